@@ -113,6 +113,124 @@ const (
 	RateLimitAdminEndpoints = 200 // 200 requests per minute for admin endpoints
 )
 
+// Login protection
+const (
+	LoginMaxAccountAttempts    = 5                // failed attempts per account before lockout
+	LoginMaxIPAttempts         = 20               // failed attempts per IP before lockout
+	LoginAttemptWindow         = 15 * time.Minute // window in which failed attempts are counted
+	LoginBaseLockoutDuration   = 5 * time.Minute  // first lockout, doubled on every repeat
+	LoginMaxLockoutDuration    = 24 * time.Hour
+	LoginLockoutMemory         = 24 * time.Hour // how long previous lockouts count towards backoff
+	OTPMaxAttempts             = 5              // guesses allowed per login challenge
+	OTPChallengeTTL            = 10 * time.Minute
+	PasswordResetMaxAttempts   = 3 // reset requests per account per window
+	PasswordResetMaxIPAttempts = 10
+	PasswordResetWindow        = time.Hour
+)
+
+// Transaction PIN and step-up verification
+const (
+	TransactionPinMinLength = 4
+	TransactionPinMaxLength = 6
+	StepUpCodeTTL           = 5 * time.Minute
+	StepUpMaxAttempts       = 5 // failed PIN or code entries before step-up is blocked
+	StepUpAttemptWindow     = 15 * time.Minute
+)
+
+// Log export
+const (
+	LogExportMaxRows = 50000 // rows included in a single CSV export
+)
+
+// Payment requests
+const (
+	PaymentRequestDefaultExpiry = 72 * time.Hour
+	PaymentRequestLinkPath      = "/pay/" // frontend page that opens a payment link
+)
+
+// Exchange rates
+const (
+	ExchangeRateFollowUpSweepSpec  = "@every 5m"     // how often rates whose follow-up was not queued are retried
+	ExchangeRateFollowUpSweepGrace = 2 * time.Minute // time an activation has to queue its own follow-up
+)
+
+// Limit orders
+const (
+	LimitOrderDefaultExpiry = 7 * 24 * time.Hour
+	LimitOrderMaxExpiry     = 30 * 24 * time.Hour
+	LimitOrderSweepSpec     = "@every 15m" // how often overdue orders the expiry job missed are expired
+)
+
+// Rate alerts
+const (
+	RateAlertDefaultCooldown = 60 // minutes
+	RateAlertMaxPerUser      = 20
+)
+
+// Scheduled transfers
+const (
+	ScheduledTransferSweepSpec         = "@every 5m" // how often due schedules are picked up
+	ScheduledTransferBalanceAlertAhead = 24 * time.Hour
+	ScheduledTransferMaxFailures       = 3 // consecutive failed runs before a schedule is paused
+)
+
+// Currency registry
+const (
+	CurrencyRegistryTTL = time.Minute // how long a loaded registry is used before it is reloaded
+)
+
+// Email templates
+const (
+	EmailTemplateCacheTTL = time.Minute // how long admin edited templates are cached before they are reloaded
+)
+
+// Real-time events
+const (
+	EventNotificationCreated = "notification.created"
+	EventUnreadCount         = "notification.unread_count"
+	EventTransactionStatus   = "transaction.status"
+
+	EventStreamBacklog   = 500 // events kept per user so reconnecting clients can resume
+	EventStreamRetention = 24 * time.Hour
+	EventStreamHeartbeat = 25 * time.Second // keeps idle connections open through proxies
+)
+
+// SMS templates
+const (
+	SMSTemplateOTP              = "otp"
+	SMSTemplateTransactionAlert = "transaction_alert"
+	SMSTemplateCampaign         = "campaign"
+
+	SMSMaxRetries = 3
+)
+
+// Push notifications
+const (
+	PushMaxRetries        = 3
+	PushMaxDevicesPerUser = 10 // the least recently seen device is dropped beyond this
+)
+
+// Broadcast campaigns
+const (
+	CampaignBatchSize     = 500         // recipients handled by one delivery task
+	CampaignBatchInterval = time.Minute // gap between batches, which throttles large sends
+	CampaignMaxRetries    = 3
+)
+
+// KYC tiers. Identity checks are not integrated yet, so a verified account is the highest tier.
+const (
+	KycTierUnverified = 0
+	KycTierVerified   = 1
+)
+
+// Account statements
+const (
+	StatementSyncMaxDays = 92  // longer ranges are generated in the background
+	StatementMaxDays     = 731 // longest range a single statement can cover
+	StatementRetention   = 7 * 24 * time.Hour
+	StatementPurgeSpec   = "@every 1h" // how often expired statement files are removed
+)
+
 // Configuration struct for environment variables
 type Config struct {
 	Environment       string
@@ -181,11 +299,6 @@ func LoadConfig() *Config {
 	}
 }
 
-// IsValidCurrency checks if currency is valid
-func IsValidCurrency(currency string) bool {
-	return currency == CurrencyNGN || currency == CurrencyGHS
-}
-
 // IsValidTransactionType checks if transaction type is valid
 func IsValidTransactionType(txType string) bool {
 	switch txType {
@@ -216,11 +329,6 @@ func IsValidPaymentMethod(method string) bool {
 	}
 }
 
-// GetSupportedCurrencies returns list of supported currencies
-func GetSupportedCurrencies() []string {
-	return []string{CurrencyNGN, CurrencyGHS}
-}
-
 // GetSupportedCountries returns list of supported countries
 func GetSupportedCountries() []string {
 	return []string{"NGN", "GHS", "ZAR", "TZA", "UGA", "RWA", "ZMB", "MWI", "BWA", "ZWE"}
@@ -1,5 +1,6 @@
 package constants
 
 const (
-	NewLoginActivityLog = "Login at %s"
+	NewLoginActivityLog   = "Login at %s"
+	AccountLockedActivity = "Account temporarily locked after repeated failed sign-in attempts at %s"
 )
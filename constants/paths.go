@@ -54,22 +54,97 @@ const (
 	WalletHistory            = "/history"
 	WalletUpdateAfterPayment = "/update-after-payment"
 
+	// Withdrawal method paths (under the wallet group)
+	WalletWithdrawMethods       = "/withdraw-methods"
+	WalletWithdrawMethodsNew    = "/withdraw-methods/new"
+	WalletWithdrawMethodResolve = "/withdraw-methods/resolve"
+	WalletWithdrawMethodDetail  = "/withdraw-methods/:id"
+	WalletWithdrawMethodDefault = "/withdraw-methods/:id/default"
+
 	// Convert paths
-	ConvertBase      = "/convert"
-	ConvertExchange  = "/exchange"
-	ConvertRates     = "/rates"
-	ConvertCalculate = "/calculate"
-	ConvertHistory   = "/history"
+	ConvertBase        = "/convert"
+	ConvertExchange    = "/exchange"
+	ConvertRates       = "/rates"
+	ConvertCalculate   = "/calculate"
+	ConvertHistory     = "/history"
+	ConvertRatesAt     = "/rates/at"
+	ConvertRatesSeries = "/rates/series"
+
+	// Limit order paths, under the convert group
+	ConvertOrders      = "/orders"
+	ConvertOrdersNew   = "/orders/new"
+	ConvertOrderDetail = "/orders/:id"
+	ConvertOrderCancel = "/orders/:id/cancel"
 
 	// Transaction paths
 	TransactionsBase         = "/transactions"
 	TransactionsAll          = "/all"
 	TransactionsUserHistory  = "/history"
 	TransactionsDetails      = "/details/:id"
-	TransactionsUpdateStatus = "/status/:id"
 	TransactionsNew          = "/new"
 	TransactionsStats        = "/stats"
 	TransactionsFilter       = "/filter"
+	TransactionsReceiptLinks = "/details/:id/receipt-links"
+
+	// P2P transfer paths
+	P2PBase   = "/p2p"
+	P2PSend   = "/send"
+	P2PLookup = "/lookup"
+
+	// Payment request paths
+	PaymentRequestsBase    = "/payment-requests"
+	PaymentRequestsNew     = "/new"
+	PaymentRequestsAll     = "/all"
+	PaymentRequestsDetails = "/details/:code"
+	PaymentRequestsPay     = "/pay/:code"
+	PaymentRequestsDecline = "/decline/:code"
+
+	// Public payment link paths
+	PaymentLinksBase     = "/payment-links"
+	PaymentLinksDetails  = "/details/:code"
+	PaymentLinksCheckout = "/checkout/:code"
+	PaymentLinksConfirm  = "/confirm/:reference"
+
+	// Scheduled transfer paths
+	ScheduledTransfersBase   = "/scheduled-transfers"
+	ScheduledTransfersNew    = "/new"
+	ScheduledTransfersAll    = "/all"
+	ScheduledTransfersDetail = "/:id"
+	ScheduledTransfersRuns   = "/:id/runs"
+	ScheduledTransfersPause  = "/:id/pause"
+	ScheduledTransfersResume = "/:id/resume"
+	ScheduledTransfersCancel = "/:id/cancel"
+
+	// Rate alert paths
+	RateAlertsBase   = "/rate-alerts"
+	RateAlertsAll    = "/all"
+	RateAlertsNew    = "/new"
+	RateAlertsDetail = "/:id"
+
+	// Statement paths
+	StatementsBase   = "/statements"
+	StatementsNew    = "/new"
+	StatementsAll    = "/all"
+	StatementsDetail = "/:id"
+
+	// Public statement download paths, authorised by a signed link
+	StatementFilesBase     = "/statement-files"
+	StatementFilesDownload = "/:id"
+
+	// Public receipt paths, authorised by a signed link
+	ReceiptsBase     = "/receipts"
+	ReceiptsVerify   = "/:id"
+	ReceiptsDownload = "/:id/pdf"
+
+	// Currency registry paths
+	CurrenciesBase = "/currencies"
+	CurrenciesAll  = "/all"
+
+	// Recipient paths
+	RecipientsBase   = "/recipients"
+	RecipientsAll    = "/all"
+	RecipientsNew    = "/new"
+	RecipientsDetail = "/:id"
 
 	// Notification paths
 	NotificationsBase        = "/notifications"
@@ -78,6 +153,13 @@ const (
 	NotificationsMarkAllRead = "/mark-all-read"
 	NotificationMarkReadBulk = "/mark-read-bulk"
 	NotificationDeleteBulk   = "/delete-bulk"
+	NotificationsStream      = "/stream"
+
+	// Push device paths
+	DevicesBase       = "/devices"
+	DevicesAll        = "/all"
+	DevicesRegister   = "/register"
+	DevicesUnregister = "/unregister"
 
 	// Settings paths
 	SettingsBase             = "/settings"
@@ -92,6 +174,10 @@ const (
 	SettingsTwoFactor        = "/securitytwo-factor/qr"
 	SettingsTwoFactorEnable  = "/security/two-factor/enable"
 	SettingsTwoFactorDisable = "/security/two-factor/disable"
+	SettingsPin              = "/security/pin"
+	SettingsPinResetRequest  = "/security/pin/reset-request"
+	SettingsPinReset         = "/security/pin/reset"
+	SettingsStepUpCode       = "/security/step-up"
 
 	// Admin paths
 	AdminBase      = "/admin"
@@ -143,14 +229,73 @@ const (
 
 	// Admin logs paths
 	AdminLogsBase          = "/logs"
-	AdminLogsAll           = "/all"
-	AdminLogsNotifications = "/notifications"
-	AdminLogsAudit         = "/audit"
+	AdminLogsAll           = "/all"           // admin action log
+	AdminLogsNotifications = "/notifications" // notifications sent to users
+	AdminLogsAudit         = "/audit"         // user activity audit trail
+
+	// Admin role paths
+	AdminRolesBase        = "/roles"
+	AdminRolesAll         = "/all"
+	AdminRolesCreate      = "/create"
+	AdminRolesUpdate      = "/:id"
+	AdminRolesDelete      = "/:id"
+	AdminRolesPermissions = "/permissions"
+	AdminRolesMine        = "/me"
+	AdminRolesAssign      = "/assign/:userId"
+
+	// Admin dual approval paths
+	AdminApprovalsBase         = "/approvals"
+	AdminApprovalsAll          = "/all"
+	AdminApprovalsDetails      = "/details/:id"
+	AdminApprovalsApprove      = "/approve/:id"
+	AdminApprovalsReject       = "/reject/:id"
+	AdminApprovalsPolicies     = "/policies"
+	AdminApprovalsPolicyUpdate = "/policies/:action"
+
+	// Admin currency registry paths
+	AdminCurrenciesBase   = "/currencies"
+	AdminCurrenciesAll    = "/all"
+	AdminCurrenciesCreate = "/create"
+	AdminCurrenciesUpdate = "/:code"
+	AdminCorridorsBase    = "/corridors"
+	AdminCorridorsCreate  = "/create"
+	AdminCorridorsUpdate  = "/:id"
+
+	// Admin campaign paths
+	AdminCampaignsBase     = "/campaigns"
+	AdminCampaignsAll      = "/all"
+	AdminCampaignsCreate   = "/create"
+	AdminCampaignsAudience = "/audience"
+	AdminCampaignsDetails  = "/details/:id"
+	AdminCampaignsUpdate   = "/:id"
+	AdminCampaignsSchedule = "/:id/schedule"
+	AdminCampaignsCancel   = "/:id/cancel"
+
+	// Admin email template paths
+	AdminEmailTemplatesBase     = "/email-templates"
+	AdminEmailTemplatesAll      = "/all"
+	AdminEmailTemplatesDetails  = "/:name"
+	AdminEmailTemplatesCreate   = "/:name/versions"
+	AdminEmailTemplatesPreview  = "/:name/preview"
+	AdminEmailTemplatesRollback = "/:name/rollback"
+
+	// Admin email delivery log and suppression list paths
+	AdminEmailDeliveriesBase     = "/email-deliveries"
+	AdminEmailDeliveriesAll      = "/all"
+	AdminEmailDeliveriesStats    = "/stats"
+	AdminEmailDeliveriesDetails  = "/details/:id"
+	AdminEmailDeliveriesResend   = "/:id/resend"
+	AdminEmailSuppressionsBase   = "/email-suppressions"
+	AdminEmailSuppressionsAll    = "/all"
+	AdminEmailSuppressionsCreate = "/create"
+	AdminEmailSuppressionsDelete = "/:id"
 
 	// Webhook paths
 	WebhooksBase     = "/webhooks"
 	WebhooksPaystack = "/paystack"
 	WebhooksMomo     = "/momo"
+	WebhooksSMS      = "/sms"
+	WebhooksEmail    = "/email"
 )
 
 // GetFullPath combines base API path with specific path
@@ -0,0 +1,93 @@
+package constants
+
+// Admin roles
+const (
+	AdminRoleSuperAdmin = "super_admin"
+	AdminRoleFinance    = "finance"
+	AdminRoleCompliance = "compliance"
+	AdminRoleSupport    = "support"
+	AdminRoleReadOnly   = "read_only"
+)
+
+// AdminRoleDisplayNames holds the human readable names of the built-in roles
+var AdminRoleDisplayNames = map[string]string{
+	AdminRoleSuperAdmin: "Super Admin",
+	AdminRoleFinance:    "Finance",
+	AdminRoleCompliance: "Compliance",
+	AdminRoleSupport:    "Support",
+	AdminRoleReadOnly:   "Read Only",
+}
+
+// Admin permissions
+const (
+	PermDashboardView       = "dashboard.view"
+	PermUsersView           = "users.view"
+	PermUsersUpdate         = "users.update"
+	PermUsersBlock          = "users.block"
+	PermUsersSecurity       = "users.security"
+	PermTransactionsView    = "transactions.view"
+	PermTransactionsApprove = "transactions.approve"
+	PermTransactionsNotes   = "transactions.notes"
+	PermRatesView           = "rates.view"
+	PermRatesManage         = "rates.manage"
+	PermCurrenciesManage    = "currencies.manage"
+	PermSettingsView        = "settings.view"
+	PermSettingsManage      = "settings.manage"
+	PermRolesManage         = "roles.manage"
+	PermApprovalsReview     = "approvals.review"
+	PermApprovalsManage     = "approvals.manage"
+	PermLogsView            = "logs.view"
+	PermCampaignsManage     = "campaigns.manage"
+	PermTemplatesManage     = "templates.manage"
+	PermEmailsManage        = "emails.manage"
+)
+
+// AdminPermissionDescriptions lists every permission known to the admin panel
+var AdminPermissionDescriptions = map[string]string{
+	PermDashboardView:       "View the admin dashboard",
+	PermUsersView:           "View users, wallets and activity",
+	PermUsersUpdate:         "Edit user details",
+	PermUsersBlock:          "Block and unblock users",
+	PermUsersSecurity:       "Change user security settings",
+	PermTransactionsView:    "View transactions",
+	PermTransactionsApprove: "Approve and reject transactions",
+	PermTransactionsNotes:   "Add notes to transactions",
+	PermRatesView:           "View exchange rates",
+	PermRatesManage:         "Add, update and remove exchange rates",
+	PermCurrenciesManage:    "Add and configure currencies and corridors",
+	PermSettingsView:        "View platform settings",
+	PermSettingsManage:      "Update platform settings",
+	PermRolesManage:         "Manage admin roles and assignments",
+	PermApprovalsReview:     "Approve and reject changes requested by other admins",
+	PermApprovalsManage:     "Configure which actions need dual approval",
+	PermLogsView:            "View and export admin, notification, activity and email delivery logs",
+	PermCampaignsManage:     "Compose, schedule and cancel broadcast campaigns",
+	PermTemplatesManage:     "Edit, preview and roll back email templates",
+	PermEmailsManage:        "Resend emails and manage the email suppression list",
+}
+
+// DefaultAdminRoles maps built-in roles to their default permissions.
+// Super admins are granted every permission regardless of this list.
+var DefaultAdminRoles = map[string][]string{
+	AdminRoleSuperAdmin: {
+		PermDashboardView, PermUsersView, PermUsersUpdate, PermUsersBlock, PermUsersSecurity,
+		PermTransactionsView, PermTransactionsApprove, PermTransactionsNotes,
+		PermRatesView, PermRatesManage, PermCurrenciesManage, PermSettingsView, PermSettingsManage, PermRolesManage,
+		PermApprovalsReview, PermApprovalsManage, PermLogsView, PermCampaignsManage, PermTemplatesManage,
+		PermEmailsManage,
+	},
+	AdminRoleFinance: {
+		PermDashboardView, PermUsersView, PermTransactionsView, PermTransactionsApprove,
+		PermTransactionsNotes, PermRatesView, PermRatesManage, PermCurrenciesManage, PermApprovalsReview,
+	},
+	AdminRoleCompliance: {
+		PermDashboardView, PermUsersView, PermUsersBlock, PermUsersSecurity,
+		PermTransactionsView, PermTransactionsNotes, PermSettingsView, PermApprovalsReview, PermLogsView,
+	},
+	AdminRoleSupport: {
+		PermDashboardView, PermUsersView, PermUsersUpdate, PermTransactionsView, PermTransactionsNotes,
+	},
+	AdminRoleReadOnly: {
+		PermDashboardView, PermUsersView, PermTransactionsView, PermRatesView, PermSettingsView,
+	},
+}
@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+func GetChangeRequests(c *gin.Context) {
+	var params types.ChangeRequestQuery
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	requests, err := services.GetChangeRequests(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.change_requests_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.change_requests_retrieved"),
+		"data":    requests,
+	})
+}
+
+func GetChangeRequestDetails(c *gin.Context) {
+	requestID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_change_request_id"),
+		})
+		return
+	}
+
+	request, err := services.GetChangeRequest(uint(requestID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.change_request_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.change_request_retrieved"),
+		"data":    request,
+	})
+}
+
+func ApproveChangeRequest(c *gin.Context) {
+	reviewChangeRequest(c, true)
+}
+
+func RejectChangeRequest(c *gin.Context) {
+	reviewChangeRequest(c, false)
+}
+
+func GetDualApprovalPolicies(c *gin.Context) {
+	policies, err := services.GetDualApprovalPolicies()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.approval_policies_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.approval_policies_retrieved"),
+		"data":    policies,
+	})
+}
+
+func UpdateDualApprovalPolicy(c *gin.Context) {
+	var request types.UpdateDualApprovalPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	policy, err := services.UpdateDualApprovalPolicy(c.Param("action"), request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.approval_policy_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.approval_policy_updated"),
+		"data":    policy,
+	})
+}
+
+func reviewChangeRequest(c *gin.Context, approve bool) {
+	requestID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_change_request_id"),
+		})
+		return
+	}
+
+	var request types.ReviewChangeRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   true,
+				"message": i18n.T(c, "api.invalid_request_body"),
+				"details": i18n.ErrorMessage(c, err),
+			})
+			return
+		}
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	var response types.AdminChangeRequestResponse
+	message := i18n.T(c, "api.change_request_rejected")
+	if approve {
+		response, err = services.ApproveChangeRequest(uint(requestID), getAdminActor(c, admin.ID), request.Note)
+		message = i18n.T(c, "api.change_request_approved")
+	} else {
+		response, err = services.RejectChangeRequest(uint(requestID), getAdminActor(c, admin.ID), request.Note)
+	}
+	if errors.Is(err, services.ErrCheckerPermissionDenied) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.permission_denied"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.change_request_review_failed"),
+			"details": i18n.ErrorMessage(c, err),
+			"data":    response,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": message,
+		"data":    response,
+	})
+}
+
+// respondPendingApproval answers 202 when an admin action was queued for a second admin
+func respondPendingApproval(c *gin.Context, err error) bool {
+	var pending *services.PendingApprovalError
+	if !errors.As(err, &pending) {
+		return false
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"error":           false,
+		"message":         i18n.T(c, "api.change_pending_approval"),
+		"pendingApproval": true,
+		"data":            pending.Request,
+	})
+	return true
+}
@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+func AdminGetCampaigns(c *gin.Context) {
+	var query types.CampaignQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	campaigns, err := services.AdminGetCampaigns(query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.campaigns_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.campaigns_retrieved"),
+		"data":    campaigns,
+	})
+}
+
+func AdminGetCampaignDetails(c *gin.Context) {
+	campaignID, ok := parseCampaignID(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := services.AdminGetCampaign(campaignID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.campaign_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.campaign_retrieved"),
+		"data":    campaign,
+	})
+}
+
+func AdminPreviewCampaignAudience(c *gin.Context) {
+	var request types.CampaignSegmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	audience, err := services.AdminPreviewCampaignAudience(request)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.audience_preview_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.audience_retrieved"),
+		"data":    audience,
+	})
+}
+
+func AdminCreateCampaign(c *gin.Context) {
+	var request types.CreateCampaignRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := services.AdminCreateCampaign(request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.campaign_create_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.campaign_created"),
+		"data":    campaign,
+	})
+}
+
+func AdminUpdateCampaign(c *gin.Context) {
+	campaignID, ok := parseCampaignID(c)
+	if !ok {
+		return
+	}
+
+	var request types.UpdateCampaignRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := services.AdminUpdateCampaign(campaignID, request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.campaign_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.campaign_updated"),
+		"data":    campaign,
+	})
+}
+
+func AdminScheduleCampaign(c *gin.Context) {
+	campaignID, ok := parseCampaignID(c)
+	if !ok {
+		return
+	}
+
+	// An empty body sends the campaign straight away
+	var request types.ScheduleCampaignRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   true,
+				"message": i18n.T(c, "api.invalid_request_body"),
+				"details": i18n.ErrorMessage(c, err),
+			})
+			return
+		}
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := services.AdminScheduleCampaign(campaignID, request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.campaign_schedule_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.campaign_scheduled"),
+		"data":    campaign,
+	})
+}
+
+func AdminCancelCampaign(c *gin.Context) {
+	campaignID, ok := parseCampaignID(c)
+	if !ok {
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := services.AdminCancelCampaign(campaignID, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.campaign_cancel_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.campaign_cancelled"),
+		"data":    campaign,
+	})
+}
+
+func parseCampaignID(c *gin.Context) (uint, bool) {
+	campaignID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_campaign_id"),
+		})
+		return 0, false
+	}
+	return uint(campaignID), true
+}
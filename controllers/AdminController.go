@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -16,15 +17,15 @@ func GetAdminDashboardStatistics(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve dashboard statistics",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.dashboard_statistics_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Dashboard statistics retrieved successfully",
+		"message": i18n.T(c, "api.dashboard_statistics_retrieved"),
 		"data":    dashboard,
 	})
 }
@@ -34,8 +35,8 @@ func GetAdminUsersAll(c *gin.Context) {
 	if err := c.ShouldBindJSON(&params); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -44,15 +45,15 @@ func GetAdminUsersAll(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve users",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.users_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Users retrieved successfully",
+		"message": i18n.T(c, "api.users_retrieved"),
 		"data":    users,
 	})
 }
@@ -62,7 +63,7 @@ func AdminUsersDetails(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -70,8 +71,8 @@ func AdminUsersDetails(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid User ID format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_user_id_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -79,15 +80,15 @@ func AdminUsersDetails(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User not found",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_not_found"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User details retrieved successfully",
+		"message": i18n.T(c, "api.user_details_retrieved"),
 		"data":    userDetails,
 	})
 }
@@ -97,7 +98,7 @@ func AdminUserUpdate(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -106,8 +107,8 @@ func AdminUserUpdate(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -116,25 +117,30 @@ func AdminUserUpdate(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid User ID format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_user_id_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
-	response, err := services.UpdateAdminUser(userId, request)
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	response, err := services.UpdateAdminUser(userId, request, getAdminActor(c, admin.ID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to update user",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User updated successfully",
+		"message": i18n.T(c, "api.user_updated"),
 		"data":    response,
 	})
 }
@@ -144,8 +150,8 @@ func GetAdminTransactionsAll(c *gin.Context) {
 	if err := c.ShouldBindQuery(&params); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid query parameters",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -154,15 +160,15 @@ func GetAdminTransactionsAll(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve transactions",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transactions_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transactions retrieved successfully",
+		"message": i18n.T(c, "api.transactions_retrieved"),
 		"data":    transactions,
 	})
 }
@@ -172,7 +178,7 @@ func GetAdminTransactionDetails(c *gin.Context) {
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -181,15 +187,15 @@ func GetAdminTransactionDetails(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":   true,
-			"message": "Transaction not found",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transaction_not_found"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transaction details retrieved successfully",
+		"message": i18n.T(c, "api.transaction_details_retrieved"),
 		"data":    transaction,
 	})
 }
@@ -199,7 +205,7 @@ func ApproveAdminTransaction(c *gin.Context) {
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -209,7 +215,7 @@ func ApproveAdminTransaction(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -218,7 +224,7 @@ func ApproveAdminTransaction(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -226,24 +232,27 @@ func ApproveAdminTransaction(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.ApproveAdminTransaction(transactionID, user.ID)
+	response, err := services.ApproveAdminTransaction(transactionID, getAdminActor(c, user.ID))
+	if respondPendingApproval(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to approve transaction",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transaction_approve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.transaction_approved"),
 		"data":    response,
 	})
 }
@@ -253,7 +262,7 @@ func RejectAdminTransaction(c *gin.Context) {
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -262,8 +271,8 @@ func RejectAdminTransaction(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -273,7 +282,7 @@ func RejectAdminTransaction(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -282,7 +291,7 @@ func RejectAdminTransaction(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -290,24 +299,24 @@ func RejectAdminTransaction(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.RejectAdminTransaction(transactionID, request.Reason, user.ID)
+	response, err := services.RejectAdminTransaction(transactionID, request.Reason, getAdminActor(c, user.ID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to reject transaction",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transaction_reject_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.transaction_rejected"),
 		"data":    response,
 	})
 }
@@ -317,7 +326,7 @@ func AdminTransactionStatus(c *gin.Context) {
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -326,15 +335,15 @@ func AdminTransactionStatus(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":   true,
-			"message": "Transaction not found",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transaction_not_found"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transaction status retrieved successfully",
+		"message": i18n.T(c, "api.transaction_status_retrieved"),
 		"data":    status,
 	})
 }
@@ -344,15 +353,15 @@ func AdminTransactionsOverview(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve transactions overview",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transactions_overview_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transactions overview retrieved successfully",
+		"message": i18n.T(c, "api.transactions_overview_retrieved"),
 		"data":    overview,
 	})
 }
@@ -371,8 +380,8 @@ func AdminRatesHistory(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid limit parameter",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_limit"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -380,8 +389,8 @@ func AdminRatesHistory(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid cursor parameter",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_cursor"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -390,15 +399,15 @@ func AdminRatesHistory(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve rates history",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.rates_history_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":      false,
-		"message":    "Rates history retrieved successfully",
+		"message":    i18n.T(c, "api.rates_history_retrieved"),
 		"nextCursor": nextCursor,
 		"data":       rates,
 	})
@@ -409,8 +418,8 @@ func AdminRatesAdd(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -420,7 +429,7 @@ func AdminRatesAdd(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -429,7 +438,7 @@ func AdminRatesAdd(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -437,24 +446,27 @@ func AdminRatesAdd(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	rate, err := services.AddAdminRate(request, user.ID)
+	rate, err := services.AddAdminRate(request, getAdminActor(c, user.ID))
+	if respondPendingApproval(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to add rate",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.rate_add_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
 		"error":   false,
-		"message": "Rate added successfully",
+		"message": i18n.T(c, "api.rate_added"),
 		"data":    rate,
 	})
 }
@@ -465,7 +477,7 @@ func BlockUser(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -474,8 +486,8 @@ func BlockUser(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -485,7 +497,7 @@ func BlockUser(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -494,7 +506,7 @@ func BlockUser(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -502,24 +514,24 @@ func BlockUser(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.BlockUser(userID, request.Reason, user.ID)
+	response, err := services.BlockUser(userID, request.Reason, getAdminActor(c, user.ID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to block user",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_block_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.user_blocked"),
 		"data":    response,
 	})
 }
@@ -529,7 +541,7 @@ func UnblockUser(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -539,7 +551,7 @@ func UnblockUser(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -548,7 +560,7 @@ func UnblockUser(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -556,24 +568,24 @@ func UnblockUser(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.UnblockUser(userID, user.ID)
+	response, err := services.UnblockUser(userID, getAdminActor(c, user.ID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to unblock user",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_unblock_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.user_unblocked"),
 		"data":    response,
 	})
 }
@@ -584,7 +596,7 @@ func GetUserTransactions(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -593,8 +605,8 @@ func GetUserTransactions(c *gin.Context) {
 	if err := c.ShouldBindQuery(&params); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid query parameters",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -603,8 +615,8 @@ func GetUserTransactions(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid User ID format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_user_id_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -613,15 +625,15 @@ func GetUserTransactions(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve user transactions",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_transactions_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User transactions retrieved successfully",
+		"message": i18n.T(c, "api.user_transactions_retrieved"),
 		"data":    transactions,
 	})
 }
@@ -632,7 +644,7 @@ func GetUserWallet(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -641,8 +653,8 @@ func GetUserWallet(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid User ID format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_user_id_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -651,15 +663,15 @@ func GetUserWallet(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve user wallet",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_wallet_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User wallet retrieved successfully",
+		"message": i18n.T(c, "api.user_wallet_retrieved"),
 		"data":    wallet,
 	})
 }
@@ -673,8 +685,8 @@ func SearchUsers(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -683,15 +695,15 @@ func SearchUsers(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to search users",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.users_search_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Users search completed successfully",
+		"message": i18n.T(c, "api.users_search_completed"),
 		"data":    users,
 	})
 }
@@ -703,7 +715,7 @@ func UpdateRate(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid rate ID",
+			"message": i18n.T(c, "api.invalid_rate_id"),
 		})
 		return
 	}
@@ -712,8 +724,8 @@ func UpdateRate(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -723,7 +735,7 @@ func UpdateRate(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -732,7 +744,7 @@ func UpdateRate(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -740,24 +752,27 @@ func UpdateRate(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	rate, err := services.UpdateAdminRate(uint(rateID), request, user.ID)
+	rate, err := services.UpdateAdminRate(uint(rateID), request, getAdminActor(c, user.ID))
+	if respondPendingApproval(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to update rate",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.rate_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Rate updated successfully",
+		"message": i18n.T(c, "api.rate_updated"),
 		"data":    rate,
 	})
 }
@@ -769,7 +784,7 @@ func ToggleRateStatus(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid rate ID",
+			"message": i18n.T(c, "api.invalid_rate_id"),
 		})
 		return
 	}
@@ -781,8 +796,8 @@ func ToggleRateStatus(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -792,7 +807,7 @@ func ToggleRateStatus(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -801,7 +816,7 @@ func ToggleRateStatus(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -809,24 +824,27 @@ func ToggleRateStatus(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.ToggleRateStatus(uint(rateID), request.Active, user.ID)
+	response, err := services.ToggleRateStatus(uint(rateID), request.Active, getAdminActor(c, user.ID))
+	if respondPendingApproval(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to update rate status",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.rate_status_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.rate_status_updated"),
 		"data":    response,
 	})
 }
@@ -838,7 +856,7 @@ func DeleteRate(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid rate ID",
+			"message": i18n.T(c, "api.invalid_rate_id"),
 		})
 		return
 	}
@@ -848,7 +866,7 @@ func DeleteRate(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -857,7 +875,7 @@ func DeleteRate(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -865,24 +883,27 @@ func DeleteRate(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.DeleteRate(uint(rateID), user.ID)
+	response, err := services.DeleteRate(uint(rateID), getAdminActor(c, user.ID))
+	if respondPendingApproval(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to delete rate",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.rate_delete_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.rate_deleted"),
 		"data":    response,
 	})
 }
@@ -893,8 +914,8 @@ func GetPendingTransactions(c *gin.Context) {
 	if err := c.ShouldBindQuery(&params); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid query parameters",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -903,15 +924,15 @@ func GetPendingTransactions(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve pending transactions",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.pending_transactions_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Pending transactions retrieved successfully",
+		"message": i18n.T(c, "api.pending_transactions_retrieved"),
 		"data":    transactions,
 	})
 }
@@ -921,22 +942,22 @@ func AdminGetPlatformSettings(c *gin.Context) {
 	// Only allow admins
 	userInterface, exists := c.Get("user")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": "Admin authentication required"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": i18n.T(c, "api.admin_authentication_required")})
 		return
 	}
 	user, ok := userInterface.(*libs.JWTClaims)
 	if !ok || !user.IsAdmin {
-		c.JSON(http.StatusForbidden, gin.H{"error": true, "message": "Admin privileges required"})
+		c.JSON(http.StatusForbidden, gin.H{"error": true, "message": i18n.T(c, "api.admin_privileges_required")})
 		return
 	}
 
 	settings, err := services.GetPlatformSettings()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": true, "message": "Failed to retrieve platform settings", "details": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": true, "message": i18n.T(c, "api.platform_settings_retrieve_failed"), "details": i18n.ErrorMessage(c, err)})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"error": false, "message": "Platform settings retrieved successfully", "data": settings})
+	c.JSON(http.StatusOK, gin.H{"error": false, "message": i18n.T(c, "api.platform_settings_retrieved"), "data": settings})
 }
 
 // AdminUpdatePlatformSettings updates platform-wide settings
@@ -944,28 +965,31 @@ func AdminUpdatePlatformSettings(c *gin.Context) {
 	// Only allow admins
 	userInterface, exists := c.Get("user")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": "Admin authentication required"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": i18n.T(c, "api.admin_authentication_required")})
 		return
 	}
 	user, ok := userInterface.(*libs.JWTClaims)
 	if !ok || !user.IsAdmin {
-		c.JSON(http.StatusForbidden, gin.H{"error": true, "message": "Admin privileges required"})
+		c.JSON(http.StatusForbidden, gin.H{"error": true, "message": i18n.T(c, "api.admin_privileges_required")})
 		return
 	}
 
 	var req services.PlatformSettingsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": true, "message": "Invalid request body", "details": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": true, "message": i18n.T(c, "api.invalid_request_body"), "details": i18n.ErrorMessage(c, err)})
 		return
 	}
 
-	settings, err := services.UpdatePlatformSettings(req, user.ID)
+	settings, err := services.UpdatePlatformSettings(req, getAdminActor(c, user.ID))
+	if respondPendingApproval(c, err) {
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": true, "message": "Failed to update platform settings", "details": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": true, "message": i18n.T(c, "api.platform_settings_update_failed"), "details": i18n.ErrorMessage(c, err)})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"error": false, "message": "Platform settings updated successfully", "data": settings})
+	c.JSON(http.StatusOK, gin.H{"error": false, "message": i18n.T(c, "api.platform_settings_updated"), "data": settings})
 }
 
 // GetFailedTransactions retrieves all failed transactions
@@ -974,8 +998,8 @@ func GetFailedTransactions(c *gin.Context) {
 	if err := c.ShouldBindQuery(&params); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid query parameters",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -984,15 +1008,15 @@ func GetFailedTransactions(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve failed transactions",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.failed_transactions_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Failed transactions retrieved successfully",
+		"message": i18n.T(c, "api.failed_transactions_retrieved"),
 		"data":    transactions,
 	})
 }
@@ -1003,7 +1027,7 @@ func AddTransactionNote(c *gin.Context) {
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -1015,8 +1039,8 @@ func AddTransactionNote(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request body",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -1026,7 +1050,7 @@ func AddTransactionNote(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -1035,7 +1059,7 @@ func AddTransactionNote(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -1043,24 +1067,24 @@ func AddTransactionNote(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
 
-	response, err := services.AddTransactionNote(transactionID, request.Note, user.ID)
+	response, err := services.AddTransactionNote(transactionID, request.Note, getAdminActor(c, user.ID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to add transaction note",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.transaction_note_add_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": i18n.T(c, "api.transaction_note_added"),
 		"data":    response,
 	})
 }
@@ -1071,7 +1095,7 @@ func GetUserActivityLogs(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -1080,8 +1104,8 @@ func GetUserActivityLogs(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid User ID format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_user_id_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -1090,15 +1114,15 @@ func GetUserActivityLogs(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve user activity logs",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_activity_logs_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User activity logs retrieved successfully",
+		"message": i18n.T(c, "api.user_activity_logs_retrieved"),
 		"data":    activities,
 	})
 }
@@ -1109,7 +1133,7 @@ func ToggleUserTwoFactor(c *gin.Context) {
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "User ID is required",
+			"message": i18n.T(c, "api.user_id_required"),
 		})
 		return
 	}
@@ -1120,8 +1144,8 @@ func ToggleUserTwoFactor(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -1131,7 +1155,7 @@ func ToggleUserTwoFactor(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Admin authentication required",
+			"message": i18n.T(c, "api.admin_authentication_required"),
 		})
 		return
 	}
@@ -1140,7 +1164,7 @@ func ToggleUserTwoFactor(c *gin.Context) {
 	if !ok {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Invalid user context",
+			"message": i18n.T(c, "api.invalid_user_context"),
 		})
 		return
 	}
@@ -1148,7 +1172,7 @@ func ToggleUserTwoFactor(c *gin.Context) {
 	if !user.IsAdmin {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin privileges required",
+			"message": i18n.T(c, "api.admin_privileges_required"),
 		})
 		return
 	}
@@ -1157,25 +1181,33 @@ func ToggleUserTwoFactor(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid User ID format",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_user_id_format"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
-	response, err := services.ToggleUserTwoFactor(userId, request.Enabled, user.ID)
+	response, err := services.ToggleUserTwoFactor(userId, request.Enabled, getAdminActor(c, user.ID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to toggle user two-factor authentication",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.user_two_factor_toggle_failed"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": response.Message,
+		"message": userTwoFactorMessage(c, request.Enabled),
 		"data":    response,
 	})
 }
+
+// userTwoFactorMessage confirms whether an admin turned a user's two-factor authentication on or off
+func userTwoFactorMessage(c *gin.Context, enabled bool) string {
+	if enabled {
+		return i18n.T(c, "api.user_two_factor_enabled")
+	}
+	return i18n.T(c, "api.user_two_factor_disabled")
+}
@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+func AdminGetCurrencies(c *gin.Context) {
+	registry, err := services.AdminGetCurrencies()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.currencies_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.currencies_retrieved"),
+		"data":    registry,
+	})
+}
+
+func AdminCreateCurrency(c *gin.Context) {
+	var request types.CreateCurrencyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	currency, err := services.AdminCreateCurrency(request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.currency_create_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.currency_created"),
+		"data":    currency,
+	})
+}
+
+func AdminUpdateCurrency(c *gin.Context) {
+	var request types.UpdateCurrencyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	currency, err := services.AdminUpdateCurrency(c.Param("code"), request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.currency_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.currency_updated"),
+		"data":    currency,
+	})
+}
+
+func AdminCreateCorridor(c *gin.Context) {
+	var request types.CreateCorridorRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	corridor, err := services.AdminCreateCorridor(request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.corridor_create_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.corridor_created"),
+		"data":    corridor,
+	})
+}
+
+func AdminUpdateCorridor(c *gin.Context) {
+	corridorID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_corridor_id"),
+		})
+		return
+	}
+
+	var request types.UpdateCorridorRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	corridor, err := services.AdminUpdateCorridor(uint(corridorID), request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.corridor_update_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.corridor_updated"),
+		"data":    corridor,
+	})
+}
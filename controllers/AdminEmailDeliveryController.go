@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+func AdminGetEmailDeliveries(c *gin.Context) {
+	var params types.GetEmailDeliveriesRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	deliveries, err := services.AdminGetEmailDeliveries(params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_deliveries_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_deliveries_retrieved"),
+		"data":    deliveries,
+	})
+}
+
+func AdminGetEmailDeliveryStats(c *gin.Context) {
+	stats, err := services.GetEmailDeliveryStats()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_delivery_stats_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_delivery_stats_retrieved"),
+		"data":    stats,
+	})
+}
+
+func AdminGetEmailDelivery(c *gin.Context) {
+	deliveryID, ok := parseEmailAdminID(c, i18n.T(c, "api.invalid_email_delivery_id"))
+	if !ok {
+		return
+	}
+
+	delivery, err := services.AdminGetEmailDelivery(deliveryID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_delivery_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_delivery_retrieved"),
+		"data":    delivery,
+	})
+}
+
+func AdminResendEmailDelivery(c *gin.Context) {
+	deliveryID, ok := parseEmailAdminID(c, i18n.T(c, "api.invalid_email_delivery_id"))
+	if !ok {
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	result, err := services.AdminResendEmailDelivery(deliveryID, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_resend_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_resend_queued"),
+		"data":    result,
+	})
+}
+
+func AdminGetEmailSuppressions(c *gin.Context) {
+	var params types.GetEmailSuppressionsRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	suppressions, err := services.AdminGetEmailSuppressions(params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_suppressions_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_suppressions_retrieved"),
+		"data":    suppressions,
+	})
+}
+
+func AdminAddEmailSuppression(c *gin.Context) {
+	var request types.AddEmailSuppressionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	suppression, err := services.AdminAddEmailSuppression(request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_suppress_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_suppressed"),
+		"data":    suppression,
+	})
+}
+
+func AdminRemoveEmailSuppression(c *gin.Context) {
+	suppressionID, ok := parseEmailAdminID(c, i18n.T(c, "api.invalid_email_suppression_id"))
+	if !ok {
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	result, err := services.AdminRemoveEmailSuppression(suppressionID, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_suppression_remove_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_suppression_removed"),
+		"data":    result,
+	})
+}
+
+func parseEmailAdminID(c *gin.Context, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
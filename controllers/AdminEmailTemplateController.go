@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+func AdminGetEmailTemplates(c *gin.Context) {
+	templates, err := services.AdminGetEmailTemplates()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_templates_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_templates_retrieved"),
+		"data":    templates,
+	})
+}
+
+func AdminGetEmailTemplate(c *gin.Context) {
+	template, err := services.AdminGetEmailTemplate(c.Param("name"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_template_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_template_retrieved"),
+		"data":    template,
+	})
+}
+
+func AdminCreateEmailTemplateVersion(c *gin.Context) {
+	var request types.CreateEmailTemplateVersionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	version, err := services.AdminCreateEmailTemplateVersion(c.Param("name"), request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_template_save_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_template_saved"),
+		"data":    version,
+	})
+}
+
+func AdminPreviewEmailTemplate(c *gin.Context) {
+	var request types.PreviewEmailTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	preview, err := services.AdminPreviewEmailTemplate(c.Param("name"), request)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_template_preview_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_template_rendered"),
+		"data":    preview,
+	})
+}
+
+func AdminRollbackEmailTemplate(c *gin.Context) {
+	var request types.RollbackEmailTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_body"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	admin, ok := getAdminClaims(c)
+	if !ok {
+		return
+	}
+
+	template, err := services.AdminRollbackEmailTemplate(c.Param("name"), request, getAdminActor(c, admin.ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.email_template_rollback_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_template_rolled_back"),
+		"data":    template,
+	})
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+func GetAdminLogs(c *gin.Context) {
+	var params types.GetAdminLogsRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	if params.Format == "csv" {
+		data, err := services.ExportAdminLogsCSV(params)
+		sendCSV(c, "admin-logs", data, err)
+		return
+	}
+
+	logs, err := services.GetAdminLogs(params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.admin_logs_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.admin_logs_retrieved"),
+		"data":    logs,
+	})
+}
+
+func GetNotificationLogs(c *gin.Context) {
+	var params types.GetNotificationLogsRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	if params.Format == "csv" {
+		data, err := services.ExportNotificationLogsCSV(params)
+		sendCSV(c, "notification-logs", data, err)
+		return
+	}
+
+	logs, err := services.GetNotificationLogs(params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.notification_logs_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.notification_logs_retrieved"),
+		"data":    logs,
+	})
+}
+
+func GetActivityLogs(c *gin.Context) {
+	var params types.GetActivityLogsRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	if params.Format == "csv" {
+		data, err := services.ExportActivityLogsCSV(params)
+		sendCSV(c, "activity-logs", data, err)
+		return
+	}
+
+	logs, err := services.GetActivityLogs(params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.activity_logs_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.activity_logs_retrieved"),
+		"data":    logs,
+	})
+}
+
+// sendCSV writes an export as a downloadable CSV file
+func sendCSV(c *gin.Context, name string, data []byte, err error) {
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.logs_export_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.csv", name, time.Now().Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", data)
+}
@@ -1,9 +1,11 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -13,34 +15,38 @@ import (
 func RegisterUserEndpoint(c *gin.Context) {
 	var user types.RegisterUser
 	if err := c.ShouldBind(&user); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusBadRequest, gin.H{"message": i18n.T(c, "api.invalid_request_data"), "details": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
 	if err := services.RegisterUser(user); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"error": false, "message": "User registered successfully"})
+	c.JSON(http.StatusCreated, gin.H{"error": false, "message": i18n.T(c, "api.user_registered")})
 }
 
 func LoginUserEndpoint(c *gin.Context) {
 	var user types.LoginUser
 	if err := c.ShouldBind(&user); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusBadRequest, gin.H{"message": i18n.T(c, "api.invalid_request_data"), "details": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
-	token, action, err := services.LoginUser(user)
+	token, action, err := services.LoginUser(user, c.ClientIP())
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "error": true, "action": action})
+		status := http.StatusBadRequest
+		if action == "locked" {
+			status = http.StatusTooManyRequests
+		}
+		c.JSON(status, gin.H{"message": i18n.ErrorMessage(c, err), "error": true, "action": action})
 		return
 	}
 
 	if token.IsTwoFactorEnabled != nil && *token.IsTwoFactorEnabled {
 		c.JSON(http.StatusOK, gin.H{
-			"message": "Two-factor authentication required",
+			"message": i18n.T(c, "api.two_factor_required"),
 			"error":   false,
 			"action":  action,
 			"token":   token,
@@ -50,11 +56,11 @@ func LoginUserEndpoint(c *gin.Context) {
 
 	if token.IsAdmin {
 		if err := libs.SetCookie(c, "admin_token", token.AccessToken, 3600, "/"); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+			c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 			return
 		}
 		if err := libs.SetCookie(c, "refresh_token", token.RefreshToken, 3600, "/"); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+			c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 			return
 		}
 		c.Header("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
@@ -65,11 +71,11 @@ func LoginUserEndpoint(c *gin.Context) {
 		return
 	}
 	if err := libs.SetCookie(c, "token", token.AccessToken, 3600, "/"); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 		return
 	}
 	if err := libs.SetCookie(c, "refresh_token", token.RefreshToken, 3600, "/"); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 		return
 	}
 	c.Header("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
@@ -104,24 +110,28 @@ func CreatePasswordResetLinkEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&PassWordReset); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "email is required",
+			"message": i18n.T(c, "api.email_required"),
 		})
 		return
 	}
 
-	resetToken, err := services.CreatePasswordReset(PassWordReset.Email)
+	resetToken, err := services.CreatePasswordReset(PassWordReset.Email, c.ClientIP())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error(), "error": true})
+		if errors.Is(err, services.ErrTooManyAttempts) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"message": i18n.ErrorMessage(c, err), "error": true})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
 	if resetToken == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "failed to generate reset token", "error": true})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.reset_token_failed"), "error": true})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Password reset email sent successfully",
+		"message": i18n.T(c, "api.password_reset_email_sent"),
 		"error":   false,
 	})
 
@@ -130,18 +140,18 @@ func CreatePasswordResetLinkEndpoint(c *gin.Context) {
 func ResetPasswordTokenVerifyEndpoint(c *gin.Context) {
 	token := c.Query("token")
 	if token == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "token is required", "error": true})
+		c.JSON(http.StatusBadRequest, gin.H{"message": i18n.T(c, "api.token_required"), "error": true})
 		return
 	}
 
 	email, err := services.VerifyPasswordResetToken(token)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusUnauthorized, gin.H{"message": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Token verified successfully",
+		"message": i18n.T(c, "api.token_verified"),
 		"error":   false,
 		"email":   email,
 	})
@@ -157,17 +167,17 @@ func ResetPasswordEndpoint(c *gin.Context) {
 	var value Request
 
 	if err := c.ShouldBindJSON(&value); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusBadRequest, gin.H{"message": i18n.T(c, "api.invalid_request_data"), "details": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 	fmt.Printf("Received token: %s and password: %s\n", value.Token, value.Password)
 	if err := services.ResetPassword(value.Token, value.Password); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "failed to to reset password", "error": true})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.password_reset_failed"), "error": true})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Password reset successfully",
+		"message": i18n.T(c, "api.password_reset"),
 		"error":   false,
 	})
 
@@ -175,31 +185,36 @@ func ResetPasswordEndpoint(c *gin.Context) {
 
 func VerifyOtpEndpoint(c *gin.Context) {
 	var otp struct {
-		Code string `json:"code" binding:"required"`
+		ChallengeID string `json:"challenge_id" binding:"required"`
+		Code        string `json:"code" binding:"required"`
 	}
 	if err := c.ShouldBind(&otp); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusBadRequest, gin.H{"message": i18n.T(c, "api.invalid_request_data"), "details": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
-	if otp.Code == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "OTP and User ID are required", "error": true})
+	if otp.Code == "" || otp.ChallengeID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": i18n.T(c, "api.otp_challenge_required"), "error": true})
 		return
 	}
 
-	token, code, err := services.VerifyOtp(otp.Code)
+	token, code, err := services.VerifyOtp(otp.ChallengeID, otp.Code, c.ClientIP())
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "error": true, "action": code})
+		status := http.StatusBadRequest
+		if code == "locked" {
+			status = http.StatusTooManyRequests
+		}
+		c.JSON(status, gin.H{"message": i18n.ErrorMessage(c, err), "error": true, "action": code})
 		return
 	}
 
 	if token.IsAdmin {
 		if err := libs.SetCookie(c, "admin_token", token.AccessToken, 3600, "/"); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+			c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 			return
 		}
 		if err := libs.SetCookie(c, "refresh_token", token.RefreshToken, 3600, "/"); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+			c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 			return
 		}
 		c.Header("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
@@ -211,11 +226,11 @@ func VerifyOtpEndpoint(c *gin.Context) {
 	}
 
 	if err := libs.SetCookie(c, "token", token.AccessToken, 3600, "/"); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 		return
 	}
 	if err := libs.SetCookie(c, "refresh_token", token.RefreshToken, 3600, "/"); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 		return
 	}
 	c.Header("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
@@ -229,29 +244,29 @@ func LogoutUserEndpoint(c *gin.Context) {
 	c.SetCookie("token", "", -1, "/", "", false, true)
 	c.SetCookie("refresh_token", "", -1, "/", "", false, true)
 	c.SetCookie("admin_token", "", -1, "/", "", false, true)
-	c.JSON(http.StatusOK, gin.H{"message": "User logged out successfully", "error": false})
+	c.JSON(http.StatusOK, gin.H{"message": i18n.T(c, "api.user_logged_out"), "error": false})
 }
 
 func RefreshTokenEndpoint(c *gin.Context) {
 	refreshToken, err := c.Cookie("refresh_token")
 	if err != nil || refreshToken == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Refresh token is required", "error": true})
+		c.JSON(http.StatusUnauthorized, gin.H{"message": i18n.T(c, "api.refresh_token_required"), "error": true})
 		return
 	}
 
 	newTokens, err := services.RefreshToken(refreshToken)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": err.Error(), "error": true})
+		c.JSON(http.StatusUnauthorized, gin.H{"message": i18n.ErrorMessage(c, err), "error": true})
 		return
 	}
 
 	if newTokens.IsAdmin {
 		if err := libs.SetCookie(c, "admin_token", newTokens.AccessToken, 3600, "/"); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+			c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 			return
 		}
 		if err := libs.SetCookie(c, "refresh_token", newTokens.RefreshToken, 3600, "/"); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+			c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 			return
 		}
 		c.Header("Authorization", fmt.Sprintf("Bearer %s", newTokens.AccessToken))
@@ -263,11 +278,11 @@ func RefreshTokenEndpoint(c *gin.Context) {
 	}
 
 	if err := libs.SetCookie(c, "token", newTokens.AccessToken, 3600, "/"); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 		return
 	}
 	if err := libs.SetCookie(c, "refresh_token", newTokens.RefreshToken, 3600, "/"); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to set cookie", "error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"message": i18n.T(c, "api.cookie_set_failed"), "error": err.Error()})
 		return
 	}
 	c.Header("Authorization", fmt.Sprintf("Bearer %s", newTokens.AccessToken))
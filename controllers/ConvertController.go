@@ -3,7 +3,9 @@ package controllers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -16,26 +18,95 @@ func GetExchangeRatesEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Exchange rates retrieved successfully",
+		"message": i18n.T(c, "api.exchange_rates_retrieved"),
 		"data":    rates,
 	})
 }
 
+// GetRateAtEndpoint returns the rate that was in effect for a pair at a point in time
+func GetRateAtEndpoint(c *gin.Context) {
+	var query types.RateAtQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	at := time.Now()
+	if query.At != "" {
+		parsed, err := time.Parse(time.RFC3339, query.At)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   true,
+				"message": i18n.T(c, "api.invalid_time"),
+			})
+			return
+		}
+		at = parsed
+	}
+
+	rate, err := services.GetRateAt(query.From, query.To, at)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.exchange_rate_retrieved"),
+		"data":    rate,
+	})
+}
+
+// GetRateSeriesEndpoint returns bucketed rate history for charts
+func GetRateSeriesEndpoint(c *gin.Context) {
+	var query types.RateSeriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	series, err := services.GetRateSeries(query.From, query.To, query.Range)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.exchange_rate_series_retrieved"),
+		"data":    series,
+	})
+}
+
 // CalculateConversionEndpoint calculates conversion amounts without performing conversion
 func CalculateConversionEndpoint(c *gin.Context) {
 	var req types.ConversionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -44,14 +115,14 @@ func CalculateConversionEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Conversion calculated successfully",
+		"message": i18n.T(c, "api.conversion_calculated"),
 		"data":    calculation,
 	})
 }
@@ -62,7 +133,7 @@ func ExecuteConversionEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -73,8 +144,8 @@ func ExecuteConversionEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -83,14 +154,14 @@ func ExecuteConversionEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Currency conversion completed successfully",
+		"message": i18n.T(c, "api.conversion_completed"),
 		"data":    response,
 	})
 }
@@ -101,7 +172,7 @@ func GetConversionHistoryEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -134,14 +205,14 @@ func GetConversionHistoryEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":      false,
-		"message":    "Conversion history retrieved successfully",
+		"message":    i18n.T(c, "api.conversion_history_retrieved"),
 		"data":       history,
 		"pagination": paginationResp,
 	})
@@ -0,0 +1,28 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/gin-gonic/gin"
+)
+
+// GetCurrenciesEndpoint lists the currencies users can hold and the corridors between them
+func GetCurrenciesEndpoint(c *gin.Context) {
+	currencies, err := services.GetCurrencies()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.currencies_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.currencies_retrieved"),
+		"data":    currencies,
+	})
+}
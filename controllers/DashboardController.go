@@ -3,6 +3,7 @@ package controllers
 import (
 	"net/http"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/gin-gonic/gin"
@@ -14,7 +15,7 @@ func GetDashboardOverviewEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -24,14 +25,14 @@ func GetDashboardOverviewEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Dashboard overview retrieved successfully",
+		"message": i18n.T(c, "api.dashboard_overview_retrieved"),
 		"data":    overview,
 	})
 }
@@ -42,7 +43,7 @@ func GetDashboardStatsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -52,14 +53,14 @@ func GetDashboardStatsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Dashboard statistics retrieved successfully",
+		"message": i18n.T(c, "api.dashboard_statistics_retrieved"),
 		"data":    stats,
 	})
 }
@@ -70,7 +71,7 @@ func GetUserTransactionSummaryEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -80,14 +81,14 @@ func GetUserTransactionSummaryEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transaction summary retrieved successfully",
+		"message": i18n.T(c, "api.transaction_summary_retrieved"),
 		"data":    summary,
 	})
 }
@@ -98,7 +99,7 @@ func GetTransactionStatsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -110,14 +111,14 @@ func GetTransactionStatsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transaction statistics retrieved successfully",
+		"message": i18n.T(c, "api.transaction_statistics_retrieved"),
 		"data":    stats,
 	})
 }
@@ -128,7 +129,7 @@ func GetDashboardChartsDataEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -138,14 +139,14 @@ func GetDashboardChartsDataEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Dashboard chart data retrieved successfully",
+		"message": i18n.T(c, "api.dashboard_chart_retrieved"),
 		"data":    stats.ChartData,
 	})
 }
@@ -156,7 +157,7 @@ func GetDashboardSummaryEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -167,14 +168,14 @@ func GetDashboardSummaryEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Dashboard summary retrieved successfully",
+		"message": i18n.T(c, "api.dashboard_summary_retrieved"),
 		"data":    summary,
 	})
 }
@@ -185,7 +186,7 @@ func GetRecentActivityEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -196,14 +197,14 @@ func GetRecentActivityEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Recent activity retrieved successfully",
+		"message": i18n.T(c, "api.recent_activity_retrieved"),
 		"data":    activity,
 	})
 }
@@ -214,7 +215,7 @@ func GetWalletOverviewEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -225,14 +226,14 @@ func GetWalletOverviewEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Wallet overview retrieved successfully",
+		"message": i18n.T(c, "api.wallet_overview_retrieved"),
 		"data":    overview,
 	})
 }
@@ -243,7 +244,7 @@ func GetConversionStatsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -254,14 +255,14 @@ func GetConversionStatsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Conversion statistics retrieved successfully",
+		"message": i18n.T(c, "api.conversion_statistics_retrieved"),
 		"data":    stats,
 	})
 }
@@ -272,7 +273,7 @@ func GetMonthlyStatsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -283,14 +284,14 @@ func GetMonthlyStatsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Monthly statistics retrieved successfully",
+		"message": i18n.T(c, "api.monthly_statistics_retrieved"),
 		"data":    stats,
 	})
 }
@@ -301,7 +302,7 @@ func GetTransactionTrendsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -312,14 +313,14 @@ func GetTransactionTrendsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transaction trends retrieved successfully",
+		"message": i18n.T(c, "api.transaction_trends_retrieved"),
 		"data":    trends,
 	})
 }
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetDevicesEndpoint lists the devices registered for push notifications
+func GetDevicesEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	devices, err := services.GetDevices(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.devices_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.devices_retrieved"),
+		"data":    devices,
+	})
+}
+
+// RegisterDeviceEndpoint registers a device token for push notifications
+func RegisterDeviceEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.RegisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	device, err := services.RegisterDevice(userID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.device_registered"),
+		"data":    device,
+	})
+}
+
+// UnregisterDeviceEndpoint stops push notifications to a device
+func UnregisterDeviceEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.UnregisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	if err := services.UnregisterDevice(userID, req.Token); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.device_unregistered"),
+	})
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateLimitOrderEndpoint places a conversion that runs when the target rate is reached
+func CreateLimitOrderEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.CreateLimitOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	order, err := services.CreateLimitOrder(userID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.limit_order_placed"),
+		"data":    order,
+	})
+}
+
+// GetLimitOrdersEndpoint lists the user's limit orders
+func GetLimitOrdersEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var query types.LimitOrderQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	response, err := services.GetLimitOrders(userID, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.limit_orders_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":      false,
+		"message":    i18n.T(c, "api.limit_orders_retrieved"),
+		"data":       response.Orders,
+		"pagination": response.Pagination,
+	})
+}
+
+// GetLimitOrderEndpoint returns a single limit order
+func GetLimitOrderEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_order_id"),
+		})
+		return
+	}
+
+	order, err := services.GetLimitOrder(userID, uint(orderID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.limit_order_retrieved"),
+		"data":    order,
+	})
+}
+
+// CancelLimitOrderEndpoint cancels an open limit order and releases its funds
+func CancelLimitOrderEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_order_id"),
+		})
+		return
+	}
+
+	order, err := services.CancelLimitOrder(userID, uint(orderID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.limit_order_cancelled"),
+		"data":    order,
+	})
+}
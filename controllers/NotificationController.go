@@ -1,12 +1,17 @@
 package controllers
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,7 +22,7 @@ func GetAllNotificationsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -40,7 +45,7 @@ func GetAllNotificationsEndpoint(c *gin.Context) {
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error":   true,
-				"message": "Invalid cursor format",
+				"message": i18n.T(c, "api.invalid_cursor_format"),
 			})
 			return
 		}
@@ -51,7 +56,7 @@ func GetAllNotificationsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -69,7 +74,7 @@ func MarkNotificationReadEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -80,7 +85,7 @@ func MarkNotificationReadEndpoint(c *gin.Context) {
 	if notificationID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Notification ID is required",
+			"message": i18n.T(c, "api.notification_id_required"),
 		})
 		return
 	}
@@ -89,14 +94,14 @@ func MarkNotificationReadEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Notification marked as read",
+		"message": i18n.T(c, "api.notification_read"),
 		"data": gin.H{
 			"notification_id": notificationID,
 			"read":            true,
@@ -111,7 +116,7 @@ func MarkAllNotificationsReadEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -122,14 +127,14 @@ func MarkAllNotificationsReadEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "All notifications marked as read",
+		"message": i18n.T(c, "api.all_notifications_read"),
 		"data": gin.H{
 			"marked_count": count,
 		},
@@ -143,7 +148,7 @@ func GetUnreadNotificationCountEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -154,7 +159,7 @@ func GetUnreadNotificationCountEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -174,7 +179,7 @@ func CreateNotificationEndpoint(c *gin.Context) {
 	if !exists || !isAdmin.(bool) {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin access required",
+			"message": i18n.T(c, "api.admin_access_required"),
 		})
 		return
 	}
@@ -183,7 +188,7 @@ func CreateNotificationEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -196,14 +201,14 @@ func CreateNotificationEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
 		"error":   false,
-		"message": "Notification created successfully",
+		"message": i18n.T(c, "api.notification_created"),
 		"data":    response,
 	})
 }
@@ -214,7 +219,7 @@ func NotificationMarkReadBulkEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -227,7 +232,7 @@ func NotificationMarkReadBulkEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -236,14 +241,14 @@ func NotificationMarkReadBulkEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Notifications marked as read successfully",
+		"message": i18n.T(c, "api.notifications_read"),
 		"data": gin.H{
 			"marked_count": markedCount,
 		},
@@ -256,7 +261,7 @@ func DeleteNotificationEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -269,7 +274,7 @@ func DeleteNotificationEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -278,14 +283,14 @@ func DeleteNotificationEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Notifications deleted successfully",
+		"message": i18n.T(c, "api.notifications_deleted"),
 		"data": gin.H{
 			"deleted_count": deletedCount,
 		},
@@ -299,7 +304,7 @@ func GetNotificationDetailsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -310,7 +315,7 @@ func GetNotificationDetailsEndpoint(c *gin.Context) {
 	if notificationID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Notification ID is required",
+			"message": i18n.T(c, "api.notification_id_required"),
 		})
 		return
 	}
@@ -323,7 +328,7 @@ func GetNotificationDetailsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -333,3 +338,79 @@ func GetNotificationDetailsEndpoint(c *gin.Context) {
 		"data":  response,
 	})
 }
+
+// NotificationStreamEndpoint streams new notifications, unread count changes and transaction
+// status changes to the user as Server-Sent Events. Reconnecting clients resume after the
+// Last-Event-ID header, or the last_event_id query parameter.
+func NotificationStreamEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_authentication_required"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	if lastEventID != "" && !utils.IsValidEventID(lastEventID) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_last_event_id"),
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	stream, err := services.OpenUserEventStream(ctx, userID, lastEventID)
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.notification_stream_unavailable"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+	defer stream.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	// The current unread count has no ID, so it does not move the client's resume point
+	unread, _ := json.Marshal(gin.H{"unread_count": stream.UnreadCount})
+	fmt.Fprint(c.Writer, "retry: 3000\n")
+	writeStreamEvent(c.Writer, utils.UserEvent{Type: constants.EventUnreadCount, Data: unread})
+	for _, event := range stream.Backlog {
+		writeStreamEvent(c.Writer, event)
+	}
+
+	for {
+		event, ok, err := stream.Next(ctx)
+		if err != nil {
+			return
+		}
+		if !ok {
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+			c.Writer.Flush()
+			continue
+		}
+		writeStreamEvent(c.Writer, event)
+	}
+}
+
+// writeStreamEvent writes one Server-Sent Event and flushes it to the client
+func writeStreamEvent(w gin.ResponseWriter, event utils.UserEvent) {
+	if event.ID != "" {
+		fmt.Fprintf(w, "id: %s\n", event.ID)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, event.Data)
+	w.Flush()
+}
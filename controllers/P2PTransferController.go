@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// LookupP2PRecipientEndpoint shows who a username, email or phone number belongs to before sending
+func LookupP2PRecipientEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	recipient, err := services.FindP2PRecipient(userID, c.Query("recipient"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.recipient_found"),
+		"data":    recipient,
+	})
+}
+
+// SendP2PTransferEndpoint sends money to another JeanPay user's wallet
+func SendP2PTransferEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.P2PTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	response, err := services.SendP2PTransfer(userID, req)
+	if err != nil {
+		if services.IsStepUpError(err) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":          true,
+				"message":        i18n.ErrorMessage(c, err),
+				"stepUpRequired": true,
+			})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.transfer_sent"),
+		"data":    response,
+	})
+}
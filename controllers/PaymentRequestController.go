@@ -0,0 +1,258 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// CreatePaymentRequestEndpoint requests money from a user or creates an open payment link
+func CreatePaymentRequestEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.CreatePaymentRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	request, err := services.CreatePaymentRequest(userID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.payment_request_created"),
+		"data":    request,
+	})
+}
+
+// GetPaymentRequestsEndpoint lists payment requests the user sent or received
+func GetPaymentRequestsEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var query types.PaymentRequestQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_query_parameters"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	response, err := services.GetPaymentRequests(userID, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.payment_requests_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":      false,
+		"message":    i18n.T(c, "api.payment_requests_retrieved"),
+		"data":       response.Requests,
+		"pagination": response.Pagination,
+	})
+}
+
+// GetPaymentRequestEndpoint returns a single payment request
+func GetPaymentRequestEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	request, err := services.GetPaymentRequest(userID, c.Param("code"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.payment_request_retrieved"),
+		"data":    request,
+	})
+}
+
+// PayPaymentRequestEndpoint settles a payment request from the user's wallet
+func PayPaymentRequestEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.PayPaymentRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	request, err := services.PayPaymentRequest(userID, c.Param("code"), req)
+	if err != nil {
+		if services.IsStepUpError(err) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":          true,
+				"message":        i18n.ErrorMessage(c, err),
+				"stepUpRequired": true,
+			})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.payment_request_paid"),
+		"data":    request,
+	})
+}
+
+// DeclinePaymentRequestEndpoint declines a payment request addressed to the user
+func DeclinePaymentRequestEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	request, err := services.DeclinePaymentRequest(userID, c.Param("code"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.payment_request_declined"),
+		"data":    request,
+	})
+}
+
+// GetPaymentLinkEndpoint returns the public details of a payment link
+func GetPaymentLinkEndpoint(c *gin.Context) {
+	request, err := services.GetPaymentLink(c.Param("code"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.payment_link_retrieved"),
+		"data":    request,
+	})
+}
+
+// StartPaymentLinkCheckoutEndpoint starts a Paystack checkout for a payment link
+func StartPaymentLinkCheckoutEndpoint(c *gin.Context) {
+	var req types.PaymentRequestCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	checkout, err := services.StartPaymentRequestCheckout(c.Param("code"), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.checkout_started"),
+		"data":    checkout,
+	})
+}
+
+// ConfirmPaymentLinkCheckoutEndpoint settles a payment link once Paystack reports the checkout paid
+func ConfirmPaymentLinkCheckoutEndpoint(c *gin.Context) {
+	if err := services.ConfirmPaymentRequestCheckout(c.Param("reference")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.payment_confirmed"),
+	})
+}
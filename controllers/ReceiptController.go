@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyReceiptEndpoint shows whether a shared receipt link was issued by JeanPay, with the
+// transaction as it stands now
+func VerifyReceiptEndpoint(c *gin.Context) {
+	// Links that fail verification get the same page, without saying why
+	status := http.StatusOK
+	var receipt *types.TransactionReceipt
+	var query types.ReceiptVerifyQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		status = http.StatusNotFound
+	} else if receipt, err = services.GetVerifiedReceipt(c.Param("id"), query); err != nil {
+		status = http.StatusNotFound
+	}
+
+	page, err := services.RenderReceiptVerificationPage(receipt)
+	if err != nil {
+		log.Printf("Failed to render receipt page: %v", err)
+		c.String(http.StatusInternalServerError, i18n.T(c, "api.receipt_verification_unavailable"))
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Robots-Tag", "noindex")
+	c.Data(status, "text/html; charset=utf-8", page)
+}
+
+// DownloadReceiptEndpoint serves the PDF receipt to anyone holding a valid signed link
+func DownloadReceiptEndpoint(c *gin.Context) {
+	var query types.ReceiptVerifyQuery
+	// As with the page, a failed link never says why
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.receipt_not_found"),
+		})
+		return
+	}
+
+	fileName, content, err := services.GetVerifiedReceiptPDF(c.Param("id"), query)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.receipt_not_found"),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, "application/pdf", content)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -17,7 +18,7 @@ func GetUserSettingsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -27,14 +28,14 @@ func GetUserSettingsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User settings retrieved successfully",
+		"message": i18n.T(c, "api.user_settings_retrieved"),
 		"data":    settings,
 	})
 }
@@ -45,7 +46,7 @@ func UpdateUserProfileImageEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -56,7 +57,7 @@ func UpdateUserProfileImageEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid image file",
+			"message": i18n.T(c, "api.invalid_image"),
 		})
 		return
 	}
@@ -65,7 +66,7 @@ func UpdateUserProfileImageEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 			"data":    nil,
 		})
 		return
@@ -77,7 +78,7 @@ func UpdateUserProfileImageEndpoint(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Profile image updated successfully",
+		"message": i18n.T(c, "api.profile_image_updated"),
 		"data":    data,
 	})
 }
@@ -88,7 +89,7 @@ func UpdateUserSettingsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -97,7 +98,7 @@ func UpdateUserSettingsEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -106,14 +107,14 @@ func UpdateUserSettingsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Settings updated successfully",
+		"message": i18n.T(c, "api.settings_updated"),
 		"data":    settings,
 	})
 }
@@ -124,7 +125,7 @@ func ChangePasswordEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -134,7 +135,7 @@ func ChangePasswordEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -143,14 +144,14 @@ func ChangePasswordEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Password changed successfully",
+		"message": i18n.T(c, "api.password_changed"),
 	})
 }
 
@@ -160,7 +161,7 @@ func GetUserPreferencesEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -169,14 +170,14 @@ func GetUserPreferencesEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "User preferences retrieved successfully",
+		"message": i18n.T(c, "api.user_preferences_retrieved"),
 		"data":    preferences,
 	})
 }
@@ -187,7 +188,7 @@ func UpdateUserPreferencesEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -196,7 +197,7 @@ func UpdateUserPreferencesEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -205,14 +206,14 @@ func UpdateUserPreferencesEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Preferences updated successfully",
+		"message": i18n.T(c, "api.preferences_updated"),
 		"data":    preferences,
 	})
 }
@@ -223,7 +224,7 @@ func EnableTwoFactorAuthEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -233,14 +234,14 @@ func EnableTwoFactorAuthEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Two-factor authentication enabled successfully",
+		"message": i18n.T(c, "api.two_factor_enabled"),
 	})
 }
 
@@ -250,7 +251,7 @@ func DisableTwoFactorAuthEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -260,14 +261,14 @@ func DisableTwoFactorAuthEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Two-factor authentication disabled successfully",
+		"message": i18n.T(c, "api.two_factor_disabled"),
 	})
 }
 
@@ -277,7 +278,7 @@ func DeactivateAccountEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -288,7 +289,7 @@ func DeactivateAccountEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -297,14 +298,14 @@ func DeactivateAccountEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Account deactivated successfully",
+		"message": i18n.T(c, "api.account_deactivated"),
 	})
 }
 
@@ -314,7 +315,7 @@ func GetSecuritySettingsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -324,7 +325,7 @@ func GetSecuritySettingsEndpoint(c *gin.Context) {
 	if err := database.DB.Where("user_id = ?", userID.(uint32)).First(&user).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to retrieve user information",
+			"message": i18n.T(c, "api.user_information_retrieve_failed"),
 		})
 		return
 	}
@@ -338,7 +339,7 @@ func GetSecuritySettingsEndpoint(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Security settings retrieved successfully",
+		"message": i18n.T(c, "api.security_settings_retrieved"),
 		"data":    securitySettings,
 	})
 }
@@ -349,7 +350,7 @@ func UpdatePreferencesEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -360,8 +361,8 @@ func UpdatePreferencesEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -370,14 +371,14 @@ func UpdatePreferencesEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Preferences updated successfully",
+		"message": i18n.T(c, "api.preferences_updated"),
 		"data":    preferences,
 	})
 }
@@ -388,7 +389,7 @@ func UpdateProfileEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -399,8 +400,8 @@ func UpdateProfileEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -409,14 +410,14 @@ func UpdateProfileEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Profile updated successfully",
+		"message": i18n.T(c, "api.profile_updated"),
 		"data":    profile,
 	})
 }
@@ -427,7 +428,7 @@ func UpdateSecuritySettingsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -438,24 +439,62 @@ func UpdateSecuritySettingsEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	settings, err := services.UpdateSecuritySettings(claims.UserID, req)
 	if err != nil {
+		if services.IsStepUpError(err) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":          true,
+				"message":        i18n.ErrorMessage(c, err),
+				"stepUpRequired": true,
+			})
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Security settings updated successfully",
+		"message": i18n.T(c, "api.security_settings_updated"),
+		"data":    settings,
+	})
+}
+
+// GetNotificationSettingsEndpoint returns the user's notification preferences by category and channel
+func GetNotificationSettingsEndpoint(c *gin.Context) {
+	claimsAny, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	claims := claimsAny.(*libs.JWTClaims)
+
+	settings, err := services.GetNotificationSettings(claims.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.notification_settings_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.notification_settings_retrieved"),
 		"data":    settings,
 	})
 }
@@ -466,7 +505,7 @@ func UpdateNotificationSettingsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -477,24 +516,24 @@ func UpdateNotificationSettingsEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
-	settings, err := services.UpdateNotificationSettings(claims.UserID, req)
+	settings, err := services.UpdateNotificationSettings(claims.ID, req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Notification settings updated successfully",
+		"message": i18n.T(c, "api.notification_settings_updated"),
 		"data":    settings,
 	})
 }
@@ -505,7 +544,7 @@ func GenerateTwoFactorQREndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -516,14 +555,14 @@ func GenerateTwoFactorQREndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Two-factor QR code generated successfully",
+		"message": i18n.T(c, "api.two_factor_qr_generated"),
 		"data":    qrData,
 	})
 }
@@ -534,7 +573,7 @@ func EnableTwoFactorEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -545,8 +584,8 @@ func EnableTwoFactorEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -555,14 +594,14 @@ func EnableTwoFactorEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Two-factor authentication enabled successfully",
+		"message": i18n.T(c, "api.two_factor_enabled"),
 	})
 }
 
@@ -572,7 +611,7 @@ func DisableTwoFactorEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -583,24 +622,32 @@ func DisableTwoFactorEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	err := services.DisableTwoFactorAuthentication(uint(claims.UserID), req)
 	if err != nil {
+		if services.IsStepUpError(err) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":          true,
+				"message":        i18n.ErrorMessage(c, err),
+				"stepUpRequired": true,
+			})
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Two-factor authentication disabled successfully",
+		"message": i18n.T(c, "api.two_factor_disabled"),
 	})
 }
 
@@ -609,7 +656,7 @@ func SettingsWalletEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -620,8 +667,8 @@ func SettingsWalletEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -630,13 +677,13 @@ func SettingsWalletEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Wallet settings updated successfully",
+		"message": i18n.T(c, "api.wallet_settings_updated"),
 	})
 }
@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -18,7 +21,7 @@ func CreateTransactionEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -28,42 +31,42 @@ func CreateTransactionEndpoint(c *gin.Context) {
 	if transaction.FromAmount == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "FromAmount is required",
+			"message": i18n.T(c, "api.from_amount_required"),
 		})
 		return
 	}
 	if transaction.ToAmount == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "ToAmount is required",
+			"message": i18n.T(c, "api.to_amount_required"),
 		})
 		return
 	}
 	if transaction.FromCurrency == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "FromCurrency is required",
+			"message": i18n.T(c, "api.from_currency_required"),
 		})
 		return
 	}
 	if transaction.ToCurrency == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "ToCurrency is required",
+			"message": i18n.T(c, "api.to_currency_required"),
 		})
 		return
 	}
 	if transaction.FromCurrency == transaction.ToCurrency {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "FromCurrency and ToCurrency cannot be the same",
+			"message": i18n.T(c, "api.conversion_same_currency"),
 		})
 		return
 	}
 	if transaction.MethodOfPayment == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "MethodOfPayment is required",
+			"message": i18n.T(c, "api.payment_method_required"),
 		})
 		return
 	}
@@ -72,7 +75,7 @@ func CreateTransactionEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 			"code":    codeError,
 		})
 		return
@@ -90,7 +93,7 @@ func GetUserTransactionHistoryEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -118,7 +121,7 @@ func GetUserTransactionHistoryEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -129,13 +132,13 @@ func GetUserTransactionHistoryEndpoint(c *gin.Context) {
 	})
 }
 
-// GetTransactionDetailsEndpoint returns transaction details
+// GetTransactionDetailsEndpoint returns transaction details, or the PDF receipt with format=pdf
 func GetTransactionDetailsEndpoint(c *gin.Context) {
 	transactionID := c.Param("id")
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -144,16 +147,30 @@ func GetTransactionDetailsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
 	user := claims.(*libs.JWTClaims)
+	if c.Query("format") == "pdf" {
+		fileName, content, err := services.GetTransactionReceiptPDF(transactionID, user.ID, user.IsAdmin)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   true,
+				"message": i18n.ErrorMessage(c, err),
+			})
+			return
+		}
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+		c.Header("Cache-Control", "private, no-store")
+		c.Data(http.StatusOK, "application/pdf", content)
+		return
+	}
 	response, err := services.GetTransactionDetailsService(transactionID, user.ID, user.IsAdmin)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -163,48 +180,45 @@ func GetTransactionDetailsEndpoint(c *gin.Context) {
 	})
 }
 
-// UpdateTransactionStatusEndpoint updates transaction status (Admin only)
-func UpdateTransactionStatusEndpoint(c *gin.Context) {
-	// Check if user is admin
-	isAdmin, exists := c.Get("is_admin")
-	if !exists || !isAdmin.(bool) {
-		c.JSON(http.StatusForbidden, gin.H{
+// RevokeReceiptLinksEndpoint stops every shared link to a transaction's receipt from working
+// and returns a new one
+func RevokeReceiptLinksEndpoint(c *gin.Context) {
+	transactionID := c.Param("id")
+	if transactionID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Admin access required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
-	transactionID := c.Param("id")
-	if transactionID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
-	var request types.UpdateTransactionStatusRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+	user := claims.(*libs.JWTClaims)
+	receiptURL, err := services.RevokeReceiptLinks(transactionID, user.ID)
+	if errors.Is(err, services.ErrReceiptNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.transaction_not_found"),
 		})
 		return
 	}
-	// Get admin ID from JWT token
-	adminIDInterface, _ := c.Get("user_id")
-	adminID := (adminIDInterface.(uint32))
-	response, err := services.UpdateTransactionStatusService(transactionID, request, adminID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.T(c, "api.receipt_links_revoke_failed"),
 		})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Transaction status updated successfully",
-		"data":    response,
+		"message": i18n.T(c, "api.receipt_links_revoked"),
+		"data":    gin.H{"receipt_url": receiptURL},
 	})
 }
 
@@ -215,7 +229,7 @@ func CreateDepositTransactionEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -224,7 +238,7 @@ func CreateDepositTransactionEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -232,13 +246,13 @@ func CreateDepositTransactionEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{
 		"error":   false,
-		"message": "Deposit transaction created successfully",
+		"message": i18n.T(c, "api.deposit_created"),
 		"data":    response,
 	})
 }
@@ -250,7 +264,7 @@ func CreateWithdrawalTransactionEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -259,7 +273,7 @@ func CreateWithdrawalTransactionEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
+			"message": i18n.T(c, "api.invalid_request_data"),
 		})
 		return
 	}
@@ -267,13 +281,13 @@ func CreateWithdrawalTransactionEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{
 		"error":   false,
-		"message": "Withdrawal transaction created successfully",
+		"message": i18n.T(c, "api.withdrawal_created"),
 		"data":    response,
 	})
 }
@@ -285,7 +299,7 @@ func GetUserTransactionStatsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User authentication required",
+			"message": i18n.T(c, "api.user_authentication_required"),
 		})
 		return
 	}
@@ -294,7 +308,7 @@ func GetUserTransactionStatsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -310,7 +324,7 @@ func FilterTransactionsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -319,8 +333,8 @@ func FilterTransactionsEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&filterReq); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid filter criteria",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_filter"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -328,7 +342,7 @@ func FilterTransactionsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"net/http"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/gin-gonic/gin"
@@ -11,7 +12,7 @@ import (
 func FetchUserEndpoint(c *gin.Context) {
 	claimsAny, exists := c.Get("user")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "user not found in context", "error": true})
+		c.JSON(http.StatusUnauthorized, gin.H{"message": i18n.T(c, "api.user_not_authenticated"), "error": true})
 		return
 	}
 
@@ -20,13 +21,13 @@ func FetchUserEndpoint(c *gin.Context) {
 	response, err := services.FetchUser(claims.ID)
 
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "user not found in context 3", "error": true})
+		c.JSON(http.StatusUnauthorized, gin.H{"message": i18n.T(c, "api.user_not_found"), "error": true})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"user":    response,
 		"error":   false,
-		"message": "User fetched successfully",
+		"message": i18n.T(c, "api.user_fetched"),
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -14,7 +15,7 @@ import (
 func GetWalletBalanceEndpoint(c *gin.Context) {
 	claimsAny, exists := c.Get("user")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "user not found in context", "error": true})
+		c.JSON(http.StatusUnauthorized, gin.H{"message": i18n.T(c, "api.user_not_authenticated"), "error": true})
 		return
 	}
 
@@ -23,14 +24,14 @@ func GetWalletBalanceEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Wallet balance retrieved successfully",
+		"message": i18n.T(c, "api.wallet_balance_retrieved"),
 		"data":    balance,
 	})
 }
@@ -41,7 +42,7 @@ func TopUpWalletEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -52,8 +53,8 @@ func TopUpWalletEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -62,14 +63,14 @@ func TopUpWalletEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Wallet top-up initiated successfully",
+		"message": i18n.T(c, "api.wallet_top_up_initiated"),
 		"data":    response,
 	})
 }
@@ -80,7 +81,7 @@ func WithdrawFromWalletEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -90,24 +91,32 @@ func WithdrawFromWalletEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	response, err := services.WithdrawFromWallet(userId, req)
 	if err != nil {
+		if services.IsStepUpError(err) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":          true,
+				"message":        i18n.ErrorMessage(c, err),
+				"stepUpRequired": true,
+			})
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Wallet withdrawal initiated successfully",
+		"message": i18n.T(c, "api.wallet_withdrawal_initiated"),
 		"data":    response,
 	})
 }
@@ -118,7 +127,7 @@ func GetWalletHistoryEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -148,14 +157,14 @@ func GetWalletHistoryEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":      false,
-		"message":    "Wallet history retrieved successfully",
+		"message":    i18n.T(c, "api.wallet_history_retrieved"),
 		"data":       history,
 		"pagination": paginationResp,
 	})
@@ -172,8 +181,8 @@ func UpdateWalletAfterPaymentEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -182,14 +191,14 @@ func UpdateWalletAfterPaymentEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Wallet updated successfully",
+		"message": i18n.T(c, "api.wallet_updated"),
 	})
 }
 
@@ -199,7 +208,7 @@ func GetTopUpDetailsEndpoint(c *gin.Context) {
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   true,
-			"message": "User not authenticated",
+			"message": i18n.T(c, "api.user_not_authenticated"),
 		})
 		return
 	}
@@ -210,7 +219,7 @@ func GetTopUpDetailsEndpoint(c *gin.Context) {
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Transaction ID is required",
+			"message": i18n.T(c, "api.transaction_id_required"),
 		})
 		return
 	}
@@ -219,14 +228,14 @@ func GetTopUpDetailsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Topup details retrieved successfully",
+		"message": i18n.T(c, "api.top_up_details_retrieved"),
 		"data":    topupDetails,
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -21,7 +22,7 @@ func HandlePaystackWebhookEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Failed to read request body",
+			"message": i18n.T(c, "api.read_request_body_failed"),
 		})
 		return
 	}
@@ -31,7 +32,7 @@ func HandlePaystackWebhookEndpoint(c *gin.Context) {
 	if signature == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Missing webhook signature",
+			"message": i18n.T(c, "api.webhook_signature_missing"),
 		})
 		return
 	}
@@ -41,14 +42,82 @@ func HandlePaystackWebhookEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Webhook processed successfully",
+		"message": i18n.T(c, "api.webhook_processed"),
+	})
+}
+
+// HandleSMSDeliveryReportEndpoint records delivery reports posted by the SMS gateway
+func HandleSMSDeliveryReportEndpoint(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.read_request_body_failed"),
+		})
+		return
+	}
+
+	signature := c.GetHeader("X-SMS-Signature")
+	if signature == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.webhook_signature_missing"),
+		})
+		return
+	}
+
+	if err := services.HandleSMSDeliveryReport(body, signature); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.delivery_report_processed"),
+	})
+}
+
+// HandleEmailEventsEndpoint records delivery, bounce and complaint events posted by the email provider
+func HandleEmailEventsEndpoint(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.read_request_body_failed"),
+		})
+		return
+	}
+
+	signature := c.GetHeader("X-Email-Signature")
+	if signature == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.webhook_signature_missing"),
+		})
+		return
+	}
+
+	if err := services.HandleEmailEvents(body, signature); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.email_events_processed"),
 	})
 }
 
@@ -59,7 +128,7 @@ func HandleMomoWebhookEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Failed to read request body",
+			"message": i18n.T(c, "api.read_request_body_failed"),
 		})
 		return
 	}
@@ -77,7 +146,7 @@ func HandleMomoWebhookEndpoint(c *gin.Context) {
 	if signature == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Missing webhook signature",
+			"message": i18n.T(c, "api.webhook_signature_missing"),
 		})
 		return
 	}
@@ -87,14 +156,14 @@ func HandleMomoWebhookEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Webhook processed successfully",
+		"message": i18n.T(c, "api.webhook_processed"),
 	})
 }
 
@@ -105,7 +174,7 @@ func GetWebhookEventLogsEndpoint(c *gin.Context) {
 	if !exists || !isAdmin.(bool) {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Admin access required",
+			"message": i18n.T(c, "api.admin_access_required"),
 		})
 		return
 	}
@@ -138,14 +207,14 @@ func GetWebhookEventLogsEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": err.Error(),
+			"message": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":      false,
-		"message":    "Webhook logs retrieved successfully",
+		"message":    i18n.T(c, "api.webhook_logs_retrieved"),
 		"data":       logs,
 		"pagination": paginationResp,
 	})
@@ -157,7 +226,7 @@ func TestWebhookEndpoint(c *gin.Context) {
 	if !constants.IsDevelopment() {
 		c.JSON(http.StatusForbidden, gin.H{
 			"error":   true,
-			"message": "Test endpoint only available in development",
+			"message": i18n.T(c, "api.test_endpoint_development_only"),
 		})
 		return
 	}
@@ -171,8 +240,8 @@ func TestWebhookEndpoint(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Invalid request data",
-			"details": err.Error(),
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
 		})
 		return
 	}
@@ -187,7 +256,7 @@ func TestWebhookEndpoint(c *gin.Context) {
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   true,
-			"message": "Failed to create test payload",
+			"message": i18n.T(c, "api.test_payload_create_failed"),
 		})
 		return
 	}
@@ -205,7 +274,7 @@ func TestWebhookEndpoint(c *gin.Context) {
 	default:
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": "Unsupported provider",
+			"message": i18n.T(c, "api.unsupported_provider"),
 		})
 		return
 	}
@@ -213,14 +282,14 @@ func TestWebhookEndpoint(c *gin.Context) {
 	if processErr != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   true,
-			"message": processErr.Error(),
+			"message": i18n.ErrorMessage(c, processErr),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Test webhook processed successfully",
+		"message": i18n.T(c, "api.test_webhook_processed"),
 		"data": gin.H{
 			"provider": req.Provider,
 			"event":    req.Event,
@@ -233,7 +302,7 @@ func TestWebhookEndpoint(c *gin.Context) {
 func WebhookHealthCheckEndpoint(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"error":   false,
-		"message": "Webhook endpoints are healthy",
+		"message": i18n.T(c, "api.webhooks_healthy"),
 		"data": gin.H{
 			"status":    "ok",
 			"timestamp": time.Now(),
@@ -0,0 +1,258 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetWithdrawMethodsEndpoint lists the user's withdrawal methods, optionally for one currency
+func GetWithdrawMethodsEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	methods, err := services.GetWithdrawMethods(userID, c.Query("currency"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.withdrawal_methods_retrieve_failed"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.withdrawal_methods_retrieved"),
+		"data":    methods,
+	})
+}
+
+// ResolveAccountNameEndpoint looks up the account holder's name before a method is saved
+func ResolveAccountNameEndpoint(c *gin.Context) {
+	if _, exists := c.Get("user"); !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	var req types.ResolveAccountNameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	accountName, err := services.ResolveAccountName(req.Method, req.AccountNumber, req.BankCode)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.account_name_resolved"),
+		"data": types.ResolveAccountNameResponse{
+			AccountNumber: req.AccountNumber,
+			AccountName:   accountName,
+		},
+	})
+}
+
+// CreateWithdrawMethodEndpoint verifies and saves a withdrawal method
+func CreateWithdrawMethodEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	var req types.CreateWithdrawMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	method, err := services.CreateWithdrawMethod(userID, req)
+	if err != nil {
+		respondWithdrawMethodError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.withdrawal_method_saved"),
+		"data":    method,
+	})
+}
+
+// UpdateWithdrawMethodEndpoint changes a withdrawal method's account details
+func UpdateWithdrawMethodEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	methodID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_withdrawal_method_id"),
+		})
+		return
+	}
+
+	var req types.UpdateWithdrawMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_request_data"),
+			"details": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	method, err := services.UpdateWithdrawMethod(userID, uint(methodID), req)
+	if err != nil {
+		respondWithdrawMethodError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.withdrawal_method_updated"),
+		"data":    method,
+	})
+}
+
+// SetDefaultWithdrawMethodEndpoint makes a method the default for its currency
+func SetDefaultWithdrawMethodEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	methodID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_withdrawal_method_id"),
+		})
+		return
+	}
+
+	method, err := services.SetDefaultWithdrawMethod(userID, uint(methodID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.default_withdrawal_method_updated"),
+		"data":    method,
+	})
+}
+
+// DeleteWithdrawMethodEndpoint removes a withdrawal method
+func DeleteWithdrawMethodEndpoint(c *gin.Context) {
+	claims, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.user_not_authenticated"),
+		})
+		return
+	}
+
+	userID := claims.(*libs.JWTClaims).ID
+
+	methodID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.T(c, "api.invalid_withdrawal_method_id"),
+		})
+		return
+	}
+
+	if err := services.DeleteWithdrawMethod(userID, uint(methodID)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   true,
+			"message": i18n.ErrorMessage(c, err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error":   false,
+		"message": i18n.T(c, "api.withdrawal_method_deleted"),
+	})
+}
+
+// respondWithdrawMethodError maps step-up and duplicate errors to their status codes
+func respondWithdrawMethodError(c *gin.Context, err error) {
+	if services.IsStepUpError(err) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":          true,
+			"message":        i18n.ErrorMessage(c, err),
+			"stepUpRequired": true,
+		})
+		return
+	}
+
+	status := http.StatusBadRequest
+	if errors.Is(err, services.ErrWithdrawMethodExists) {
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"error":   true,
+		"message": i18n.ErrorMessage(c, err),
+	})
+}
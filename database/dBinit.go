@@ -1,6 +1,7 @@
 package database
 
 import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"gorm.io/driver/postgres"
@@ -26,6 +27,9 @@ func InitDB() {
 }
 
 func autoMigrate(db *gorm.DB) {
+	renameLegacyTables(db)
+	dropLegacyIndexes(db)
+
 	db.AutoMigrate(
 		&models.User{},
 		&models.AdminLog{},
@@ -42,8 +46,33 @@ func autoMigrate(db *gorm.DB) {
 		&models.WithdrawMethod{},
 		&models.SavedRecipient{},
 		&models.PlatformSetting{},
+		&models.AdminPermission{},
+		&models.AdminRole{},
+		&models.AdminChangeRequest{},
+		&models.DualApprovalPolicy{},
+		&models.P2PTransfer{},
+		&models.PaymentRequest{},
+		&models.ScheduledTransfer{},
+		&models.ScheduledTransferRun{},
+		&models.LimitOrder{},
+		&models.RateAlert{},
+		&models.Currency{},
+		&models.Corridor{},
+		&models.Statement{},
+		&models.SMSMessage{},
+		&models.DeviceToken{},
+		&models.NotificationPreference{},
+		&models.Campaign{},
+		&models.CampaignRecipient{},
+		&models.EmailTemplateVersion{},
+		&models.EmailDelivery{},
+		&models.EmailSuppression{},
 	)
 
+	seedCurrencies(db)
+	seedAdminRoles(db)
+	seedDualApprovalPolicies(db)
+
 	// Seed admin user if it doesn't exist
 	var count int64
 	db.Model(&models.User{}).Where("email = ?", "admin@jeanpay.africa").Count(&count)
@@ -70,4 +99,122 @@ func autoMigrate(db *gorm.DB) {
 			},
 		})
 	}
+
+	assignDefaultAdminRoles(db)
+}
+
+// renameLegacyTables moves tables created under GORM's default names before a model set its own
+func renameLegacyTables(db *gorm.DB) {
+	migrator := db.Migrator()
+	if migrator.HasTable("withdraw_methods") && !migrator.HasTable(&models.WithdrawMethod{}) {
+		migrator.RenameTable("withdraw_methods", &models.WithdrawMethod{})
+	}
+}
+
+// dropLegacyIndexes removes indexes whose definition changed so AutoMigrate recreates them
+func dropLegacyIndexes(db *gorm.DB) {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.Conversions{}) {
+		return
+	}
+	// conversions.user_id used to be unique, which allowed only one conversion per user
+	indexes, err := migrator.GetIndexes(&models.Conversions{})
+	if err != nil {
+		return
+	}
+	for _, index := range indexes {
+		if unique, ok := index.Unique(); ok && unique && index.Name() == "idx_conversions_user_id" {
+			migrator.DropIndex(&models.Conversions{}, index.Name())
+		}
+	}
+}
+
+// seedAdminRoles makes sure every known permission and built-in role exists
+func seedAdminRoles(db *gorm.DB) {
+	permissions := make(map[string]models.AdminPermission)
+	added := make(map[string]bool)
+	for name, description := range constants.AdminPermissionDescriptions {
+		permission := models.AdminPermission{Name: name}
+		result := db.Where(models.AdminPermission{Name: name}).Attrs(models.AdminPermission{Description: description}).FirstOrCreate(&permission)
+		permissions[name] = permission
+		added[name] = result.RowsAffected > 0
+	}
+
+	for roleName, rolePermissions := range constants.DefaultAdminRoles {
+		var role models.AdminRole
+		result := db.Where("name = ?", roleName).First(&role)
+		if result.Error == nil {
+			// Grant permissions introduced since the role was seeded
+			for _, name := range rolePermissions {
+				if added[name] {
+					permission := permissions[name]
+					db.Model(&role).Association("Permissions").Append(&permission)
+				}
+			}
+			continue
+		}
+
+		role = models.AdminRole{
+			Name:        roleName,
+			DisplayName: constants.AdminRoleDisplayNames[roleName],
+			IsSystem:    true,
+		}
+		for _, name := range rolePermissions {
+			role.Permissions = append(role.Permissions, permissions[name])
+		}
+		db.Create(&role)
+	}
+}
+
+// seedCurrencies registers the launch currencies and corridors. Existing rows are left alone so
+// admin changes survive restarts.
+func seedCurrencies(db *gorm.DB) {
+	currencies := []models.Currency{
+		{Code: "NGN", Name: "Nigerian Naira", Symbol: "₦", MinorUnits: 2, Country: string(models.Nigeria), SortOrder: 1, IsEnabled: true},
+		{Code: "GHS", Name: "Ghanaian Cedi", Symbol: "GH₵", MinorUnits: 2, Country: string(models.Ghana), SortOrder: 2, IsEnabled: true},
+	}
+	for _, currency := range currencies {
+		db.Where(models.Currency{Code: currency.Code}).Attrs(currency).FirstOrCreate(&models.Currency{})
+	}
+
+	corridors := []models.Corridor{
+		{FromCurrency: "NGN", ToCurrency: "GHS", DefaultRate: 0.0053, IsEnabled: true},
+		{FromCurrency: "GHS", ToCurrency: "NGN", DefaultRate: 188.68, IsEnabled: true},
+	}
+	for _, corridor := range corridors {
+		db.Where(models.Corridor{FromCurrency: corridor.FromCurrency, ToCurrency: corridor.ToCurrency}).Attrs(corridor).FirstOrCreate(&models.Corridor{})
+	}
+}
+
+// seedDualApprovalPolicies creates the default maker-checker policies
+func seedDualApprovalPolicies(db *gorm.DB) {
+	policies := []models.DualApprovalPolicy{
+		{ActionType: models.ChangeApproveTransaction, Enabled: true, Threshold: 1000000, Description: "Approving transactions at or above this amount"},
+		{ActionType: models.ChangeAddRate, Enabled: true, Threshold: 0, Description: "Adding exchange rates"},
+		{ActionType: models.ChangeUpdateRate, Enabled: true, Threshold: 5, Description: "Changing an exchange rate by at least this percentage"},
+		{ActionType: models.ChangeDeleteRate, Enabled: true, Threshold: 0, Description: "Deleting exchange rates"},
+		{ActionType: models.ChangePlatformSettings, Enabled: true, Threshold: 1, Description: "Changing at least this many platform settings"},
+	}
+	for _, policy := range policies {
+		db.Where(models.DualApprovalPolicy{ActionType: policy.ActionType}).Attrs(policy).FirstOrCreate(&models.DualApprovalPolicy{})
+	}
+}
+
+// assignDefaultAdminRoles gives admins created before roles existed a role.
+// The seeded admin becomes super admin, anyone else starts read-only.
+func assignDefaultAdminRoles(db *gorm.DB) {
+	var superAdmin, readOnly models.AdminRole
+	if err := db.Where("name = ?", constants.AdminRoleSuperAdmin).First(&superAdmin).Error; err != nil {
+		return
+	}
+	if err := db.Where("name = ?", constants.AdminRoleReadOnly).First(&readOnly).Error; err != nil {
+		return
+	}
+
+	db.Model(&models.User{}).
+		Where("email = ? AND is_admin = ? AND admin_role_id IS NULL", "admin@jeanpay.africa", true).
+		Update("admin_role_id", superAdmin.ID)
+	db.Model(&models.User{}).
+		Where("is_admin = ? AND admin_role_id IS NULL", true).
+		Update("admin_role_id", readOnly.ID)
 }
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ChangeActionType string
+
+const (
+	ChangeApproveTransaction ChangeActionType = "approve_transaction"
+	ChangeAddRate            ChangeActionType = "add_rate"
+	ChangeUpdateRate         ChangeActionType = "update_rate"
+	ChangeDeleteRate         ChangeActionType = "delete_rate"
+	ChangePlatformSettings   ChangeActionType = "update_platform_settings"
+)
+
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestPending  ChangeRequestStatus = "pending"
+	ChangeRequestApproved ChangeRequestStatus = "approved"
+	ChangeRequestRejected ChangeRequestStatus = "rejected"
+	ChangeRequestFailed   ChangeRequestStatus = "failed"
+)
+
+// AdminChangeRequest is an admin action waiting for a second admin to approve it
+type AdminChangeRequest struct {
+	gorm.Model
+	ActionType     ChangeActionType    `json:"action_type" gorm:"not null;index"`
+	TargetID       string              `json:"target_id"`
+	Payload        string              `json:"payload" gorm:"type:text"` // JSON request to execute once approved
+	Diff           string              `json:"diff" gorm:"type:text"`    // JSON map of field -> {from, to}
+	Metric         float64             `json:"metric"`                   // value compared against the policy threshold
+	Status         ChangeRequestStatus `json:"status" gorm:"not null;default:'pending';index"`
+	MakerID        uint                `json:"maker_id" gorm:"not null"`
+	CheckerID      *uint               `json:"checker_id"`
+	ReviewNote     string              `json:"review_note"`
+	ReviewedAt     *time.Time          `json:"reviewed_at"`
+	ExecutionError string              `json:"execution_error"`
+}
+
+func (AdminChangeRequest) TableName() string {
+	return "admin_change_requests"
+}
+
+// DualApprovalPolicy configures when an action type needs a second admin.
+// A change request is created when the policy is enabled and the metric reaches the threshold.
+type DualApprovalPolicy struct {
+	gorm.Model
+	ActionType  ChangeActionType `json:"action_type" gorm:"uniqueIndex;not null"`
+	Enabled     bool             `json:"enabled" gorm:"default:true"`
+	Threshold   float64          `json:"threshold" gorm:"default:0"`
+	Description string           `json:"description"`
+}
+
+func (DualApprovalPolicy) TableName() string {
+	return "dual_approval_policies"
+}
@@ -13,6 +13,9 @@ type AdminLog struct {
 	Details   string `json:"details" gorm:"type:string"`
 	IPAddress string `json:"ip_address"`
 	UserAgent string `json:"user_agent"`
+	RequestID string `json:"request_id" gorm:"index"`
+	Before    string `json:"before" gorm:"type:text"` // JSON snapshot before an update
+	After     string `json:"after" gorm:"type:text"`  // JSON snapshot after an update
 }
 
 func (AdminLog) TableName() string {
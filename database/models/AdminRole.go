@@ -0,0 +1,28 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type AdminRole struct {
+	gorm.Model
+	Name        string            `json:"name" gorm:"uniqueIndex;not null"`
+	DisplayName string            `json:"display_name"`
+	Description string            `json:"description"`
+	IsSystem    bool              `json:"is_system" gorm:"default:false"` // built-in roles cannot be deleted
+	Permissions []AdminPermission `json:"permissions" gorm:"many2many:admin_role_permissions;"`
+}
+
+func (AdminRole) TableName() string {
+	return "admin_roles"
+}
+
+type AdminPermission struct {
+	gorm.Model
+	Name        string `json:"name" gorm:"uniqueIndex;not null"` // e.g. 'transactions.approve'
+	Description string `json:"description"`
+}
+
+func (AdminPermission) TableName() string {
+	return "admin_permissions"
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CampaignStatus string
+
+const (
+	CampaignDraft     CampaignStatus = "draft"
+	CampaignScheduled CampaignStatus = "scheduled"
+	CampaignSending   CampaignStatus = "sending"
+	CampaignSent      CampaignStatus = "sent"
+	CampaignCancelled CampaignStatus = "cancelled"
+)
+
+type CampaignRecipientStatus string
+
+const (
+	RecipientPending   CampaignRecipientStatus = "pending"
+	RecipientQueued    CampaignRecipientStatus = "queued"    // handed to its channels, no result yet
+	RecipientDelivered CampaignRecipientStatus = "delivered" // a channel reported delivery
+	RecipientFailed    CampaignRecipientStatus = "failed"    // every channel it was queued on failed
+	RecipientSkipped   CampaignRecipientStatus = "skipped"   // the user turned off every chosen channel
+)
+
+// CampaignSegment narrows a campaign's audience. Empty fields do not filter.
+type CampaignSegment struct {
+	Country          string   `json:"country"`
+	MinKycTier       *int     `json:"min_kyc_tier"` // with no KYC bounds only verified users are reached
+	MaxKycTier       *int     `json:"max_kyc_tier"`
+	WalletCurrency   string   `json:"wallet_currency"` // users holding a wallet in this currency
+	MinBalance       *float64 `json:"min_balance"`     // balance band of the WalletCurrency wallet
+	MaxBalance       *float64 `json:"max_balance"`
+	ActiveWithinDays int      `json:"active_within_days"` // logged in or transacted recently
+	InactiveForDays  int      `json:"inactive_for_days"`  // no logins or transactions for this long
+}
+
+// Campaign is an admin broadcast sent to a segment of users over the channels it names
+type Campaign struct {
+	gorm.Model
+	Title           string          `json:"title" gorm:"not null"`
+	Message         string          `json:"message" gorm:"not null"`
+	Channels        string          `json:"channels" gorm:"not null"` // comma separated NotificationChannel values
+	Segment         CampaignSegment `json:"segment" gorm:"embedded;embeddedPrefix:segment_"`
+	Status          CampaignStatus  `json:"status" gorm:"default:draft;index"`
+	ScheduledAt     *time.Time      `json:"scheduled_at"`
+	StartedAt       *time.Time      `json:"started_at"`
+	CompletedAt     *time.Time      `json:"completed_at"`
+	TotalRecipients int             `json:"total_recipients" gorm:"default:0"`
+	CreatedBy       uint            `json:"created_by" gorm:"not null"`
+}
+
+func (Campaign) TableName() string {
+	return "campaigns"
+}
+
+// CampaignRecipient tracks delivery of a campaign to one user. Batch groups recipients
+// into the throttled delivery tasks. The status moves from queued as the channels report back.
+type CampaignRecipient struct {
+	gorm.Model
+	CampaignID     uint                    `json:"campaign_id" gorm:"not null;uniqueIndex:idx_campaign_recipient;index:idx_campaign_batch"`
+	UserID         uint                    `json:"user_id" gorm:"not null;uniqueIndex:idx_campaign_recipient"`
+	Batch          int                     `json:"batch" gorm:"not null;index:idx_campaign_batch"`
+	Status         CampaignRecipientStatus `json:"status" gorm:"default:pending;index"`
+	Channels       string                  `json:"channels"`        // channels the message was queued on
+	FailedChannels string                  `json:"failed_channels"` // queued channels that reported failure
+	Error          string                  `json:"error"`
+	DeliveredAt    *time.Time              `json:"delivered_at"`
+}
+
+func (CampaignRecipient) TableName() string {
+	return "campaign_recipients"
+}
@@ -14,7 +14,7 @@ const (
 
 type Conversions struct {
 	gorm.Model
-	UserID           uint             `json:"user_id" gorm:"not null;uniqueIndex"`
+	UserID           uint             `json:"user_id" gorm:"not null;index"`
 	ConversionID     string           `json:"conversion_id" gorm:"not null;uniqueIndex"`
 	TransactionID    string           `json:"transaction_id" gorm:"not null"`
 	FromCurrency     string           `json:"from_currency" gorm:"not null"`
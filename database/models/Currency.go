@@ -0,0 +1,37 @@
+package models
+
+import "gorm.io/gorm"
+
+// Currency is a registry entry. Enabled currencies get wallets and pass validation everywhere.
+type Currency struct {
+	gorm.Model
+	Code       string `json:"code" gorm:"not null;uniqueIndex;size:3"`
+	Name       string `json:"name" gorm:"not null"`
+	Symbol     string `json:"symbol" gorm:"not null"`
+	MinorUnits int    `json:"minor_units" gorm:"default:2"`
+	Country    string `json:"country"` // ISO country code whose users default to this currency
+	SortOrder  int    `json:"sort_order" gorm:"default:0"`
+	IsEnabled  bool   `json:"is_enabled" gorm:"default:true"`
+	// StepUpThreshold is the amount in this currency from which a fresh emailed code is
+	// required. 0 falls back to the platform threshold converted at the active rate.
+	StepUpThreshold float64 `json:"step_up_threshold" gorm:"default:0"`
+}
+
+func (Currency) TableName() string {
+	return "currencies"
+}
+
+// Corridor is a currency pair that can be converted or sent across
+type Corridor struct {
+	gorm.Model
+	FromCurrency string  `json:"from_currency" gorm:"not null;uniqueIndex:idx_corridors_pair,priority:1"`
+	ToCurrency   string  `json:"to_currency" gorm:"not null;uniqueIndex:idx_corridors_pair,priority:2"`
+	DefaultRate  float64 `json:"default_rate" gorm:"default:0"` // used until a rate is published for the pair
+	MinAmount    float64 `json:"min_amount" gorm:"default:0"`
+	MaxAmount    float64 `json:"max_amount" gorm:"default:0"` // 0 means no limit
+	IsEnabled    bool    `json:"is_enabled" gorm:"default:true"`
+}
+
+func (Corridor) TableName() string {
+	return "corridors"
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type DevicePlatform string
+
+const (
+	DeviceAndroid DevicePlatform = "android"
+	DeviceIOS     DevicePlatform = "ios"
+	DeviceWeb     DevicePlatform = "web"
+)
+
+// DeviceToken is a push registration for one of a user's devices or browsers. A token
+// belongs to a single user; registering it again moves it to the latest user.
+type DeviceToken struct {
+	gorm.Model
+	UserID     uint           `json:"user_id" gorm:"not null;index"`
+	Token      string         `json:"token" gorm:"not null;uniqueIndex;size:512"`
+	Platform   DevicePlatform `json:"platform" gorm:"not null"`
+	DeviceName string         `json:"device_name"`
+	LastSeenAt time.Time      `json:"last_seen_at"`
+}
+
+func (DeviceToken) TableName() string {
+	return "device_tokens"
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EmailDeliveryStatus string
+
+const (
+	EmailQueued     EmailDeliveryStatus = "queued"
+	EmailSent       EmailDeliveryStatus = "sent"
+	EmailDelivered  EmailDeliveryStatus = "delivered"
+	EmailBounced    EmailDeliveryStatus = "bounced"
+	EmailComplained EmailDeliveryStatus = "complained"
+	EmailFailed     EmailDeliveryStatus = "failed"
+	EmailSuppressed EmailDeliveryStatus = "suppressed" // every recipient is on the suppression list
+)
+
+// EmailDelivery records an outgoing email from the moment it is handed to the email service
+// until the provider reports it delivered, bounced or complained about. The rendered content is
+// kept for reading and the template data for resending; attachments are not, only their names.
+// Security emails carry codes and tokens, so neither is kept for them and Redacted is set.
+type EmailDelivery struct {
+	gorm.Model
+	To                string              `json:"to" gorm:"not null;index"` // comma separated
+	CC                string              `json:"cc"`
+	BCC               string              `json:"bcc"`
+	Template          string              `json:"template" gorm:"index"`
+	Locale            string              `json:"locale" gorm:"size:10"`
+	Subject           string              `json:"subject" gorm:"not null"`
+	TextBody          string              `json:"text_body" gorm:"type:text"`
+	HTMLBody          string              `json:"html_body" gorm:"type:text"`
+	TemplateData      string              `json:"-" gorm:"type:text"` // JSON
+	Redacted          bool                `json:"redacted" gorm:"default:false"`
+	Attachments       string              `json:"attachments"` // comma separated file names
+	Provider          string              `json:"provider"`
+	ProviderMessageID string              `json:"provider_message_id" gorm:"index"`
+	Status            EmailDeliveryStatus `json:"status" gorm:"default:queued;index"`
+	Attempts          int                 `json:"attempts" gorm:"default:0"`
+	Error             string              `json:"error"`
+	Suppressed        string              `json:"suppressed"` // comma separated recipients skipped because they are suppressed
+	ResentFromID      *uint               `json:"resent_from_id" gorm:"index"`
+	CampaignID        *uint               `json:"campaign_id,omitempty" gorm:"index"` // set on the email part of a broadcast
+	SentAt            *time.Time          `json:"sent_at"`
+	DeliveredAt       *time.Time          `json:"delivered_at"`
+	BouncedAt         *time.Time          `json:"bounced_at"`
+}
+
+func (EmailDelivery) TableName() string {
+	return "email_deliveries"
+}
+
+type EmailSuppressionReason string
+
+const (
+	SuppressionBounce    EmailSuppressionReason = "bounce"
+	SuppressionComplaint EmailSuppressionReason = "complaint"
+	SuppressionManual    EmailSuppressionReason = "manual"
+)
+
+// EmailSuppression is an address no email is sent to, after a hard bounce, a spam complaint
+// or an admin adding it by hand. Removing the row lifts the suppression.
+type EmailSuppression struct {
+	gorm.Model
+	Email      string                 `json:"email" gorm:"not null;size:255;uniqueIndex"`
+	Reason     EmailSuppressionReason `json:"reason" gorm:"not null;index"`
+	Detail     string                 `json:"detail"`
+	DeliveryID *uint                  `json:"delivery_id"` // the delivery that bounced or was complained about
+	CreatedBy  uint                   `json:"created_by"`  // admin who added it by hand
+}
+
+func (EmailSuppression) TableName() string {
+	return "email_suppressions"
+}
@@ -0,0 +1,31 @@
+package models
+
+import "gorm.io/gorm"
+
+// EmailTemplateVersion is an admin edited revision of a built-in email template, written in one
+// language. The active revision for a reader's language is sent in place of the built-in one.
+type EmailTemplateVersion struct {
+	gorm.Model
+	Name        string `json:"name" gorm:"not null;size:100;uniqueIndex:idx_email_template_version"`
+	Version     int    `json:"version" gorm:"not null;uniqueIndex:idx_email_template_version"`
+	Locale      string `json:"locale" gorm:"not null;size:10;default:'en';index"`
+	Subject     string `json:"subject" gorm:"not null"`
+	HTMLContent string `json:"html_content" gorm:"type:text;not null"`
+	TextContent string `json:"text_content" gorm:"type:text"`
+	Variables   string `json:"variables" gorm:"type:text"` // JSON list of EmailTemplateVariable
+	IsActive    bool   `json:"is_active" gorm:"default:false;index"`
+	Note        string `json:"note"`
+	CreatedBy   uint   `json:"created_by"`
+}
+
+func (EmailTemplateVersion) TableName() string {
+	return "email_template_versions"
+}
+
+// EmailTemplateVariable declares a value a template expects in its data
+type EmailTemplateVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+	Sample      string `json:"sample"` // used when previewing the template
+}
@@ -13,16 +13,20 @@ const (
 	API    ExchangeRateSource = "api"
 )
 
+// ExchangeRate is the rate conversions are priced at. Rows are never deleted: a new rate retires
+// the previous one, so ValidFrom/ValidTo form the pair's rate history.
 type ExchangeRate struct {
 	gorm.Model
-	FromCurrency string             `json:"from_currency" gorm:"not null"`
-	ToCurrency   string             `json:"to_currency" gorm:"not null"`
-	Rate         float64            `json:"rate" gorm:"not null"`
-	Source       ExchangeRateSource `json:"source" gorm:"default:api"`
-	SetBy        string             `json:"set_by"` // adminId
-	IsActive     bool               `json:"is_active" gorm:"default:true"`
-	ValidFrom    time.Time          `json:"valid_from" gorm:"default:CURRENT_TIMESTAMP"`
-	ValidTo      *time.Time         `json:"valid_to"`
+	FromCurrency     string             `json:"from_currency" gorm:"not null;index:idx_exchange_rates_history,priority:1"`
+	ToCurrency       string             `json:"to_currency" gorm:"not null;index:idx_exchange_rates_history,priority:2"`
+	Rate             float64            `json:"rate" gorm:"not null"`
+	Source           ExchangeRateSource `json:"source" gorm:"default:api"`
+	SetBy            string             `json:"set_by"`                         // adminId
+	RateID           *uint              `json:"rate_id,omitempty" gorm:"index"` // admin rate that published it
+	IsActive         bool               `json:"is_active" gorm:"default:true"`
+	ValidFrom        time.Time          `json:"valid_from" gorm:"default:CURRENT_TIMESTAMP;index:idx_exchange_rates_history,priority:3"`
+	ValidTo          *time.Time         `json:"valid_to"`
+	FollowUpQueuedAt *time.Time         `json:"-"` // when the job reacting to the rate was queued
 }
 
 func (ExchangeRate) TableName() string {
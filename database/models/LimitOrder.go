@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LimitOrderStatus string
+
+const (
+	LimitOrderOpen      LimitOrderStatus = "open"
+	LimitOrderFilled    LimitOrderStatus = "filled"
+	LimitOrderExpired   LimitOrderStatus = "expired"
+	LimitOrderCancelled LimitOrderStatus = "cancelled"
+)
+
+// LimitOrder converts Amount once the FromCurrency->ToCurrency rate reaches TargetRate.
+// Amount is held out of the user's wallet while the order is open.
+type LimitOrder struct {
+	gorm.Model
+	UserID          uint             `json:"user_id" gorm:"not null;index"`
+	FromCurrency    string           `json:"from_currency" gorm:"not null"`
+	ToCurrency      string           `json:"to_currency" gorm:"not null"`
+	Amount          float64          `json:"amount" gorm:"not null"`
+	TargetRate      float64          `json:"target_rate" gorm:"not null"`
+	Status          LimitOrderStatus `json:"status" gorm:"default:open;index"`
+	ExpiresAt       time.Time        `json:"expires_at" gorm:"not null"`
+	FilledAt        *time.Time       `json:"filled_at"`
+	FilledRate      float64          `json:"filled_rate"`
+	ConvertedAmount float64          `json:"converted_amount"`
+	ConversionID    string           `json:"conversion_id"`
+	ClosedAt        *time.Time       `json:"closed_at"` // set when the order expires or is cancelled
+}
+
+func (LimitOrder) TableName() string {
+	return "limit_orders"
+}
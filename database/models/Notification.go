@@ -7,9 +7,12 @@ import (
 type NotificationType string
 
 const (
-	TransferType NotificationType = "transfer"
-	TopUpType    NotificationType = "topup"
-	WithdrawType NotificationType = "withdraw"
+	TransferType  NotificationType = "transfer"
+	TopUpType     NotificationType = "topup"
+	WithdrawType  NotificationType = "withdraw"
+	SecurityType  NotificationType = "security"
+	StatementType NotificationType = "statement"
+	PromotionType NotificationType = "promotion"
 )
 
 type Notification struct {
@@ -19,6 +22,8 @@ type Notification struct {
 	Title   string           `json:"title" gorm:"not null"`
 	Message string           `json:"message" gorm:"not null"`
 	Read    bool             `json:"read" gorm:"default:false"`
+	// CampaignID is set on notifications sent as part of an admin broadcast
+	CampaignID *uint `json:"campaign_id,omitempty" gorm:"index"`
 }
 
 func (Notification) TableName() string {
@@ -0,0 +1,37 @@
+package models
+
+import "gorm.io/gorm"
+
+type NotificationCategory string
+
+const (
+	CategorySecurity        NotificationCategory = "security"
+	CategoryAccount         NotificationCategory = "account"
+	CategoryTransactions    NotificationCategory = "transactions"
+	CategoryPaymentRequests NotificationCategory = "payment_requests"
+	CategoryRateAlerts      NotificationCategory = "rate_alerts"
+	CategoryPromotional     NotificationCategory = "promotional"
+)
+
+type NotificationChannel string
+
+const (
+	ChannelInApp NotificationChannel = "in_app"
+	ChannelEmail NotificationChannel = "email"
+	ChannelSMS   NotificationChannel = "sms"
+	ChannelPush  NotificationChannel = "push"
+)
+
+// NotificationPreference is a user's explicit choice for one category on one channel.
+// Only choices that differ from the defaults need a row.
+type NotificationPreference struct {
+	gorm.Model
+	UserID   uint                 `json:"user_id" gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Category NotificationCategory `json:"category" gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Channel  NotificationChannel  `json:"channel" gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Enabled  bool                 `json:"enabled"`
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
@@ -0,0 +1,26 @@
+package models
+
+import "gorm.io/gorm"
+
+// P2PTransfer links the sender's debit and the recipient's credit of an in-app transfer
+type P2PTransfer struct {
+	gorm.Model
+	TransferID             string            `json:"transfer_id" gorm:"not null;uniqueIndex"`
+	SenderID               uint              `json:"sender_id" gorm:"not null;index"`
+	RecipientID            uint              `json:"recipient_id" gorm:"not null;index"`
+	FromCurrency           string            `json:"from_currency" gorm:"not null"`
+	ToCurrency             string            `json:"to_currency" gorm:"not null"`
+	FromAmount             float64           `json:"from_amount" gorm:"not null"`
+	ToAmount               float64           `json:"to_amount" gorm:"not null"`
+	Rate                   float64           `json:"rate" gorm:"not null"`
+	Note                   string            `json:"note"`
+	SenderTransactionID    string            `json:"sender_transaction_id" gorm:"not null"`
+	RecipientTransactionID string            `json:"recipient_transaction_id" gorm:"not null"`
+	Status                 TransactionStatus `json:"status" gorm:"default:pending"`
+	Sender                 User              `json:"sender" gorm:"foreignKey:SenderID"`
+	Recipient              User              `json:"recipient" gorm:"foreignKey:RecipientID"`
+}
+
+func (P2PTransfer) TableName() string {
+	return "p2p_transfers"
+}
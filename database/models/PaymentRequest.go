@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PaymentRequestStatus string
+
+const (
+	PaymentRequestPending  PaymentRequestStatus = "pending"
+	PaymentRequestPaid     PaymentRequestStatus = "paid"
+	PaymentRequestDeclined PaymentRequestStatus = "declined"
+	PaymentRequestExpired  PaymentRequestStatus = "expired"
+)
+
+// PaymentRequest asks a JeanPay user, or anyone holding the link when PayerID is nil, for money
+type PaymentRequest struct {
+	gorm.Model
+	Code          string               `json:"code" gorm:"not null;uniqueIndex"`
+	RequesterID   uint                 `json:"requester_id" gorm:"not null;index"`
+	PayerID       *uint                `json:"payer_id" gorm:"index"`
+	Amount        float64              `json:"amount" gorm:"not null"`
+	Currency      string               `json:"currency" gorm:"not null"`
+	Note          string               `json:"note"`
+	Status        PaymentRequestStatus `json:"status" gorm:"default:pending;index"`
+	ExpiresAt     time.Time            `json:"expires_at" gorm:"not null"`
+	PaidAt        *time.Time           `json:"paid_at"`
+	PaidByID      *uint                `json:"paid_by_id"`
+	TransactionID string               `json:"transaction_id"` // requester's credit transaction
+	RemindersSent int                  `json:"reminders_sent" gorm:"default:0"`
+	Requester     User                 `json:"requester" gorm:"foreignKey:RequesterID"`
+	Payer         *User                `json:"payer,omitempty" gorm:"foreignKey:PayerID"`
+}
+
+func (PaymentRequest) TableName() string {
+	return "payment_requests"
+}
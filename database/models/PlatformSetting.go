@@ -29,6 +29,7 @@ type PlatformSetting struct {
 	SendTransactionPendingEmail  bool            `json:"send_transaction_pending_email" gorm:"default:true"`
 	SendTransactionRefundEmail   bool            `json:"send_transaction_refund_email" gorm:"default:true"`
 	AccountLimitsNotification    bool            `json:"account_limits_notification" gorm:"default:true"`
+	StepUpAmountThreshold        float64         `json:"step_up_amount_threshold" gorm:"default:100000.00"`
 }
 
 func (PlatformSetting) TableName() string {
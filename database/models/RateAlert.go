@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RateAlertDirection string
+
+const (
+	RateAlertAbove RateAlertDirection = "above"
+	RateAlertBelow RateAlertDirection = "below"
+)
+
+// RateAlert notifies the user when the FromCurrency->ToCurrency rate crosses Threshold
+type RateAlert struct {
+	gorm.Model
+	UserID            uint               `json:"user_id" gorm:"not null;index"`
+	FromCurrency      string             `json:"from_currency" gorm:"not null"`
+	ToCurrency        string             `json:"to_currency" gorm:"not null"`
+	Direction         RateAlertDirection `json:"direction" gorm:"not null"`
+	Threshold         float64            `json:"threshold" gorm:"not null"`
+	CooldownMinutes   int                `json:"cooldown_minutes" gorm:"default:60"` // quiet period after the alert fires
+	IsActive          bool               `json:"is_active" gorm:"default:true;index"`
+	LastTriggeredAt   *time.Time         `json:"last_triggered_at"`
+	LastTriggeredRate float64            `json:"last_triggered_rate"`
+	TriggerCount      int                `json:"trigger_count" gorm:"default:0"`
+}
+
+func (RateAlert) TableName() string {
+	return "rate_alerts"
+}
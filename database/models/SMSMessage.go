@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SMSStatus string
+
+const (
+	SMSQueued    SMSStatus = "queued"
+	SMSSent      SMSStatus = "sent"
+	SMSDelivered SMSStatus = "delivered"
+	SMSFailed    SMSStatus = "failed"
+)
+
+// SMSMessage records a text message from the moment it is queued until the gateway
+// reports it delivered or failed.
+type SMSMessage struct {
+	gorm.Model
+	UserID            uint       `json:"user_id" gorm:"index"`
+	To                string     `json:"to" gorm:"not null"`
+	Template          string     `json:"template"`
+	Body              string     `json:"body" gorm:"not null"`
+	Provider          string     `json:"provider"`
+	ProviderMessageID string     `json:"provider_message_id" gorm:"index"`
+	Status            SMSStatus  `json:"status" gorm:"default:queued;index"`
+	Attempts          int        `json:"attempts" gorm:"default:0"`
+	Error             string     `json:"error"`
+	SentAt            *time.Time `json:"sent_at"`
+	DeliveredAt       *time.Time `json:"delivered_at"`
+	CampaignID        *uint      `json:"campaign_id,omitempty" gorm:"index"` // set on the SMS part of a broadcast
+}
+
+func (SMSMessage) TableName() string {
+	return "sms_messages"
+}
@@ -1,6 +1,10 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type RecipientType string
 
@@ -10,14 +14,18 @@ const (
 )
 
 type SavedRecipient struct {
-	*gorm.Model
-	UserID            uint   `json:"user_id" gorm:"not null;index"`
-	RecipientName     string `json:"recipient_name" gorm:"not null"`
-	RecipientPhone    string `json:"recipient_phone" gorm:"not null"`
-	RecipientAccount  string `json:"recipient_account" gorm:"not null"`
-	RecipientBank     string `json:"recipient_bank" gorm:"not null"`
-	RecipientBankCode string `json:"recipient_bank_code" gorm:"not null"`
-	RecipientType     string `json:"recipient_type" gorm:"not null"` // e.g momo or bank transfer
+	gorm.Model
+	UserID            uint          `json:"user_id" gorm:"not null;index"`
+	Nickname          string        `json:"nickname"`
+	RecipientName     string        `json:"recipient_name" gorm:"not null"`
+	RecipientPhone    string        `json:"recipient_phone" gorm:"not null"`
+	RecipientAccount  string        `json:"recipient_account" gorm:"not null"`
+	RecipientBank     string        `json:"recipient_bank" gorm:"not null"`
+	RecipientBankCode string        `json:"recipient_bank_code" gorm:"not null"`
+	RecipientNetwork  string        `json:"recipient_network"`
+	RecipientType     RecipientType `json:"recipient_type" gorm:"not null"` // e.g momo or bank transfer
+	Currency          string        `json:"currency"`
+	LastUsedAt        *time.Time    `json:"last_used_at"`
 }
 
 func (SavedRecipient) TableName() string {
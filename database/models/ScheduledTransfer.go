@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ScheduleFrequency string
+
+const (
+	FrequencyDaily    ScheduleFrequency = "daily"
+	FrequencyWeekly   ScheduleFrequency = "weekly"
+	FrequencyBiweekly ScheduleFrequency = "biweekly"
+	FrequencyMonthly  ScheduleFrequency = "monthly"
+)
+
+type ScheduleStatus string
+
+const (
+	ScheduleActive    ScheduleStatus = "active"
+	SchedulePaused    ScheduleStatus = "paused"
+	ScheduleCancelled ScheduleStatus = "cancelled"
+	ScheduleCompleted ScheduleStatus = "completed"
+)
+
+type ScheduleRunStatus string
+
+const (
+	ScheduleRunSucceeded ScheduleRunStatus = "succeeded"
+	ScheduleRunFailed    ScheduleRunStatus = "failed"
+	ScheduleRunSkipped   ScheduleRunStatus = "skipped"
+)
+
+// ScheduledTransfer repeats a wallet transfer to a saved recipient until its end condition is met
+type ScheduledTransfer struct {
+	gorm.Model
+	UserID       uint              `json:"user_id" gorm:"not null;index"`
+	RecipientID  uint              `json:"recipient_id" gorm:"not null"`
+	FromCurrency string            `json:"from_currency" gorm:"not null"`
+	ToCurrency   string            `json:"to_currency" gorm:"not null"`
+	Amount       float64           `json:"amount" gorm:"not null"` // debited in FromCurrency on every run
+	Note         string            `json:"note"`
+	Frequency    ScheduleFrequency `json:"frequency" gorm:"not null"`
+	StartDate    time.Time         `json:"start_date" gorm:"not null"`
+	EndDate      *time.Time        `json:"end_date"`
+	MaxRuns      int               `json:"max_runs" gorm:"default:0"`   // 0 runs until cancelled or EndDate
+	Occurrence   int               `json:"occurrence" gorm:"default:0"` // index of NextRunAt counted from StartDate
+	NextRunAt    time.Time         `json:"next_run_at" gorm:"not null;index"`
+	RunCount     int               `json:"run_count" gorm:"default:0"`     // successful runs
+	FailureCount int               `json:"failure_count" gorm:"default:0"` // consecutive failed runs
+	LastRunAt    *time.Time        `json:"last_run_at"`
+	BalanceAlert *time.Time        `json:"balance_alert"` // run the low balance warning was sent for
+	Status       ScheduleStatus    `json:"status" gorm:"default:active;index"`
+	Recipient    SavedRecipient    `json:"recipient" gorm:"foreignKey:RecipientID"`
+}
+
+func (ScheduledTransfer) TableName() string {
+	return "scheduled_transfers"
+}
+
+// ScheduledTransferRun records the outcome of one scheduled execution
+type ScheduledTransferRun struct {
+	gorm.Model
+	ScheduledTransferID uint              `json:"scheduled_transfer_id" gorm:"not null;index"`
+	ScheduledFor        time.Time         `json:"scheduled_for" gorm:"not null"`
+	Status              ScheduleRunStatus `json:"status" gorm:"not null"`
+	TransactionID       string            `json:"transaction_id"`
+	FromAmount          float64           `json:"from_amount"`
+	ToAmount            float64           `json:"to_amount"`
+	Rate                float64           `json:"rate"`
+	Code                string            `json:"code"`
+	Reason              string            `json:"reason"`
+}
+
+func (ScheduledTransferRun) TableName() string {
+	return "scheduled_transfer_runs"
+}
@@ -18,6 +18,7 @@ type Setting struct {
 	UserID                   uint            `json:"user_id" gorm:"not null;index"`
 	DefaultCurrency          DefaultCurrency `json:"default_currency" gorm:"default:'NGN'"`
 	Username                 string          `json:"username" gorm:"null;index"`
+	Language                 string          `json:"language" gorm:"size:10;default:'en'"`
 	FeesBreakdown            bool            `json:"fees_breakdown" gorm:"default:true"`
 	SaveRecipient            bool            `json:"save_recipient" gorm:"default:false"`
 	EmailNotifications       bool            `json:"email_notifications" gorm:"default:true"`
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type StatementFormat string
+
+const (
+	StatementPDF StatementFormat = "pdf"
+	StatementCSV StatementFormat = "csv"
+)
+
+type StatementDelivery string
+
+const (
+	StatementDownload StatementDelivery = "download"
+	StatementEmail    StatementDelivery = "email"
+)
+
+type StatementStatus string
+
+const (
+	StatementPending StatementStatus = "pending"
+	StatementReady   StatementStatus = "ready"
+	StatementFailed  StatementStatus = "failed"
+)
+
+// Statement is a generated account statement for one wallet over a date range. The rendered
+// file is kept until ExpiresAt so it can be downloaded through a signed link.
+type Statement struct {
+	gorm.Model
+	UserID         uint              `json:"user_id" gorm:"not null;index"`
+	Currency       string            `json:"currency" gorm:"not null"`
+	StartDate      time.Time         `json:"start_date" gorm:"not null"`
+	EndDate        time.Time         `json:"end_date" gorm:"not null"` // exclusive
+	Format         StatementFormat   `json:"format" gorm:"not null"`
+	Delivery       StatementDelivery `json:"delivery" gorm:"not null"`
+	Status         StatementStatus   `json:"status" gorm:"default:pending;index"`
+	OpeningBalance float64           `json:"opening_balance"`
+	ClosingBalance float64           `json:"closing_balance"`
+	EntryCount     int               `json:"entry_count"`
+	FileName       string            `json:"file_name"`
+	ContentType    string            `json:"content_type"`
+	Content        []byte            `json:"-"`
+	Error          string            `json:"error"`
+	GeneratedAt    *time.Time        `json:"generated_at"`
+	ExpiresAt      *time.Time        `json:"expires_at" gorm:"index"`
+}
+
+func (Statement) TableName() string {
+	return "statements"
+}
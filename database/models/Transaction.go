@@ -12,6 +12,7 @@ const (
 	TransactionPending   TransactionStatus = "pending"
 	TransactionCompleted TransactionStatus = "completed"
 	TransactionFailed    TransactionStatus = "failed"
+	TransactionRefundDue TransactionStatus = "refund_due" // collected but not credited; the payer is owed a refund
 )
 
 type TransactionType string
@@ -21,6 +22,7 @@ const (
 	Withdrawal TransactionType = "withdrawal"
 	Conversion TransactionType = "conversion"
 	Transfer   TransactionType = "transfer"
+	P2P        TransactionType = "p2p"
 )
 
 type TransactionDirection string
@@ -32,13 +34,37 @@ const (
 	DepositGHS    TransactionDirection = "DEPOSIT-GHS"
 	WithdrawalNGN TransactionDirection = "WITHDRAWAL-NGN"
 	WithdrawalGHS TransactionDirection = "WITHDRAWAL-GHS"
+	P2PNGN        TransactionDirection = "P2P-NGN"
+	P2PGHS        TransactionDirection = "P2P-GHS"
 )
 
+// ConversionDirection is the direction of a cross-currency movement, e.g. NGN-GHS
+func ConversionDirection(fromCurrency, toCurrency string) TransactionDirection {
+	return TransactionDirection(fromCurrency + "-" + toCurrency)
+}
+
+// DepositDirection is the direction of a deposit into a currency wallet
+func DepositDirection(currency string) TransactionDirection {
+	return TransactionDirection("DEPOSIT-" + currency)
+}
+
+// WithdrawalDirection is the direction of a withdrawal from a currency wallet
+func WithdrawalDirection(currency string) TransactionDirection {
+	return TransactionDirection("WITHDRAWAL-" + currency)
+}
+
+// P2PDirection is the direction of a same-currency transfer between users
+func P2PDirection(currency string) TransactionDirection {
+	return TransactionDirection("P2P-" + currency)
+}
+
 type PaymentType string
 
 const (
-	PaymentTypeBank PaymentType = "bank"
-	PaymentTypeMomo PaymentType = "momo"
+	PaymentTypeBank     PaymentType = "bank"
+	PaymentTypeMomo     PaymentType = "momo"
+	PaymentTypeWallet   PaymentType = "wallet"
+	PaymentTypeCheckout PaymentType = "checkout"
 )
 
 type Transaction struct {
@@ -56,6 +82,8 @@ type Transaction struct {
 	Reference          string               `json:"reference" gorm:"not null;uniqueIndex"`
 	Direction          TransactionDirection `json:"direction" gorm:"not null"`
 	Description        string               `json:"description" gorm:"default:''"`
+	PaymentRequestID   *uint                `json:"payment_request_id" gorm:"index"`
+	ReceiptLinkVersion int                  `json:"-" gorm:"default:0"` // bumped to revoke shared receipt links
 	User               User                 `json:"user" gorm:"not null"`
 	TransactionDetails TransactionDetails   `json:"transaction_details" gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -66,6 +94,7 @@ type TransactionDetails struct {
 	RecipientName   string  `json:"recipient_name"`
 	AccountNumber   string  `json:"account_number"`
 	BankName        string  `json:"bank_name"`
+	BankCode        string  `json:"bank_code"`
 	PhoneNumber     string  `json:"phone_number"`
 	Network         string  `json:"network"`
 	FromCurrency    string  `json:"from_currency"`
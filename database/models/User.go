@@ -28,6 +28,10 @@ type User struct {
 	UserID             uint32           `json:"user_id"`
 	Country            UserCountry      `json:"country"`
 	IsTwoFactorEnabled bool             `json:"is_two_factor_enabled"`
+	TransactionPin     string           `json:"-"`
+	PinUpdatedAt       *time.Time       `json:"pin_updated_at"`
+	AdminRoleID        *uint            `json:"admin_role_id"`
+	AdminRole          *AdminRole       `json:"admin_role,omitempty" gorm:"foreignKey:AdminRoleID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 	UpdatedAt          time.Time        `json:"updated_at"`
 	Setting            Setting          `json:"setting" gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Transactions       []Transaction    `json:"transactions" gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
@@ -9,7 +9,7 @@ import (
 type Wallet struct {
 	gorm.Model
 	UserID            uint       `json:"user_id" gorm:"not null;index"`
-	Currency          string     `json:"currency" gorm:"not null;default:'NGN';enum('NGN', 'GHS')"`
+	Currency          string     `json:"currency" gorm:"not null;default:'NGN'"`
 	Balance           float64    `json:"balance" gorm:"default:0"`
 	WalletID          uint64     `json:"wallet_id" gorm:"not null;uniqueIndex"`
 	TotalDeposits     float64    `json:"total_deposits" gorm:"default:0"`
@@ -1,20 +1,24 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
 type WithdrawMethod struct {
 	gorm.Model
-	UserID        uint   `json:"user_id" gorm:"not null;index"`
-	Currency      string `json:"currency" gorm:"not null;"`
-	Method        string `json:"method" gorm:"not null;"`
-	AccountNumber string `json:"account_number" gorm:"not null;"`
-	AccountName   string `json:"account_name" gorm:"not null;"`
-	BankName      string `json:"bank_name" gorm:"not null;"`
-	BankCode      string `json:"bank_code" gorm:"not null;"`
+	UserID        uint       `json:"user_id" gorm:"not null;index"`
+	Currency      string     `json:"currency" gorm:"not null;"`
+	Method        string     `json:"method" gorm:"not null;"`
+	AccountNumber string     `json:"account_number" gorm:"not null;"`
+	AccountName   string     `json:"account_name" gorm:"not null;"`
+	BankName      string     `json:"bank_name" gorm:"not null;"`
+	BankCode      string     `json:"bank_code" gorm:"not null;"` // network for momo
+	IsDefault     bool       `json:"is_default" gorm:"default:false"`
+	VerifiedAt    *time.Time `json:"verified_at"`
 }
 
-func TableName() string {
+func (WithdrawMethod) TableName() string {
 	return "withdrawal_methods"
 }
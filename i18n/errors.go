@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Error is an error meant for users. It carries a catalog message so handlers can show it
+// in the reader's language; Error returns the English text for logs.
+type Error struct {
+	Text Text
+}
+
+// NewError returns an error for a catalog message
+func NewError(id string, args Args) *Error {
+	return &Error{Text: Msg(id, args)}
+}
+
+// Error renders the message in the default locale
+func (e *Error) Error() string {
+	return Default.Render(e.Text)
+}
+
+// ErrorMessage renders an error in the language of the current request. Errors without a
+// catalog message keep their own text.
+func ErrorMessage(c *gin.Context, err error) string {
+	var catalogErr *Error
+	if errors.As(err, &catalogErr) {
+		return FromContext(c).Render(catalogErr.Text)
+	}
+	return err.Error()
+}
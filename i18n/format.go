@@ -0,0 +1,127 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+)
+
+// numberFormat is how a language writes numbers and amounts
+type numberFormat struct {
+	group        string
+	decimal      string
+	symbolBefore bool
+}
+
+var numberFormats = map[string]numberFormat{
+	English: {group: ",", decimal: ".", symbolBefore: true},
+	French:  {group: " ", decimal: ",", symbolBefore: false},
+}
+
+// regionZones are the local times users in each region read dates in
+var regionZones = map[string]*time.Location{
+	"NG": time.FixedZone("WAT", 60*60),
+	"GH": time.FixedZone("GMT", 0),
+}
+
+var frenchMonths = [...]string{
+	"janvier", "février", "mars", "avril", "mai", "juin",
+	"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+}
+
+// Currency formats an amount with the registry symbol and minor units, e.g. ₦1,250.00 or 1 250,00 ₦
+func (l Locale) Currency(amount float64, currency string) string {
+	format := l.numberFormat()
+	info, ok := utils.LookupCurrency(currency)
+	if !ok {
+		return l.number(amount, 2) + " " + currency
+	}
+
+	number := l.number(math.Abs(amount), info.MinorUnits)
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+	if format.symbolBefore {
+		return sign + info.Symbol + number
+	}
+	return sign + number + " " + info.Symbol
+}
+
+// Number formats a plain number with the language's separators
+func (l Locale) Number(value float64, decimals int) string {
+	if value < 0 {
+		return "-" + l.number(-value, decimals)
+	}
+	return l.number(value, decimals)
+}
+
+func (l Locale) number(value float64, decimals int) string {
+	format := l.numberFormat()
+	text := strconv.FormatFloat(value, 'f', decimals, 64)
+	whole, fraction, _ := strings.Cut(text, ".")
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteString(format.group)
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction != "" {
+		grouped.WriteString(format.decimal)
+		grouped.WriteString(fraction)
+	}
+	return grouped.String()
+}
+
+// Date formats a calendar date in the reader's time zone, e.g. 2 January 2006
+func (l Locale) Date(t time.Time) string {
+	t = t.In(l.zone())
+	if l.Language == French {
+		return strconv.Itoa(t.Day()) + " " + frenchMonths[t.Month()-1] + " " + strconv.Itoa(t.Year())
+	}
+	return t.Format("2 January 2006")
+}
+
+// DateTime formats a moment in the reader's time zone, e.g. 2 January 2006, 3:04 PM
+func (l Locale) DateTime(t time.Time) string {
+	local := t.In(l.zone())
+	if l.Language == French {
+		return l.Date(t) + " à " + local.Format("15:04")
+	}
+	return l.Date(t) + ", " + local.Format("3:04 PM")
+}
+
+// Duration spells out a wait in hours and minutes, e.g. 1 hour 5 minutes
+func (l Locale) Duration(d time.Duration) string {
+	if d < time.Minute {
+		return l.T("duration.less_than_minute", nil)
+	}
+	minutes := int(d.Round(time.Minute).Minutes())
+	if minutes < 60 {
+		return l.T("duration.minutes", Args{"count": minutes})
+	}
+	text := l.T("duration.hours", Args{"count": minutes / 60})
+	if minutes%60 > 0 {
+		text += " " + l.T("duration.minutes", Args{"count": minutes % 60})
+	}
+	return text
+}
+
+func (l Locale) numberFormat() numberFormat {
+	if format, ok := numberFormats[l.Language]; ok {
+		return format
+	}
+	return numberFormats[DefaultLanguage]
+}
+
+func (l Locale) zone() *time.Location {
+	if zone, ok := regionZones[l.Region]; ok {
+		return zone
+	}
+	return regionZones[DefaultRegion]
+}
@@ -0,0 +1,106 @@
+// Package i18n holds the message catalogs and locale-aware formatting used for everything
+// shown to users: notifications, SMS, emails and API error messages.
+package i18n
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Languages with a message catalog
+const (
+	English = "en"
+	French  = "fr"
+
+	DefaultLanguage = English
+	DefaultRegion   = "NG"
+)
+
+// contextKey is where the request's locale is kept on the gin context
+const contextKey = "locale"
+
+// Locale is a language plus the region used for time zones, e.g. en-NG
+type Locale struct {
+	Language string
+	Region   string
+}
+
+// Default is used when nothing is known about the reader
+var Default = Locale{Language: DefaultLanguage, Region: DefaultRegion}
+
+// NewLocale builds a locale from a user's preferred language and country. Unsupported
+// languages fall back to English.
+func NewLocale(language, country string) Locale {
+	locale := Locale{Language: DefaultLanguage, Region: regionForCountry(country)}
+	if Supported(language) {
+		locale.Language = strings.ToLower(language)
+	}
+	return locale
+}
+
+// Parse reads a locale in the form produced by String, e.g. "fr-GH"
+func Parse(value string) Locale {
+	language, region, _ := strings.Cut(value, "-")
+	return NewLocale(language, region)
+}
+
+// String returns the locale as a language tag
+func (l Locale) String() string {
+	return l.Language + "-" + l.Region
+}
+
+// Supported reports whether a language has a catalog
+func Supported(language string) bool {
+	_, ok := catalogs[strings.ToLower(language)]
+	return ok
+}
+
+// Languages lists the supported language codes
+func Languages() []string {
+	return []string{English, French}
+}
+
+// Negotiate picks the first supported language from an Accept-Language header
+func Negotiate(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language, _, _ := strings.Cut(tag, "-")
+		if Supported(language) {
+			return strings.ToLower(language)
+		}
+	}
+	return DefaultLanguage
+}
+
+// SetContext stores the request's locale for handlers to read with FromContext
+func SetContext(c *gin.Context, locale Locale) {
+	c.Set(contextKey, locale)
+}
+
+// FromContext returns the locale of the current request
+func FromContext(c *gin.Context) Locale {
+	if value, ok := c.Get(contextKey); ok {
+		if locale, ok := value.(Locale); ok {
+			return locale
+		}
+	}
+	return Default
+}
+
+// T renders a catalog message in the language of the current request
+func T(c *gin.Context, id string) string {
+	return FromContext(c).T(id, nil)
+}
+
+// regionForCountry maps the user country names stored on accounts to region codes
+func regionForCountry(country string) string {
+	switch strings.ToLower(country) {
+	case "ghana", "gh":
+		return "GH"
+	case "nigeria", "ng":
+		return "NG"
+	default:
+		return DefaultRegion
+	}
+}
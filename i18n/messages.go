@@ -0,0 +1,162 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Message is a catalog entry. One is used for a count of one where the language says so,
+// Other for everything else and for messages without a count.
+type Message struct {
+	One   string
+	Other string
+}
+
+// Args fill a message's {name} placeholders. Money, Day, time.Time and Text values are
+// formatted for the reader; "count" also picks the plural form.
+type Args map[string]any
+
+// Money is an amount to format with its currency's symbol
+type Money struct {
+	Amount   float64
+	Currency string
+}
+
+// Amount wraps an amount for formatting in a message
+func Amount(amount float64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Decimal is a number shown to a fixed number of places, such as an exchange rate
+type Decimal struct {
+	Value  float64
+	Places int
+}
+
+// Rate wraps an exchange rate for formatting in a message
+func Rate(rate float64) Decimal {
+	return Decimal{Value: rate, Places: 6}
+}
+
+// Day is a time shown as a date only
+type Day time.Time
+
+// Text is a message to render later, once the reader's locale is known
+type Text struct {
+	ID   string
+	Args Args
+	raw  string
+}
+
+// Msg refers to a catalog message
+func Msg(id string, args Args) Text {
+	return Text{ID: id, Args: args}
+}
+
+// Raw is text that is already written for its readers, such as an admin's campaign copy
+func Raw(text string) Text {
+	return Text{raw: text}
+}
+
+// IsZero reports whether the text is empty
+func (t Text) IsZero() bool {
+	return t.ID == "" && t.raw == ""
+}
+
+var catalogs = map[string]map[string]Message{
+	English: english,
+	French:  french,
+}
+
+// Exists reports whether a message is in the English catalog, which has every message
+func Exists(id string) bool {
+	_, ok := english[id]
+	return ok
+}
+
+// Render renders a text in the locale
+func (l Locale) Render(text Text) string {
+	if text.ID == "" {
+		return text.raw
+	}
+	return l.T(text.ID, text.Args)
+}
+
+// T renders a catalog message, falling back to English and then to the message ID
+func (l Locale) T(id string, args Args) string {
+	message, ok := catalogs[l.Language][id]
+	if !ok {
+		if message, ok = english[id]; !ok {
+			return id
+		}
+	}
+
+	pattern := message.Other
+	if count, ok := args["count"].(int); ok && message.One != "" && l.pluralOne(count) {
+		pattern = message.One
+	}
+	return l.fill(pattern, args)
+}
+
+// pluralOne applies the language's rule for the singular form
+func (l Locale) pluralOne(count int) bool {
+	if l.Language == French {
+		return count == 0 || count == 1
+	}
+	return count == 1
+}
+
+// fill replaces {name} placeholders, leaving unknown ones as they are
+func (l Locale) fill(pattern string, args Args) string {
+	if len(args) == 0 || !strings.Contains(pattern, "{") {
+		return pattern
+	}
+
+	var out strings.Builder
+	for {
+		start := strings.IndexByte(pattern, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(pattern[start:], '}')
+		if end < 0 {
+			break
+		}
+		name := pattern[start+1 : start+end]
+		out.WriteString(pattern[:start])
+		if value, ok := args[name]; ok {
+			out.WriteString(l.format(value))
+		} else {
+			out.WriteString(pattern[start : start+end+1])
+		}
+		pattern = pattern[start+end+1:]
+	}
+	out.WriteString(pattern)
+	return out.String()
+}
+
+func (l Locale) format(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case Text:
+		return l.Render(v)
+	case Money:
+		return l.Currency(v.Amount, v.Currency)
+	case Decimal:
+		return l.Number(v.Value, v.Places)
+	case Day:
+		return l.Date(time.Time(v))
+	case time.Time:
+		return l.DateTime(v)
+	case time.Duration:
+		return l.Duration(v)
+	case int:
+		return l.Number(float64(v), 0)
+	case float64:
+		return l.Number(v, 2)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,579 @@
+package i18n
+
+// english is the source catalog. Every message must be here; other languages fall back to it.
+var english = map[string]Message{
+	// Shared words
+	"duration.less_than_minute": {Other: "less than a minute"},
+	"duration.minutes":          {One: "{count} minute", Other: "{count} minutes"},
+	"duration.hours":            {One: "{count} hour", Other: "{count} hours"},
+
+	"frequency.daily":    {Other: "daily"},
+	"frequency.weekly":   {Other: "weekly"},
+	"frequency.biweekly": {Other: "every two weeks"},
+	"frequency.monthly":  {Other: "monthly"},
+
+	"transaction_type.deposit":    {Other: "deposit"},
+	"transaction_type.withdrawal": {Other: "withdrawal"},
+	"transaction_type.conversion": {Other: "conversion"},
+	"transaction_type.transfer":   {Other: "transfer"},
+	"transaction_type.p2p":        {Other: "transfer"},
+
+	"transaction_status.pending":   {Other: "pending"},
+	"transaction_status.completed": {Other: "completed"},
+	"transaction_status.failed":    {Other: "declined"},
+
+	"rate_alert.direction.above": {Other: "above"},
+	"rate_alert.direction.below": {Other: "below"},
+
+	"statement.period": {Other: "{start} - {end}"},
+
+	// In-app and push notifications
+	"notification.transaction_successful.title":   {Other: "Transaction Successful"},
+	"notification.transaction_successful.message": {Other: "Your transfer of {amount} to {recipient} was successful."},
+	"notification.transaction_failed.title":       {Other: "Transaction Failed"},
+	"notification.transaction_failed.message":     {Other: "Your transfer of {amount} to {recipient} was not successful."},
+	"notification.deposit_successful.title":       {Other: "Deposit Successful"},
+	"notification.deposit_successful.message":     {Other: "Your deposit of {amount} has been processed successfully. Transaction ID: {transactionId}"},
+	"notification.withdrawal_successful.title":    {Other: "Withdrawal Successful"},
+	"notification.withdrawal_successful.message":  {Other: "Your withdrawal of {amount} has been processed successfully. Transaction ID: {transactionId}"},
+	"notification.wallet_top_up.title":            {Other: "Wallet Top Up"},
+	"notification.wallet_top_up.message":          {Other: "Your wallet top-up of {amount} is being processed."},
+	"notification.money_sent.title":               {Other: "Money Sent"},
+	"notification.money_sent.message":             {Other: "You sent {amount} to @{username}."},
+	"notification.money_received.title":           {Other: "Money Received"},
+	"notification.money_received.message":         {Other: "You received {amount} from @{username}."},
+
+	"notification.limit_order_filled.title":      {Other: "Limit Order Filled"},
+	"notification.limit_order_filled.message":    {Other: "Your order converted {amount} to {converted} at {rate}."},
+	"notification.limit_order_cancelled.title":   {Other: "Limit Order Cancelled"},
+	"notification.limit_order_cancelled.message": {Other: "Your order to convert {amount} to {toCurrency} was cancelled. The funds are back in your wallet."},
+	"notification.limit_order_expired.title":     {Other: "Limit Order Expired"},
+	"notification.limit_order_expired.message":   {Other: "Your order to convert {amount} to {toCurrency} expired before the rate reached your target. The funds are back in your wallet."},
+
+	"notification.scheduled_transfer_top_up.title":    {Other: "Top Up For Scheduled Transfer"},
+	"notification.scheduled_transfer_top_up.message":  {Other: "Your {frequency} transfer of {amount} to {recipient} runs on {runAt}, but your {currency} wallet only has {balance}."},
+	"notification.scheduled_transfer_sent.title":      {Other: "Scheduled Transfer Sent"},
+	"notification.scheduled_transfer_sent.message":    {Other: "Your {frequency} transfer of {amount} to {recipient} has been placed."},
+	"notification.scheduled_transfer_failed.title":    {Other: "Scheduled Transfer Failed"},
+	"notification.scheduled_transfer_failed.message":  {Other: "Your {frequency} transfer of {amount} to {recipient} did not go through: {reason}."},
+	"notification.scheduled_transfer_completed.title": {Other: "Scheduled Transfer Completed"},
+	"notification.scheduled_transfer_completed.message": {
+		One:   "Your {frequency} transfer to {recipient} has finished after {count} run.",
+		Other: "Your {frequency} transfer to {recipient} has finished after {count} runs.",
+	},
+	"notification.scheduled_transfer_paused.title": {Other: "Scheduled Transfer Paused"},
+	"notification.scheduled_transfer_paused.message": {
+		One:   "Your {frequency} transfer to {recipient} was paused after {count} failed run. Resume it once the issue is fixed.",
+		Other: "Your {frequency} transfer to {recipient} was paused after {count} failed runs. Resume it once the issue is fixed.",
+	},
+
+	"notification.payment_request_received.title":   {Other: "Payment Request"},
+	"notification.payment_request_received.message": {Other: "@{username} requested {amount} from you."},
+	"notification.payment_request_declined.title":   {Other: "Payment Request Declined"},
+	"notification.payment_request_declined.message": {Other: "@{username} declined your request for {amount}."},
+	"notification.payment_request_reminder.title":   {Other: "Payment Request Reminder"},
+	"notification.payment_request_reminder.message": {Other: "@{username} is still waiting for {amount}. This request expires on {expiresAt}."},
+	"notification.payment_request_expired.title":    {Other: "Payment Request Expired"},
+	"notification.payment_request_expired.message":  {Other: "Your request for {amount} expired without being paid."},
+	"notification.payment_request_paid.title":       {Other: "Payment Request Paid"},
+	"notification.payment_request_paid.message":     {Other: "Your payment request for {amount} has been paid."},
+
+	"notification.statement_ready.title":    {Other: "Statement Ready"},
+	"notification.statement_ready.download": {Other: "Your {currency} statement for {period} is ready to download."},
+	"notification.statement_ready.email":    {Other: "Your {currency} statement for {period} has been sent to {email}."},
+	"notification.statement_failed.title":   {Other: "Statement Failed"},
+	"notification.statement_failed.message": {Other: "We could not generate your {currency} statement. Please try again later."},
+
+	"notification.rate_alert.title":       {Other: "Rate Alert"},
+	"notification.rate_alert.message":     {Other: "{pair} is now {rate}, {direction} your alert at {threshold}."},
+	"notification.account_locked.title":   {Other: "Account Temporarily Locked"},
+	"notification.account_locked.message": {Other: "Security alert: too many failed sign-in attempts on your account. Sign-in is locked for {duration}."},
+
+	// SMS, kept within a single 160 character segment where possible
+	"sms.otp": {
+		One:   "Your JeanPay verification code is {code}. It expires in {count} minute. Do not share this code with anyone.",
+		Other: "Your JeanPay verification code is {code}. It expires in {count} minutes. Do not share this code with anyone.",
+	},
+	"sms.transaction_alert": {Other: "JeanPay: your {type} of {amount} was {status}. Ref: {reference}"},
+	"sms.campaign":          {Other: "JeanPay: {message}"},
+
+	// API errors
+	"api.user_not_authenticated":        {Other: "User not authenticated"},
+	"api.user_authentication_required":  {Other: "User authentication required"},
+	"api.invalid_user_context":          {Other: "Invalid user context"},
+	"api.admin_authentication_required": {Other: "Admin authentication required"},
+	"api.admin_privileges_required":     {Other: "Admin privileges required"},
+	"api.admin_access_required":         {Other: "Admin access required"},
+	"api.access_denied":                 {Other: "You do not have permission to access this resource"},
+	"api.permission_denied":             {Other: "You do not have permission to perform this action"},
+	"api.permission_check_failed":       {Other: "Failed to verify admin permissions"},
+	"api.token_missing":                 {Other: "no token provided"},
+	"api.token_invalid":                 {Other: "invalid or expired token"},
+	"api.invalid_request_data":          {Other: "Invalid request data"},
+	"api.invalid_request_body":          {Other: "Invalid request body"},
+	"api.invalid_query_parameters":      {Other: "Invalid query parameters"},
+	"api.read_request_body_failed":      {Other: "Failed to read request body"},
+	"api.user_id_required":              {Other: "User ID is required"},
+	"api.transaction_id_required":       {Other: "Transaction ID is required"},
+	"api.transaction_not_found":         {Other: "Transaction not found"},
+	"api.receipt_not_found":             {Other: "Receipt not found"},
+	"api.receipt_links_revoked":         {Other: "Shared receipt links revoked"},
+	"api.receipt_links_revoke_failed":   {Other: "Failed to revoke receipt links"},
+
+	// Errors returned by services
+	"error.user_id_required":                   {Other: "user ID is required"},
+	"error.user_not_found":                     {Other: "user not found"},
+	"error.amount_not_positive":                {Other: "amount must be greater than zero"},
+	"error.insufficient_balance":               {Other: "insufficient balance"},
+	"error.invalid_code":                       {Other: "invalid or expired code"},
+	"error.same_currency":                      {Other: "choose two different currencies"},
+	"error.rate_not_found":                     {Other: "rate not found"},
+	"error.admin_not_found":                    {Other: "admin not found"},
+	"error.role_name_invalid":                  {Other: "role name must be 3-50 lowercase letters, digits or underscores"},
+	"error.role_exists":                        {Other: "a role with this name already exists"},
+	"error.role_not_found":                     {Other: "role not found"},
+	"error.super_admin_permissions_locked":     {Other: "super admin permissions cannot be changed"},
+	"error.built_in_role_delete":               {Other: "built-in roles cannot be deleted"},
+	"error.role_in_use":                        {Other: "role is still assigned to one or more admins"},
+	"error.own_role_change":                    {Other: "you cannot change your own role"},
+	"error.roles_manage_restricted":            {Other: "only a super admin can grant the permission to manage roles"},
+	"error.role_admins_only":                   {Other: "roles can only be assigned to admins"},
+	"error.super_admin_role_restricted":        {Other: "only a super admin can grant or remove the super admin role"},
+	"error.last_super_admin":                   {Other: "cannot remove the last super admin"},
+	"error.two_factor_start_failed":            {Other: "unable to start two-factor verification"},
+	"error.too_many_invalid_codes":             {Other: "too many invalid codes, please sign in again"},
+	"error.account_disabled":                   {Other: "your account has been disabled, please contact support"},
+	"error.campaign_content_required":          {Other: "title and message are required"},
+	"error.campaign_sending":                   {Other: "campaign has already started sending"},
+	"error.campaign_send_at_invalid":           {Other: "sendAt must be an RFC3339 timestamp"},
+	"error.campaign_send_at_past":              {Other: "sendAt cannot be in the past"},
+	"error.campaign_not_found":                 {Other: "campaign not found"},
+	"error.segment_balance_currency":           {Other: "a balance band needs a wallet currency"},
+	"error.segment_balance_range":              {Other: "minimum balance cannot exceed maximum balance"},
+	"error.segment_activity_conflict":          {Other: "choose either active or inactive users, not both"},
+	"error.segment_kyc_range":                  {Other: "minimum KYC tier cannot exceed maximum KYC tier"},
+	"error.currency_not_found":                 {Other: "currency not found"},
+	"error.corridor_amount_range":              {Other: "maximum amount cannot be below the minimum amount"},
+	"error.corridor_currencies_missing":        {Other: "add both currencies before opening a corridor between them"},
+	"error.corridor_not_found":                 {Other: "corridor not found"},
+	"error.checker_permission_denied":          {Other: "you do not have permission to perform the change you are approving"},
+	"error.policy_not_found":                   {Other: "policy not found"},
+	"error.threshold_negative":                 {Other: "threshold cannot be negative"},
+	"error.change_request_pending":             {Other: "a change request for this item is already awaiting approval"},
+	"error.transaction_not_pending":            {Other: "transaction not found or not in pending status"},
+	"error.change_request_self_review":         {Other: "a different admin must review this change request"},
+	"error.change_request_reviewed":            {Other: "change request has already been reviewed"},
+	"error.change_request_not_found":           {Other: "change request not found"},
+	"error.email_all_suppressed":               {Other: "every recipient is on the suppression list"},
+	"error.email_resend_security":              {Other: "security emails cannot be resent; the user should request a new code or link"},
+	"error.email_resend_attachments":           {Other: "emails with attachments cannot be resent, since their files are not kept"},
+	"error.email_resend_template_only":         {Other: "only emails sent from a template can be resent"},
+	"error.email_already_suppressed":           {Other: "email is already suppressed"},
+	"error.email_suppression_not_found":        {Other: "email suppression not found"},
+	"error.email_delivery_not_found":           {Other: "email delivery not found"},
+	"error.email_template_not_found":           {Other: "email template not found"},
+	"error.template_version_active":            {Other: "that version is already active"},
+	"error.template_version_not_found":         {Other: "template version not found"},
+	"error.rate_follow_up_not_queued":          {Other: "exchange rate activated but limit orders and rate alerts were not queued"},
+	"error.invalid_rate_range":                 {Other: "invalid range. Must be 24h, 7d, 30d or 1y"},
+	"error.rate_not_recorded":                  {Other: "no rate was recorded for this pair at that time"},
+	"error.limit_order_closed":                 {Other: "limit order is no longer open"},
+	"error.target_rate_not_positive":           {Other: "target rate must be greater than zero"},
+	"error.limit_order_not_found":              {Other: "limit order not found"},
+	"error.too_many_attempts":                  {Other: "too many failed attempts, please try again later"},
+	"error.name_enquiry_unavailable":           {Other: "name enquiry provider is not configured"},
+	"error.account_details_required":           {Other: "account number and bank code or network are required"},
+	"error.account_name_unresolved":            {Other: "account name could not be resolved"},
+	"error.account_not_found":                  {Other: "account not found"},
+	"error.transfer_amount_too_small":          {Other: "amount is too small to transfer"},
+	"error.recipient_required":                 {Other: "recipient is required"},
+	"error.recipient_not_found":                {Other: "recipient not found"},
+	"error.recipient_ambiguous":                {Other: "more than one user matches this recipient, use their email instead"},
+	"error.transfer_to_self":                   {Other: "you cannot send money to yourself"},
+	"error.recipient_unavailable":              {Other: "recipient cannot receive transfers"},
+	"error.payment_request_not_found":          {Other: "payment request not found"},
+	"error.payment_request_own":                {Other: "you cannot pay your own payment request"},
+	"error.payment_request_other_payer":        {Other: "this payment request is addressed to another user"},
+	"error.payment_requester_unavailable":      {Other: "requester cannot receive payments"},
+	"error.payment_request_not_pending":        {Other: "payment request is no longer pending"},
+	"error.payment_request_decline_payer_only": {Other: "only the requested payer can decline this payment request"},
+	"error.payment_request_wallet_only":        {Other: "this payment request must be paid from the payer's JeanPay wallet"},
+	"error.card_payments_unavailable":          {Other: "card payments are not available"},
+	"error.payment_incomplete":                 {Other: "payment has not been completed"},
+	"error.payment_not_found":                  {Other: "payment not found"},
+	"error.device_token_required":              {Other: "device token is required"},
+	"error.device_not_found":                   {Other: "device not found"},
+	"error.threshold_not_positive":             {Other: "threshold must be greater than zero"},
+	"error.rate_alert_not_found":               {Other: "rate alert not found"},
+	"error.transaction_not_found":              {Other: "transaction not found"},
+	"error.receipt_not_found":                  {Other: "receipt not found"},
+	"error.receipt_link_invalid":               {Other: "invalid receipt link"},
+	"error.transfer_not_found":                 {Other: "transfer not found"},
+	"error.recipient_exists":                   {Other: "recipient already saved"},
+	"error.recipient_name_required":            {Other: "recipient name is required"},
+	"error.bank_recipient_details":             {Other: "account number and bank name are required for bank recipients"},
+	"error.momo_recipient_details":             {Other: "phone number and network are required for momo recipients"},
+	"error.recipient_type_invalid":             {Other: "recipient type must be bank or momo"},
+	"error.invalid_phone_number":               {Other: "invalid phone number"},
+	"error.invalid_frequency":                  {Other: "invalid frequency. Must be daily, weekly, biweekly or monthly"},
+	"error.invalid_start_date":                 {Other: "invalid start date"},
+	"error.start_date_past":                    {Other: "start date cannot be in the past"},
+	"error.invalid_end_date":                   {Other: "invalid end date"},
+	"error.end_date_before_start":              {Other: "end date must be after the start date"},
+	"error.schedule_pause_inactive":            {Other: "only active schedules can be paused"},
+	"error.schedule_resume_not_paused":         {Other: "only paused schedules can be resumed"},
+	"error.schedule_ended":                     {Other: "this schedule has already ended"},
+	"error.scheduled_transfer_not_found":       {Other: "scheduled transfer not found"},
+	"error.security_alerts_required":           {Other: "security alerts cannot be turned off"},
+	"error.step_up_threshold_negative":         {Other: "step-up threshold cannot be negative"},
+	"error.start_date_format":                  {Other: "start date must be in YYYY-MM-DD format"},
+	"error.end_date_format":                    {Other: "end date must be in YYYY-MM-DD format"},
+	"error.end_date_before_start_date":         {Other: "end date cannot be before start date"},
+	"error.start_date_future":                  {Other: "start date cannot be in the future"},
+	"error.statement_not_found":                {Other: "statement not found"},
+	"error.download_link_invalid":              {Other: "invalid download link"},
+	"error.download_link_expired":              {Other: "download link has expired"},
+	"error.statement_unavailable":              {Other: "statement is not available"},
+	"error.email_unavailable":                  {Other: "email service not available"},
+	"error.step_up_required":                   {Other: "transaction PIN or verification code is required"},
+	"error.step_up_code_required":              {Other: "a verification code sent to your email is required for this amount"},
+	"error.transaction_pin_not_set":            {Other: "transaction PIN has not been set"},
+	"error.transaction_pin_invalid":            {Other: "invalid transaction PIN or verification code"},
+	"error.transaction_pin_exists":             {Other: "transaction PIN is already set, change it instead"},
+	"error.password_incorrect":                 {Other: "password is incorrect"},
+	"error.current_pin_incorrect":              {Other: "current transaction PIN is incorrect"},
+	"error.transaction_pin_digits":             {Other: "transaction PIN must contain only digits"},
+	"error.transaction_pin_mismatch":           {Other: "transaction PINs do not match"},
+	"error.verification_code_create_failed":    {Other: "unable to create verification code"},
+	"error.verification_code_send_failed":      {Other: "unable to send verification code"},
+	"error.withdraw_method_exists":             {Other: "withdrawal method already saved"},
+	"error.withdraw_method_not_found":          {Other: "withdrawal method not found"},
+	"error.bank_name_required":                 {Other: "bank name is required for bank accounts"},
+	"error.unknown_permission":                 {Other: "unknown permission: {permission}"},
+	"error.campaign_not_editable":              {Other: "a {status} campaign cannot be edited"},
+	"error.campaign_not_schedulable":           {Other: "a {status} campaign cannot be scheduled"},
+	"error.campaign_not_cancellable":           {Other: "a {status} campaign cannot be cancelled"},
+	"error.currency_exists":                    {Other: "currency {currency} already exists"},
+	"error.corridor_exists":                    {Other: "corridor {from}-{to} already exists"},
+	"error.invalid_from_currency":              {Other: "invalid from currency. Must be one of {currencies}"},
+	"error.invalid_to_currency":                {Other: "invalid to currency. Must be one of {currencies}"},
+	"error.invalid_currency":                   {Other: "invalid currency. Must be one of {currencies}"},
+	"error.corridor_minimum":                   {Other: "the minimum for {from} to {to} is {amount}"},
+	"error.corridor_maximum":                   {Other: "the maximum for {from} to {to} is {amount}"},
+	"error.corridor_unsupported":               {Other: "{from} to {to} is not supported"},
+	"error.change_request_status":              {Other: "change request is already {status}"},
+	"error.unsupported_language":               {Other: "unsupported language \"{language}\", choose one of {languages}"},
+	"error.template_undeclared_variables":      {Other: "template uses undeclared variables: {variables}"},
+	"error.template_variable_invalid":          {Other: "invalid variable name \"{name}\""},
+	"error.template_variable_duplicate":        {Other: "variable {name} is declared twice"},
+	"error.target_rate_met":                    {Other: "the current rate {rate} already meets your target, convert at the market rate instead"},
+	"error.limit_order_status":                 {Other: "limit order is already {status}"},
+	"error.too_many_attempts_wait":             {Other: "too many failed attempts, please try again in {duration}"},
+	"error.notification_category_required":     {Other: "{category} notifications cannot be turned off"},
+	"error.notification_category_unknown":      {Other: "unknown notification category \"{category}\""},
+	"error.recipient_wallet_missing":           {Other: "recipient has no {currency} wallet"},
+	"error.payment_request_status":             {Other: "payment request is {status}"},
+	"error.rate_alert_limit":                   {Other: "you can have at most {count} rate alerts"},
+	"error.recipient_currency_mismatch":        {Other: "this recipient receives {currency}, not {requested}"},
+	"error.recipient_method_mismatch":          {Other: "this recipient is paid by {type}, not {method}"},
+	"error.recipient_currency":                 {Other: "this recipient receives {currency}"},
+	"error.schedule_status":                    {Other: "schedule is already {status}"},
+	"error.statement_range_limit":              {Other: "a statement can cover at most {count} days"},
+	"error.wallet_missing":                     {Other: "you do not have a {currency} wallet"},
+	"error.transaction_pin_length":             {Other: "transaction PIN must be {min} to {max} digits"},
+	"error.withdraw_method_currency":           {Other: "withdrawal method is for {currency}, not {requested}"},
+	"error.withdraw_method_missing":            {Other: "no withdrawal method saved for {currency}"},
+
+	// API responses
+	"api.verification_code_sent":                {Other: "A verification code has been sent to your email"},
+	"api.account_deactivated":                   {Other: "Account deactivated successfully"},
+	"api.account_name_resolved":                 {Other: "Account name resolved successfully"},
+	"api.activity_logs_retrieved":               {Other: "Activity logs retrieved successfully"},
+	"api.admin_logs_retrieved":                  {Other: "Admin logs retrieved successfully"},
+	"api.all_notifications_read":                {Other: "All notifications marked as read"},
+	"api.audience_retrieved":                    {Other: "Audience retrieved successfully"},
+	"api.campaign_cancelled":                    {Other: "Campaign cancelled successfully"},
+	"api.campaign_created":                      {Other: "Campaign created successfully"},
+	"api.campaign_retrieved":                    {Other: "Campaign retrieved successfully"},
+	"api.campaign_scheduled":                    {Other: "Campaign scheduled successfully"},
+	"api.campaign_updated":                      {Other: "Campaign updated successfully"},
+	"api.campaigns_retrieved":                   {Other: "Campaigns retrieved successfully"},
+	"api.change_request_retrieved":              {Other: "Change request retrieved successfully"},
+	"api.change_requests_retrieved":             {Other: "Change requests retrieved successfully"},
+	"api.change_pending_approval":               {Other: "Change submitted and is awaiting approval by another admin"},
+	"api.checkout_started":                      {Other: "Checkout started successfully"},
+	"api.conversion_calculated":                 {Other: "Conversion calculated successfully"},
+	"api.conversion_history_retrieved":          {Other: "Conversion history retrieved successfully"},
+	"api.conversion_statistics_retrieved":       {Other: "Conversion statistics retrieved successfully"},
+	"api.corridor_created":                      {Other: "Corridor created successfully"},
+	"api.corridor_updated":                      {Other: "Corridor updated successfully"},
+	"api.currencies_retrieved":                  {Other: "Currencies retrieved successfully"},
+	"api.conversion_completed":                  {Other: "Currency conversion completed successfully"},
+	"api.currency_created":                      {Other: "Currency created successfully"},
+	"api.currency_updated":                      {Other: "Currency updated successfully"},
+	"api.dashboard_chart_retrieved":             {Other: "Dashboard chart data retrieved successfully"},
+	"api.dashboard_overview_retrieved":          {Other: "Dashboard overview retrieved successfully"},
+	"api.dashboard_statistics_retrieved":        {Other: "Dashboard statistics retrieved successfully"},
+	"api.dashboard_summary_retrieved":           {Other: "Dashboard summary retrieved successfully"},
+	"api.default_withdrawal_method_updated":     {Other: "Default withdrawal method updated successfully"},
+	"api.delivery_report_processed":             {Other: "Delivery report processed successfully"},
+	"api.deposit_created":                       {Other: "Deposit transaction created successfully"},
+	"api.device_registered":                     {Other: "Device registered successfully"},
+	"api.device_unregistered":                   {Other: "Device unregistered successfully"},
+	"api.devices_retrieved":                     {Other: "Devices retrieved successfully"},
+	"api.approval_policies_retrieved":           {Other: "Dual approval policies retrieved successfully"},
+	"api.approval_policy_updated":               {Other: "Dual approval policy updated successfully"},
+	"api.email_deliveries_retrieved":            {Other: "Email deliveries retrieved successfully"},
+	"api.email_delivery_retrieved":              {Other: "Email delivery retrieved successfully"},
+	"api.email_delivery_stats_retrieved":        {Other: "Email delivery stats retrieved successfully"},
+	"api.email_events_processed":                {Other: "Email events processed successfully"},
+	"api.email_suppressed":                      {Other: "Email suppressed successfully"},
+	"api.email_suppressions_retrieved":          {Other: "Email suppressions retrieved successfully"},
+	"api.email_template_rendered":               {Other: "Email template rendered successfully"},
+	"api.email_template_retrieved":              {Other: "Email template retrieved successfully"},
+	"api.email_template_rolled_back":            {Other: "Email template rolled back successfully"},
+	"api.email_template_saved":                  {Other: "Email template saved successfully"},
+	"api.email_templates_retrieved":             {Other: "Email templates retrieved successfully"},
+	"api.exchange_rate_retrieved":               {Other: "Exchange rate retrieved successfully"},
+	"api.exchange_rate_series_retrieved":        {Other: "Exchange rate series retrieved successfully"},
+	"api.exchange_rates_retrieved":              {Other: "Exchange rates retrieved successfully"},
+	"api.rate_add_failed":                       {Other: "Failed to add rate"},
+	"api.transaction_note_add_failed":           {Other: "Failed to add transaction note"},
+	"api.transaction_approve_failed":            {Other: "Failed to approve transaction"},
+	"api.role_assign_failed":                    {Other: "Failed to assign role"},
+	"api.user_block_failed":                     {Other: "Failed to block user"},
+	"api.campaign_cancel_failed":                {Other: "Failed to cancel campaign"},
+	"api.campaign_create_failed":                {Other: "Failed to create campaign"},
+	"api.corridor_create_failed":                {Other: "Failed to create corridor"},
+	"api.currency_create_failed":                {Other: "Failed to create currency"},
+	"api.role_create_failed":                    {Other: "Failed to create role"},
+	"api.test_payload_create_failed":            {Other: "Failed to create test payload"},
+	"api.rate_delete_failed":                    {Other: "Failed to delete rate"},
+	"api.role_delete_failed":                    {Other: "Failed to delete role"},
+	"api.logs_export_failed":                    {Other: "Failed to export logs"},
+	"api.audience_preview_failed":               {Other: "Failed to preview audience"},
+	"api.email_template_preview_failed":         {Other: "Failed to preview email template"},
+	"api.transaction_reject_failed":             {Other: "Failed to reject transaction"},
+	"api.email_suppression_remove_failed":       {Other: "Failed to remove email suppression"},
+	"api.email_resend_failed":                   {Other: "Failed to resend email"},
+	"api.activity_logs_retrieve_failed":         {Other: "Failed to retrieve activity logs"},
+	"api.admin_logs_retrieve_failed":            {Other: "Failed to retrieve admin logs"},
+	"api.campaign_retrieve_failed":              {Other: "Failed to retrieve campaign"},
+	"api.campaigns_retrieve_failed":             {Other: "Failed to retrieve campaigns"},
+	"api.change_request_retrieve_failed":        {Other: "Failed to retrieve change request"},
+	"api.change_requests_retrieve_failed":       {Other: "Failed to retrieve change requests"},
+	"api.currencies_retrieve_failed":            {Other: "Failed to retrieve currencies"},
+	"api.dashboard_statistics_retrieve_failed":  {Other: "Failed to retrieve dashboard statistics"},
+	"api.devices_retrieve_failed":               {Other: "Failed to retrieve devices"},
+	"api.approval_policies_retrieve_failed":     {Other: "Failed to retrieve dual approval policies"},
+	"api.email_deliveries_retrieve_failed":      {Other: "Failed to retrieve email deliveries"},
+	"api.email_delivery_stats_retrieve_failed":  {Other: "Failed to retrieve email delivery stats"},
+	"api.email_delivery_retrieve_failed":        {Other: "Failed to retrieve email delivery"},
+	"api.email_suppressions_retrieve_failed":    {Other: "Failed to retrieve email suppressions"},
+	"api.email_template_retrieve_failed":        {Other: "Failed to retrieve email template"},
+	"api.email_templates_retrieve_failed":       {Other: "Failed to retrieve email templates"},
+	"api.failed_transactions_retrieve_failed":   {Other: "Failed to retrieve failed transactions"},
+	"api.limit_orders_retrieve_failed":          {Other: "Failed to retrieve limit orders"},
+	"api.notification_logs_retrieve_failed":     {Other: "Failed to retrieve notification logs"},
+	"api.notification_settings_retrieve_failed": {Other: "Failed to retrieve notification settings"},
+	"api.payment_requests_retrieve_failed":      {Other: "Failed to retrieve payment requests"},
+	"api.pending_transactions_retrieve_failed":  {Other: "Failed to retrieve pending transactions"},
+	"api.permissions_retrieve_failed":           {Other: "Failed to retrieve permissions"},
+	"api.platform_settings_retrieve_failed":     {Other: "Failed to retrieve platform settings"},
+	"api.rate_alerts_retrieve_failed":           {Other: "Failed to retrieve rate alerts"},
+	"api.rates_history_retrieve_failed":         {Other: "Failed to retrieve rates history"},
+	"api.recipients_retrieve_failed":            {Other: "Failed to retrieve recipients"},
+	"api.roles_retrieve_failed":                 {Other: "Failed to retrieve roles"},
+	"api.scheduled_transfers_retrieve_failed":   {Other: "Failed to retrieve scheduled transfers"},
+	"api.statements_retrieve_failed":            {Other: "Failed to retrieve statements"},
+	"api.transactions_overview_retrieve_failed": {Other: "Failed to retrieve transactions overview"},
+	"api.transactions_retrieve_failed":          {Other: "Failed to retrieve transactions"},
+	"api.user_activity_logs_retrieve_failed":    {Other: "Failed to retrieve user activity logs"},
+	"api.user_information_retrieve_failed":      {Other: "Failed to retrieve user information"},
+	"api.user_transactions_retrieve_failed":     {Other: "Failed to retrieve user transactions"},
+	"api.user_wallet_retrieve_failed":           {Other: "Failed to retrieve user wallet"},
+	"api.users_retrieve_failed":                 {Other: "Failed to retrieve users"},
+	"api.withdrawal_methods_retrieve_failed":    {Other: "Failed to retrieve withdrawal methods"},
+	"api.change_request_review_failed":          {Other: "Failed to review change request"},
+	"api.email_template_rollback_failed":        {Other: "Failed to roll back email template"},
+	"api.email_template_save_failed":            {Other: "Failed to save email template"},
+	"api.campaign_schedule_failed":              {Other: "Failed to schedule campaign"},
+	"api.users_search_failed":                   {Other: "Failed to search users"},
+	"api.cookie_set_failed":                     {Other: "Failed to set cookie"},
+	"api.email_suppress_failed":                 {Other: "Failed to suppress email"},
+	"api.user_two_factor_toggle_failed":         {Other: "Failed to toggle user two-factor authentication"},
+	"api.user_unblock_failed":                   {Other: "Failed to unblock user"},
+	"api.campaign_update_failed":                {Other: "Failed to update campaign"},
+	"api.corridor_update_failed":                {Other: "Failed to update corridor"},
+	"api.currency_update_failed":                {Other: "Failed to update currency"},
+	"api.approval_policy_update_failed":         {Other: "Failed to update dual approval policy"},
+	"api.platform_settings_update_failed":       {Other: "Failed to update platform settings"},
+	"api.rate_status_update_failed":             {Other: "Failed to update rate status"},
+	"api.rate_update_failed":                    {Other: "Failed to update rate"},
+	"api.role_update_failed":                    {Other: "Failed to update role"},
+	"api.user_update_failed":                    {Other: "Failed to update user"},
+	"api.failed_transactions_retrieved":         {Other: "Failed transactions retrieved successfully"},
+	"api.from_amount_required":                  {Other: "FromAmount is required"},
+	"api.conversion_same_currency":              {Other: "FromCurrency and ToCurrency cannot be the same"},
+	"api.from_currency_required":                {Other: "FromCurrency is required"},
+	"api.invalid_user_id_format":                {Other: "Invalid User ID format"},
+	"api.invalid_alert_id":                      {Other: "Invalid alert ID"},
+	"api.invalid_campaign_id":                   {Other: "Invalid campaign ID"},
+	"api.invalid_change_request_id":             {Other: "Invalid change request ID"},
+	"api.invalid_corridor_id":                   {Other: "Invalid corridor ID"},
+	"api.invalid_cursor_format":                 {Other: "Invalid cursor format"},
+	"api.invalid_cursor":                        {Other: "Invalid cursor parameter"},
+	"api.invalid_filter":                        {Other: "Invalid filter criteria"},
+	"api.invalid_image":                         {Other: "Invalid image file"},
+	"api.invalid_last_event_id":                 {Other: "Invalid last event ID"},
+	"api.invalid_limit":                         {Other: "Invalid limit parameter"},
+	"api.invalid_order_id":                      {Other: "Invalid order ID"},
+	"api.invalid_rate_id":                       {Other: "Invalid rate ID"},
+	"api.invalid_recipient_id":                  {Other: "Invalid recipient ID"},
+	"api.invalid_request_format":                {Other: "Invalid request format"},
+	"api.invalid_role_id":                       {Other: "Invalid role ID"},
+	"api.invalid_schedule_id":                   {Other: "Invalid schedule ID"},
+	"api.invalid_statement_id":                  {Other: "Invalid statement ID"},
+	"api.invalid_time":                          {Other: "Invalid time, use RFC3339 format"},
+	"api.invalid_user_id":                       {Other: "Invalid user ID"},
+	"api.invalid_withdrawal_method_id":          {Other: "Invalid withdrawal method ID"},
+	"api.limit_order_cancelled":                 {Other: "Limit order cancelled"},
+	"api.limit_order_placed":                    {Other: "Limit order placed successfully"},
+	"api.limit_order_retrieved":                 {Other: "Limit order retrieved successfully"},
+	"api.limit_orders_retrieved":                {Other: "Limit orders retrieved successfully"},
+	"api.payment_method_required":               {Other: "MethodOfPayment is required"},
+	"api.webhook_signature_missing":             {Other: "Missing webhook signature"},
+	"api.monthly_statistics_retrieved":          {Other: "Monthly statistics retrieved successfully"},
+	"api.notification_id_required":              {Other: "Notification ID is required"},
+	"api.notification_created":                  {Other: "Notification created successfully"},
+	"api.notification_logs_retrieved":           {Other: "Notification logs retrieved successfully"},
+	"api.notification_read":                     {Other: "Notification marked as read"},
+	"api.notification_settings_retrieved":       {Other: "Notification settings retrieved successfully"},
+	"api.notification_settings_updated":         {Other: "Notification settings updated successfully"},
+	"api.notification_stream_unavailable":       {Other: "Notification stream unavailable"},
+	"api.notifications_deleted":                 {Other: "Notifications deleted successfully"},
+	"api.notifications_read":                    {Other: "Notifications marked as read successfully"},
+	"api.otp_challenge_required":                {Other: "OTP and challenge ID are required"},
+	"api.password_changed":                      {Other: "Password changed successfully"},
+	"api.password_reset_email_sent":             {Other: "Password reset email sent successfully"},
+	"api.password_reset":                        {Other: "Password reset successfully"},
+	"api.payment_confirmed":                     {Other: "Payment confirmed successfully"},
+	"api.payment_link_retrieved":                {Other: "Payment link retrieved successfully"},
+	"api.payment_request_created":               {Other: "Payment request created successfully"},
+	"api.payment_request_declined":              {Other: "Payment request declined"},
+	"api.payment_request_paid":                  {Other: "Payment request paid successfully"},
+	"api.payment_request_retrieved":             {Other: "Payment request retrieved successfully"},
+	"api.payment_requests_retrieved":            {Other: "Payment requests retrieved successfully"},
+	"api.pending_transactions_retrieved":        {Other: "Pending transactions retrieved successfully"},
+	"api.permissions_retrieved":                 {Other: "Permissions retrieved successfully"},
+	"api.platform_settings_retrieved":           {Other: "Platform settings retrieved successfully"},
+	"api.platform_settings_updated":             {Other: "Platform settings updated successfully"},
+	"api.preferences_updated":                   {Other: "Preferences updated successfully"},
+	"api.profile_image_updated":                 {Other: "Profile image updated successfully"},
+	"api.profile_updated":                       {Other: "Profile updated successfully"},
+	"api.rate_added":                            {Other: "Rate added successfully"},
+	"api.rate_alert_created":                    {Other: "Rate alert created successfully"},
+	"api.rate_alert_deleted":                    {Other: "Rate alert deleted successfully"},
+	"api.rate_alert_retrieved":                  {Other: "Rate alert retrieved successfully"},
+	"api.rate_alert_updated":                    {Other: "Rate alert updated successfully"},
+	"api.rate_alerts_retrieved":                 {Other: "Rate alerts retrieved successfully"},
+	"api.rate_updated":                          {Other: "Rate updated successfully"},
+	"api.rates_history_retrieved":               {Other: "Rates history retrieved successfully"},
+	"api.recent_activity_retrieved":             {Other: "Recent activity retrieved successfully"},
+	"api.recipient_deleted":                     {Other: "Recipient deleted successfully"},
+	"api.recipient_found":                       {Other: "Recipient found"},
+	"api.recipient_retrieved":                   {Other: "Recipient retrieved successfully"},
+	"api.recipient_saved":                       {Other: "Recipient saved successfully"},
+	"api.recipient_updated":                     {Other: "Recipient updated successfully"},
+	"api.recipients_retrieved":                  {Other: "Recipients retrieved successfully"},
+	"api.refresh_token_required":                {Other: "Refresh token is required"},
+	"api.role_created":                          {Other: "Role created successfully"},
+	"api.role_updated":                          {Other: "Role updated successfully"},
+	"api.roles_retrieved":                       {Other: "Roles retrieved successfully"},
+	"api.scheduled_transfer_cancelled":          {Other: "Scheduled transfer cancelled"},
+	"api.scheduled_transfer_created":            {Other: "Scheduled transfer created successfully"},
+	"api.scheduled_transfer_paused":             {Other: "Scheduled transfer paused"},
+	"api.scheduled_transfer_resumed":            {Other: "Scheduled transfer resumed"},
+	"api.scheduled_transfer_retrieved":          {Other: "Scheduled transfer retrieved successfully"},
+	"api.scheduled_transfer_runs_retrieved":     {Other: "Scheduled transfer runs retrieved successfully"},
+	"api.scheduled_transfers_retrieved":         {Other: "Scheduled transfers retrieved successfully"},
+	"api.security_settings_retrieved":           {Other: "Security settings retrieved successfully"},
+	"api.security_settings_updated":             {Other: "Security settings updated successfully"},
+	"api.settings_updated":                      {Other: "Settings updated successfully"},
+	"api.statement_generated":                   {Other: "Statement generated successfully"},
+	"api.statement_generating":                  {Other: "Statement is being generated, we will notify you when it is ready"},
+	"api.statement_retrieved":                   {Other: "Statement retrieved successfully"},
+	"api.statements_retrieved":                  {Other: "Statements retrieved successfully"},
+	"api.test_endpoint_development_only":        {Other: "Test endpoint only available in development"},
+	"api.test_webhook_processed":                {Other: "Test webhook processed successfully"},
+	"api.to_amount_required":                    {Other: "ToAmount is required"},
+	"api.to_currency_required":                  {Other: "ToCurrency is required"},
+	"api.token_verified":                        {Other: "Token verified successfully"},
+	"api.top_up_details_retrieved":              {Other: "Topup details retrieved successfully"},
+	"api.transaction_pin_changed":               {Other: "Transaction PIN changed successfully"},
+	"api.transaction_pin_reset":                 {Other: "Transaction PIN reset successfully"},
+	"api.transaction_pin_set":                   {Other: "Transaction PIN set successfully"},
+	"api.transaction_details_retrieved":         {Other: "Transaction details retrieved successfully"},
+	"api.transaction_statistics_retrieved":      {Other: "Transaction statistics retrieved successfully"},
+	"api.transaction_status_retrieved":          {Other: "Transaction status retrieved successfully"},
+	"api.transaction_summary_retrieved":         {Other: "Transaction summary retrieved successfully"},
+	"api.transaction_trends_retrieved":          {Other: "Transaction trends retrieved successfully"},
+	"api.transactions_overview_retrieved":       {Other: "Transactions overview retrieved successfully"},
+	"api.transactions_retrieved":                {Other: "Transactions retrieved successfully"},
+	"api.transfer_sent":                         {Other: "Transfer sent successfully"},
+	"api.two_factor_qr_generated":               {Other: "Two-factor QR code generated successfully"},
+	"api.two_factor_disabled":                   {Other: "Two-factor authentication disabled successfully"},
+	"api.two_factor_enabled":                    {Other: "Two-factor authentication enabled successfully"},
+	"api.two_factor_required":                   {Other: "Two-factor authentication required"},
+	"api.unsupported_provider":                  {Other: "Unsupported provider"},
+	"api.user_activity_logs_retrieved":          {Other: "User activity logs retrieved successfully"},
+	"api.user_details_retrieved":                {Other: "User details retrieved successfully"},
+	"api.user_fetched":                          {Other: "User fetched successfully"},
+	"api.user_logged_out":                       {Other: "User logged out successfully"},
+	"api.user_not_found":                        {Other: "User not found"},
+	"api.user_preferences_retrieved":            {Other: "User preferences retrieved successfully"},
+	"api.user_registered":                       {Other: "User registered successfully"},
+	"api.user_settings_retrieved":               {Other: "User settings retrieved successfully"},
+	"api.user_transactions_retrieved":           {Other: "User transactions retrieved successfully"},
+	"api.user_updated":                          {Other: "User updated successfully"},
+	"api.user_wallet_retrieved":                 {Other: "User wallet retrieved successfully"},
+	"api.users_retrieved":                       {Other: "Users retrieved successfully"},
+	"api.users_search_completed":                {Other: "Users search completed successfully"},
+	"api.wallet_balance_retrieved":              {Other: "Wallet balance retrieved successfully"},
+	"api.wallet_history_retrieved":              {Other: "Wallet history retrieved successfully"},
+	"api.wallet_overview_retrieved":             {Other: "Wallet overview retrieved successfully"},
+	"api.wallet_settings_updated":               {Other: "Wallet settings updated successfully"},
+	"api.wallet_top_up_initiated":               {Other: "Wallet top-up initiated successfully"},
+	"api.wallet_updated":                        {Other: "Wallet updated successfully"},
+	"api.wallet_withdrawal_initiated":           {Other: "Wallet withdrawal initiated successfully"},
+	"api.webhooks_healthy":                      {Other: "Webhook endpoints are healthy"},
+	"api.webhook_logs_retrieved":                {Other: "Webhook logs retrieved successfully"},
+	"api.webhook_processed":                     {Other: "Webhook processed successfully"},
+	"api.withdrawal_method_deleted":             {Other: "Withdrawal method deleted successfully"},
+	"api.withdrawal_method_saved":               {Other: "Withdrawal method saved successfully"},
+	"api.withdrawal_method_updated":             {Other: "Withdrawal method updated successfully"},
+	"api.withdrawal_methods_retrieved":          {Other: "Withdrawal methods retrieved successfully"},
+	"api.withdrawal_created":                    {Other: "Withdrawal transaction created successfully"},
+	"api.email_required":                        {Other: "Email is required"},
+	"api.reset_token_failed":                    {Other: "Failed to generate reset token"},
+	"api.password_reset_failed":                 {Other: "Failed to reset password"},
+	"api.token_required":                        {Other: "Token is required"},
+	"api.transaction_approved":                  {Other: "Transaction approved successfully"},
+	"api.transaction_rejected":                  {Other: "Transaction rejected successfully"},
+	"api.user_blocked":                          {Other: "User blocked successfully"},
+	"api.user_unblocked":                        {Other: "User unblocked successfully"},
+	"api.rate_status_updated":                   {Other: "Rate status updated successfully"},
+	"api.rate_deleted":                          {Other: "Rate deleted successfully"},
+	"api.transaction_note_added":                {Other: "Note added successfully"},
+	"api.user_two_factor_enabled":               {Other: "User two-factor authentication enabled successfully"},
+	"api.user_two_factor_disabled":              {Other: "User two-factor authentication disabled successfully"},
+	"api.role_deleted":                          {Other: "Role deleted successfully"},
+	"api.role_assigned":                         {Other: "Role assigned successfully"},
+	"api.email_resend_queued":                   {Other: "Email queued for resending"},
+	"api.email_suppression_removed":             {Other: "Email suppression removed successfully"},
+	"api.invalid_email_delivery_id":             {Other: "Invalid email delivery ID"},
+	"api.invalid_email_suppression_id":          {Other: "Invalid email suppression ID"},
+	"api.change_request_rejected":               {Other: "Change request rejected"},
+	"api.change_request_approved":               {Other: "Change request approved and applied"},
+	"api.receipt_verification_unavailable":      {Other: "Receipt verification is unavailable"},
+}
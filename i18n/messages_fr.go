@@ -0,0 +1,578 @@
+package i18n
+
+var french = map[string]Message{
+	// Shared words
+	"duration.less_than_minute": {Other: "moins d'une minute"},
+	"duration.minutes":          {One: "{count} minute", Other: "{count} minutes"},
+	"duration.hours":            {One: "{count} heure", Other: "{count} heures"},
+
+	"frequency.daily":    {Other: "quotidien"},
+	"frequency.weekly":   {Other: "hebdomadaire"},
+	"frequency.biweekly": {Other: "bimensuel"},
+	"frequency.monthly":  {Other: "mensuel"},
+
+	"transaction_type.deposit":    {Other: "dépôt"},
+	"transaction_type.withdrawal": {Other: "retrait"},
+	"transaction_type.conversion": {Other: "conversion"},
+	"transaction_type.transfer":   {Other: "transfert"},
+	"transaction_type.p2p":        {Other: "transfert"},
+
+	"transaction_status.pending":   {Other: "en attente"},
+	"transaction_status.completed": {Other: "effectué"},
+	"transaction_status.failed":    {Other: "refusé"},
+
+	"rate_alert.direction.above": {Other: "au-dessus de"},
+	"rate_alert.direction.below": {Other: "en dessous de"},
+
+	"statement.period": {Other: "du {start} au {end}"},
+
+	// In-app and push notifications
+	"notification.transaction_successful.title":   {Other: "Transaction réussie"},
+	"notification.transaction_successful.message": {Other: "Votre transfert de {amount} à {recipient} a réussi."},
+	"notification.transaction_failed.title":       {Other: "Échec de la transaction"},
+	"notification.transaction_failed.message":     {Other: "Votre transfert de {amount} à {recipient} n'a pas abouti."},
+	"notification.deposit_successful.title":       {Other: "Dépôt réussi"},
+	"notification.deposit_successful.message":     {Other: "Votre dépôt de {amount} a bien été traité. Identifiant de transaction : {transactionId}"},
+	"notification.withdrawal_successful.title":    {Other: "Retrait réussi"},
+	"notification.withdrawal_successful.message":  {Other: "Votre retrait de {amount} a bien été traité. Identifiant de transaction : {transactionId}"},
+	"notification.wallet_top_up.title":            {Other: "Rechargement du portefeuille"},
+	"notification.wallet_top_up.message":          {Other: "Votre rechargement de {amount} est en cours de traitement."},
+	"notification.money_sent.title":               {Other: "Argent envoyé"},
+	"notification.money_sent.message":             {Other: "Vous avez envoyé {amount} à @{username}."},
+	"notification.money_received.title":           {Other: "Argent reçu"},
+	"notification.money_received.message":         {Other: "Vous avez reçu {amount} de @{username}."},
+
+	"notification.limit_order_filled.title":      {Other: "Ordre à cours limité exécuté"},
+	"notification.limit_order_filled.message":    {Other: "Votre ordre a converti {amount} en {converted} au taux de {rate}."},
+	"notification.limit_order_cancelled.title":   {Other: "Ordre à cours limité annulé"},
+	"notification.limit_order_cancelled.message": {Other: "Votre ordre de conversion de {amount} en {toCurrency} a été annulé. Les fonds sont de retour dans votre portefeuille."},
+	"notification.limit_order_expired.title":     {Other: "Ordre à cours limité expiré"},
+	"notification.limit_order_expired.message":   {Other: "Votre ordre de conversion de {amount} en {toCurrency} a expiré avant que le taux n'atteigne votre objectif. Les fonds sont de retour dans votre portefeuille."},
+
+	"notification.scheduled_transfer_top_up.title":    {Other: "Rechargez pour votre transfert programmé"},
+	"notification.scheduled_transfer_top_up.message":  {Other: "Votre transfert {frequency} de {amount} à {recipient} est prévu le {runAt}, mais votre portefeuille {currency} ne contient que {balance}."},
+	"notification.scheduled_transfer_sent.title":      {Other: "Transfert programmé envoyé"},
+	"notification.scheduled_transfer_sent.message":    {Other: "Votre transfert {frequency} de {amount} à {recipient} a été effectué."},
+	"notification.scheduled_transfer_failed.title":    {Other: "Échec du transfert programmé"},
+	"notification.scheduled_transfer_failed.message":  {Other: "Votre transfert {frequency} de {amount} à {recipient} n'a pas abouti : {reason}."},
+	"notification.scheduled_transfer_completed.title": {Other: "Transfert programmé terminé"},
+	"notification.scheduled_transfer_completed.message": {
+		One:   "Votre transfert {frequency} à {recipient} est terminé après {count} exécution.",
+		Other: "Votre transfert {frequency} à {recipient} est terminé après {count} exécutions.",
+	},
+	"notification.scheduled_transfer_paused.title": {Other: "Transfert programmé suspendu"},
+	"notification.scheduled_transfer_paused.message": {
+		One:   "Votre transfert {frequency} à {recipient} a été suspendu après {count} échec. Reprenez-le une fois le problème résolu.",
+		Other: "Votre transfert {frequency} à {recipient} a été suspendu après {count} échecs. Reprenez-le une fois le problème résolu.",
+	},
+
+	"notification.payment_request_received.title":   {Other: "Demande de paiement"},
+	"notification.payment_request_received.message": {Other: "@{username} vous a demandé {amount}."},
+	"notification.payment_request_declined.title":   {Other: "Demande de paiement refusée"},
+	"notification.payment_request_declined.message": {Other: "@{username} a refusé votre demande de {amount}."},
+	"notification.payment_request_reminder.title":   {Other: "Rappel de demande de paiement"},
+	"notification.payment_request_reminder.message": {Other: "@{username} attend toujours {amount}. Cette demande expire le {expiresAt}."},
+	"notification.payment_request_expired.title":    {Other: "Demande de paiement expirée"},
+	"notification.payment_request_expired.message":  {Other: "Votre demande de {amount} a expiré sans être payée."},
+	"notification.payment_request_paid.title":       {Other: "Demande de paiement réglée"},
+	"notification.payment_request_paid.message":     {Other: "Votre demande de paiement de {amount} a été réglée."},
+
+	"notification.statement_ready.title":    {Other: "Relevé disponible"},
+	"notification.statement_ready.download": {Other: "Votre relevé {currency} {period} est prêt à être téléchargé."},
+	"notification.statement_ready.email":    {Other: "Votre relevé {currency} {period} a été envoyé à {email}."},
+	"notification.statement_failed.title":   {Other: "Échec du relevé"},
+	"notification.statement_failed.message": {Other: "Nous n'avons pas pu générer votre relevé {currency}. Veuillez réessayer plus tard."},
+
+	"notification.rate_alert.title":       {Other: "Alerte de taux"},
+	"notification.rate_alert.message":     {Other: "{pair} est maintenant à {rate}, {direction} votre seuil de {threshold}."},
+	"notification.account_locked.title":   {Other: "Compte temporairement bloqué"},
+	"notification.account_locked.message": {Other: "Alerte de sécurité : trop de tentatives de connexion échouées sur votre compte. La connexion est bloquée pendant {duration}."},
+
+	// SMS, kept within a single 160 character segment where possible
+	"sms.otp": {
+		One:   "Votre code de vérification JeanPay est {code}. Il expire dans {count} minute. Ne partagez ce code avec personne.",
+		Other: "Votre code de vérification JeanPay est {code}. Il expire dans {count} minutes. Ne partagez ce code avec personne.",
+	},
+	"sms.transaction_alert": {Other: "JeanPay : votre {type} de {amount} a été {status}. Réf : {reference}"},
+	"sms.campaign":          {Other: "JeanPay : {message}"},
+
+	// API errors
+	"api.user_not_authenticated":        {Other: "Utilisateur non authentifié"},
+	"api.user_authentication_required":  {Other: "Authentification de l'utilisateur requise"},
+	"api.invalid_user_context":          {Other: "Contexte utilisateur invalide"},
+	"api.admin_authentication_required": {Other: "Authentification administrateur requise"},
+	"api.admin_privileges_required":     {Other: "Privilèges administrateur requis"},
+	"api.admin_access_required":         {Other: "Accès administrateur requis"},
+	"api.access_denied":                 {Other: "Vous n'avez pas l'autorisation d'accéder à cette ressource"},
+	"api.permission_denied":             {Other: "Vous n'avez pas l'autorisation d'effectuer cette action"},
+	"api.permission_check_failed":       {Other: "Impossible de vérifier les autorisations administrateur"},
+	"api.token_missing":                 {Other: "aucun jeton fourni"},
+	"api.token_invalid":                 {Other: "jeton invalide ou expiré"},
+	"api.invalid_request_data":          {Other: "Données de requête invalides"},
+	"api.invalid_request_body":          {Other: "Corps de requête invalide"},
+	"api.invalid_query_parameters":      {Other: "Paramètres de requête invalides"},
+	"api.read_request_body_failed":      {Other: "Impossible de lire le corps de la requête"},
+	"api.user_id_required":              {Other: "L'identifiant de l'utilisateur est requis"},
+	"api.transaction_id_required":       {Other: "L'identifiant de la transaction est requis"},
+	"api.transaction_not_found":         {Other: "Transaction introuvable"},
+	"api.receipt_not_found":             {Other: "Reçu introuvable"},
+	"api.receipt_links_revoked":         {Other: "Liens de reçu partagés révoqués"},
+	"api.receipt_links_revoke_failed":   {Other: "Échec de la révocation des liens de reçu"},
+
+	// Errors returned by services
+	"error.user_id_required":                   {Other: "l'identifiant de l'utilisateur est requis"},
+	"error.user_not_found":                     {Other: "utilisateur introuvable"},
+	"error.amount_not_positive":                {Other: "le montant doit être supérieur à zéro"},
+	"error.insufficient_balance":               {Other: "solde insuffisant"},
+	"error.invalid_code":                       {Other: "code invalide ou expiré"},
+	"error.same_currency":                      {Other: "choisissez deux devises différentes"},
+	"error.rate_not_found":                     {Other: "taux introuvable"},
+	"error.admin_not_found":                    {Other: "administrateur introuvable"},
+	"error.role_name_invalid":                  {Other: "le nom du rôle doit comporter de 3 à 50 lettres minuscules, chiffres ou tirets bas"},
+	"error.role_exists":                        {Other: "un rôle portant ce nom existe déjà"},
+	"error.role_not_found":                     {Other: "rôle introuvable"},
+	"error.super_admin_permissions_locked":     {Other: "les autorisations du super administrateur ne peuvent pas être modifiées"},
+	"error.built_in_role_delete":               {Other: "les rôles intégrés ne peuvent pas être supprimés"},
+	"error.role_in_use":                        {Other: "ce rôle est encore attribué à un ou plusieurs administrateurs"},
+	"error.own_role_change":                    {Other: "vous ne pouvez pas modifier votre propre rôle"},
+	"error.roles_manage_restricted":            {Other: "seul un super administrateur peut accorder l'autorisation de gérer les rôles"},
+	"error.role_admins_only":                   {Other: "les rôles ne peuvent être attribués qu'aux administrateurs"},
+	"error.super_admin_role_restricted":        {Other: "seul un super administrateur peut attribuer ou retirer le rôle de super administrateur"},
+	"error.last_super_admin":                   {Other: "impossible de retirer le dernier super administrateur"},
+	"error.two_factor_start_failed":            {Other: "impossible de lancer la vérification en deux étapes"},
+	"error.too_many_invalid_codes":             {Other: "trop de codes invalides, veuillez vous reconnecter"},
+	"error.account_disabled":                   {Other: "votre compte a été désactivé, veuillez contacter le support"},
+	"error.campaign_content_required":          {Other: "le titre et le message sont requis"},
+	"error.campaign_sending":                   {Other: "l'envoi de la campagne a déjà commencé"},
+	"error.campaign_send_at_invalid":           {Other: "sendAt doit être un horodatage RFC3339"},
+	"error.campaign_send_at_past":              {Other: "sendAt ne peut pas être dans le passé"},
+	"error.campaign_not_found":                 {Other: "campagne introuvable"},
+	"error.segment_balance_currency":           {Other: "une tranche de solde nécessite une devise de portefeuille"},
+	"error.segment_balance_range":              {Other: "le solde minimum ne peut pas dépasser le solde maximum"},
+	"error.segment_activity_conflict":          {Other: "choisissez les utilisateurs actifs ou inactifs, pas les deux"},
+	"error.segment_kyc_range":                  {Other: "le niveau KYC minimum ne peut pas dépasser le niveau KYC maximum"},
+	"error.currency_not_found":                 {Other: "devise introuvable"},
+	"error.corridor_amount_range":              {Other: "le montant maximum ne peut pas être inférieur au montant minimum"},
+	"error.corridor_currencies_missing":        {Other: "ajoutez les deux devises avant d'ouvrir un corridor entre elles"},
+	"error.corridor_not_found":                 {Other: "corridor introuvable"},
+	"error.checker_permission_denied":          {Other: "vous n'avez pas l'autorisation d'effectuer la modification que vous approuvez"},
+	"error.policy_not_found":                   {Other: "règle introuvable"},
+	"error.threshold_negative":                 {Other: "le seuil ne peut pas être négatif"},
+	"error.change_request_pending":             {Other: "une demande de modification pour cet élément est déjà en attente d'approbation"},
+	"error.transaction_not_pending":            {Other: "transaction introuvable ou non en attente"},
+	"error.change_request_self_review":         {Other: "un autre administrateur doit examiner cette demande de modification"},
+	"error.change_request_reviewed":            {Other: "cette demande de modification a déjà été examinée"},
+	"error.change_request_not_found":           {Other: "demande de modification introuvable"},
+	"error.email_all_suppressed":               {Other: "tous les destinataires figurent sur la liste de suppression"},
+	"error.email_resend_security":              {Other: "les e-mails de sécurité ne peuvent pas être renvoyés ; l'utilisateur doit demander un nouveau code ou lien"},
+	"error.email_resend_attachments":           {Other: "les e-mails avec pièces jointes ne peuvent pas être renvoyés, car leurs fichiers ne sont pas conservés"},
+	"error.email_resend_template_only":         {Other: "seuls les e-mails envoyés à partir d'un modèle peuvent être renvoyés"},
+	"error.email_already_suppressed":           {Other: "cette adresse e-mail est déjà supprimée"},
+	"error.email_suppression_not_found":        {Other: "suppression d'e-mail introuvable"},
+	"error.email_delivery_not_found":           {Other: "envoi d'e-mail introuvable"},
+	"error.email_template_not_found":           {Other: "modèle d'e-mail introuvable"},
+	"error.template_version_active":            {Other: "cette version est déjà active"},
+	"error.template_version_not_found":         {Other: "version du modèle introuvable"},
+	"error.rate_follow_up_not_queued":          {Other: "le taux de change a été activé, mais les ordres à cours limité et les alertes de taux n'ont pas été mis en file d'attente"},
+	"error.invalid_rate_range":                 {Other: "période invalide. Choisissez 24h, 7d, 30d ou 1y"},
+	"error.rate_not_recorded":                  {Other: "aucun taux n'a été enregistré pour cette paire à ce moment-là"},
+	"error.limit_order_closed":                 {Other: "l'ordre à cours limité n'est plus ouvert"},
+	"error.target_rate_not_positive":           {Other: "le taux cible doit être supérieur à zéro"},
+	"error.limit_order_not_found":              {Other: "ordre à cours limité introuvable"},
+	"error.too_many_attempts":                  {Other: "trop de tentatives échouées, veuillez réessayer plus tard"},
+	"error.name_enquiry_unavailable":           {Other: "la vérification du nom du titulaire n'est pas configurée"},
+	"error.account_details_required":           {Other: "le numéro de compte et le code bancaire ou le réseau sont requis"},
+	"error.account_name_unresolved":            {Other: "le nom du titulaire du compte n'a pas pu être vérifié"},
+	"error.account_not_found":                  {Other: "compte introuvable"},
+	"error.transfer_amount_too_small":          {Other: "le montant est trop faible pour être transféré"},
+	"error.recipient_required":                 {Other: "le destinataire est requis"},
+	"error.recipient_not_found":                {Other: "destinataire introuvable"},
+	"error.recipient_ambiguous":                {Other: "plusieurs utilisateurs correspondent à ce destinataire, utilisez plutôt son adresse e-mail"},
+	"error.transfer_to_self":                   {Other: "vous ne pouvez pas vous envoyer de l'argent"},
+	"error.recipient_unavailable":              {Other: "ce destinataire ne peut pas recevoir de transferts"},
+	"error.payment_request_not_found":          {Other: "demande de paiement introuvable"},
+	"error.payment_request_own":                {Other: "vous ne pouvez pas payer votre propre demande de paiement"},
+	"error.payment_request_other_payer":        {Other: "cette demande de paiement est adressée à un autre utilisateur"},
+	"error.payment_requester_unavailable":      {Other: "le demandeur ne peut pas recevoir de paiements"},
+	"error.payment_request_not_pending":        {Other: "la demande de paiement n'est plus en attente"},
+	"error.payment_request_decline_payer_only": {Other: "seul le payeur sollicité peut refuser cette demande de paiement"},
+	"error.payment_request_wallet_only":        {Other: "cette demande de paiement doit être réglée depuis le portefeuille JeanPay du payeur"},
+	"error.card_payments_unavailable":          {Other: "les paiements par carte ne sont pas disponibles"},
+	"error.payment_incomplete":                 {Other: "le paiement n'a pas été finalisé"},
+	"error.payment_not_found":                  {Other: "paiement introuvable"},
+	"error.device_token_required":              {Other: "le jeton de l'appareil est requis"},
+	"error.device_not_found":                   {Other: "appareil introuvable"},
+	"error.threshold_not_positive":             {Other: "le seuil doit être supérieur à zéro"},
+	"error.rate_alert_not_found":               {Other: "alerte de taux introuvable"},
+	"error.transaction_not_found":              {Other: "transaction introuvable"},
+	"error.receipt_not_found":                  {Other: "reçu introuvable"},
+	"error.receipt_link_invalid":               {Other: "lien de reçu invalide"},
+	"error.transfer_not_found":                 {Other: "transfert introuvable"},
+	"error.recipient_exists":                   {Other: "ce destinataire est déjà enregistré"},
+	"error.recipient_name_required":            {Other: "le nom du destinataire est requis"},
+	"error.bank_recipient_details":             {Other: "le numéro de compte et le nom de la banque sont requis pour les destinataires bancaires"},
+	"error.momo_recipient_details":             {Other: "le numéro de téléphone et le réseau sont requis pour les destinataires mobile money"},
+	"error.recipient_type_invalid":             {Other: "le type de destinataire doit être bank ou momo"},
+	"error.invalid_phone_number":               {Other: "numéro de téléphone invalide"},
+	"error.invalid_frequency":                  {Other: "fréquence invalide. Choisissez daily, weekly, biweekly ou monthly"},
+	"error.invalid_start_date":                 {Other: "date de début invalide"},
+	"error.start_date_past":                    {Other: "la date de début ne peut pas être dans le passé"},
+	"error.invalid_end_date":                   {Other: "date de fin invalide"},
+	"error.end_date_before_start":              {Other: "la date de fin doit être postérieure à la date de début"},
+	"error.schedule_pause_inactive":            {Other: "seules les programmations actives peuvent être suspendues"},
+	"error.schedule_resume_not_paused":         {Other: "seules les programmations suspendues peuvent être reprises"},
+	"error.schedule_ended":                     {Other: "cette programmation est déjà terminée"},
+	"error.scheduled_transfer_not_found":       {Other: "transfert programmé introuvable"},
+	"error.security_alerts_required":           {Other: "les alertes de sécurité ne peuvent pas être désactivées"},
+	"error.step_up_threshold_negative":         {Other: "le seuil de vérification renforcée ne peut pas être négatif"},
+	"error.start_date_format":                  {Other: "la date de début doit être au format AAAA-MM-JJ"},
+	"error.end_date_format":                    {Other: "la date de fin doit être au format AAAA-MM-JJ"},
+	"error.end_date_before_start_date":         {Other: "la date de fin ne peut pas être antérieure à la date de début"},
+	"error.start_date_future":                  {Other: "la date de début ne peut pas être dans le futur"},
+	"error.statement_not_found":                {Other: "relevé introuvable"},
+	"error.download_link_invalid":              {Other: "lien de téléchargement invalide"},
+	"error.download_link_expired":              {Other: "le lien de téléchargement a expiré"},
+	"error.statement_unavailable":              {Other: "le relevé n'est pas disponible"},
+	"error.email_unavailable":                  {Other: "le service d'e-mail n'est pas disponible"},
+	"error.step_up_required":                   {Other: "le code PIN de transaction ou le code de vérification est requis"},
+	"error.step_up_code_required":              {Other: "un code de vérification envoyé à votre adresse e-mail est requis pour ce montant"},
+	"error.transaction_pin_not_set":            {Other: "le code PIN de transaction n'a pas été défini"},
+	"error.transaction_pin_invalid":            {Other: "code PIN de transaction ou code de vérification invalide"},
+	"error.transaction_pin_exists":             {Other: "le code PIN de transaction est déjà défini, modifiez-le plutôt"},
+	"error.password_incorrect":                 {Other: "le mot de passe est incorrect"},
+	"error.current_pin_incorrect":              {Other: "le code PIN de transaction actuel est incorrect"},
+	"error.transaction_pin_digits":             {Other: "le code PIN de transaction ne doit contenir que des chiffres"},
+	"error.transaction_pin_mismatch":           {Other: "les codes PIN de transaction ne correspondent pas"},
+	"error.verification_code_create_failed":    {Other: "impossible de créer le code de vérification"},
+	"error.verification_code_send_failed":      {Other: "impossible d'envoyer le code de vérification"},
+	"error.withdraw_method_exists":             {Other: "ce moyen de retrait est déjà enregistré"},
+	"error.withdraw_method_not_found":          {Other: "moyen de retrait introuvable"},
+	"error.bank_name_required":                 {Other: "le nom de la banque est requis pour les comptes bancaires"},
+	"error.unknown_permission":                 {Other: "autorisation inconnue : {permission}"},
+	"error.campaign_not_editable":              {Other: "une campagne {status} ne peut pas être modifiée"},
+	"error.campaign_not_schedulable":           {Other: "une campagne {status} ne peut pas être programmée"},
+	"error.campaign_not_cancellable":           {Other: "une campagne {status} ne peut pas être annulée"},
+	"error.currency_exists":                    {Other: "la devise {currency} existe déjà"},
+	"error.corridor_exists":                    {Other: "le corridor {from}-{to} existe déjà"},
+	"error.invalid_from_currency":              {Other: "devise source invalide. Choisissez parmi {currencies}"},
+	"error.invalid_to_currency":                {Other: "devise de destination invalide. Choisissez parmi {currencies}"},
+	"error.invalid_currency":                   {Other: "devise invalide. Choisissez parmi {currencies}"},
+	"error.corridor_minimum":                   {Other: "le minimum de {from} vers {to} est de {amount}"},
+	"error.corridor_maximum":                   {Other: "le maximum de {from} vers {to} est de {amount}"},
+	"error.corridor_unsupported":               {Other: "{from} vers {to} n'est pas pris en charge"},
+	"error.change_request_status":              {Other: "la demande de modification est déjà {status}"},
+	"error.unsupported_language":               {Other: "langue non prise en charge « {language} », choisissez parmi {languages}"},
+	"error.template_undeclared_variables":      {Other: "le modèle utilise des variables non déclarées : {variables}"},
+	"error.template_variable_invalid":          {Other: "nom de variable invalide « {name} »"},
+	"error.template_variable_duplicate":        {Other: "la variable {name} est déclarée deux fois"},
+	"error.target_rate_met":                    {Other: "le taux actuel de {rate} atteint déjà votre objectif, convertissez plutôt au taux du marché"},
+	"error.limit_order_status":                 {Other: "l'ordre à cours limité est déjà {status}"},
+	"error.too_many_attempts_wait":             {Other: "trop de tentatives échouées, veuillez réessayer dans {duration}"},
+	"error.notification_category_required":     {Other: "les notifications {category} ne peuvent pas être désactivées"},
+	"error.notification_category_unknown":      {Other: "catégorie de notification inconnue « {category} »"},
+	"error.recipient_wallet_missing":           {Other: "le destinataire n'a pas de portefeuille {currency}"},
+	"error.payment_request_status":             {Other: "la demande de paiement est {status}"},
+	"error.rate_alert_limit":                   {Other: "vous pouvez avoir au plus {count} alertes de taux"},
+	"error.recipient_currency_mismatch":        {Other: "ce destinataire reçoit des {currency}, pas des {requested}"},
+	"error.recipient_method_mismatch":          {Other: "ce destinataire est payé par {type}, pas par {method}"},
+	"error.recipient_currency":                 {Other: "ce destinataire reçoit des {currency}"},
+	"error.schedule_status":                    {Other: "la programmation est déjà {status}"},
+	"error.statement_range_limit":              {Other: "un relevé peut couvrir au plus {count} jours"},
+	"error.wallet_missing":                     {Other: "vous n'avez pas de portefeuille {currency}"},
+	"error.transaction_pin_length":             {Other: "le code PIN de transaction doit comporter de {min} à {max} chiffres"},
+	"error.withdraw_method_currency":           {Other: "ce moyen de retrait est en {currency}, pas en {requested}"},
+	"error.withdraw_method_missing":            {Other: "aucun moyen de retrait enregistré pour {currency}"},
+
+	// API responses
+	"api.verification_code_sent":                {Other: "Un code de vérification a été envoyé à votre adresse e-mail"},
+	"api.account_deactivated":                   {Other: "Compte désactivé avec succès"},
+	"api.account_name_resolved":                 {Other: "Nom du titulaire vérifié avec succès"},
+	"api.activity_logs_retrieved":               {Other: "Journaux d'activité récupérés avec succès"},
+	"api.admin_logs_retrieved":                  {Other: "Journaux d'administration récupérés avec succès"},
+	"api.all_notifications_read":                {Other: "Toutes les notifications ont été marquées comme lues"},
+	"api.audience_retrieved":                    {Other: "Audience récupérée avec succès"},
+	"api.campaign_cancelled":                    {Other: "Campagne annulée avec succès"},
+	"api.campaign_created":                      {Other: "Campagne créée avec succès"},
+	"api.campaign_retrieved":                    {Other: "Campagne récupérée avec succès"},
+	"api.campaign_scheduled":                    {Other: "Campagne programmée avec succès"},
+	"api.campaign_updated":                      {Other: "Campagne mise à jour avec succès"},
+	"api.campaigns_retrieved":                   {Other: "Campagnes récupérées avec succès"},
+	"api.change_request_retrieved":              {Other: "Demande de modification récupérée avec succès"},
+	"api.change_requests_retrieved":             {Other: "Demandes de modification récupérées avec succès"},
+	"api.change_pending_approval":               {Other: "Modification soumise, en attente d'approbation par un autre administrateur"},
+	"api.checkout_started":                      {Other: "Paiement lancé avec succès"},
+	"api.conversion_calculated":                 {Other: "Conversion calculée avec succès"},
+	"api.conversion_history_retrieved":          {Other: "Historique des conversions récupéré avec succès"},
+	"api.conversion_statistics_retrieved":       {Other: "Statistiques de conversion récupérées avec succès"},
+	"api.corridor_created":                      {Other: "Corridor créé avec succès"},
+	"api.corridor_updated":                      {Other: "Corridor mis à jour avec succès"},
+	"api.currencies_retrieved":                  {Other: "Devises récupérées avec succès"},
+	"api.conversion_completed":                  {Other: "Conversion de devise effectuée avec succès"},
+	"api.currency_created":                      {Other: "Devise créée avec succès"},
+	"api.currency_updated":                      {Other: "Devise mise à jour avec succès"},
+	"api.dashboard_chart_retrieved":             {Other: "Données du graphique du tableau de bord récupérées avec succès"},
+	"api.dashboard_overview_retrieved":          {Other: "Aperçu du tableau de bord récupéré avec succès"},
+	"api.dashboard_statistics_retrieved":        {Other: "Statistiques du tableau de bord récupérées avec succès"},
+	"api.dashboard_summary_retrieved":           {Other: "Résumé du tableau de bord récupéré avec succès"},
+	"api.default_withdrawal_method_updated":     {Other: "Moyen de retrait par défaut mis à jour avec succès"},
+	"api.delivery_report_processed":             {Other: "Rapport de livraison traité avec succès"},
+	"api.deposit_created":                       {Other: "Transaction de dépôt créée avec succès"},
+	"api.device_registered":                     {Other: "Appareil enregistré avec succès"},
+	"api.device_unregistered":                   {Other: "Appareil retiré avec succès"},
+	"api.devices_retrieved":                     {Other: "Appareils récupérés avec succès"},
+	"api.approval_policies_retrieved":           {Other: "Règles de double approbation récupérées avec succès"},
+	"api.approval_policy_updated":               {Other: "Règle de double approbation mise à jour avec succès"},
+	"api.email_deliveries_retrieved":            {Other: "Envois d'e-mails récupérés avec succès"},
+	"api.email_delivery_retrieved":              {Other: "Envoi d'e-mail récupéré avec succès"},
+	"api.email_delivery_stats_retrieved":        {Other: "Statistiques d'envoi d'e-mails récupérées avec succès"},
+	"api.email_events_processed":                {Other: "Événements d'e-mail traités avec succès"},
+	"api.email_suppressed":                      {Other: "Adresse e-mail supprimée avec succès"},
+	"api.email_suppressions_retrieved":          {Other: "Suppressions d'e-mails récupérées avec succès"},
+	"api.email_template_rendered":               {Other: "Modèle d'e-mail généré avec succès"},
+	"api.email_template_retrieved":              {Other: "Modèle d'e-mail récupéré avec succès"},
+	"api.email_template_rolled_back":            {Other: "Modèle d'e-mail restauré avec succès"},
+	"api.email_template_saved":                  {Other: "Modèle d'e-mail enregistré avec succès"},
+	"api.email_templates_retrieved":             {Other: "Modèles d'e-mail récupérés avec succès"},
+	"api.exchange_rate_retrieved":               {Other: "Taux de change récupéré avec succès"},
+	"api.exchange_rate_series_retrieved":        {Other: "Série de taux de change récupérée avec succès"},
+	"api.exchange_rates_retrieved":              {Other: "Taux de change récupérés avec succès"},
+	"api.rate_add_failed":                       {Other: "Échec de l'ajout du taux"},
+	"api.transaction_note_add_failed":           {Other: "Échec de l'ajout de la note de transaction"},
+	"api.transaction_approve_failed":            {Other: "Échec de l'approbation de la transaction"},
+	"api.role_assign_failed":                    {Other: "Échec de l'attribution du rôle"},
+	"api.user_block_failed":                     {Other: "Échec du blocage de l'utilisateur"},
+	"api.campaign_cancel_failed":                {Other: "Échec de l'annulation de la campagne"},
+	"api.campaign_create_failed":                {Other: "Échec de la création de la campagne"},
+	"api.corridor_create_failed":                {Other: "Échec de la création du corridor"},
+	"api.currency_create_failed":                {Other: "Échec de la création de la devise"},
+	"api.role_create_failed":                    {Other: "Échec de la création du rôle"},
+	"api.test_payload_create_failed":            {Other: "Échec de la création des données de test"},
+	"api.rate_delete_failed":                    {Other: "Échec de la suppression du taux"},
+	"api.role_delete_failed":                    {Other: "Échec de la suppression du rôle"},
+	"api.logs_export_failed":                    {Other: "Échec de l'exportation des journaux"},
+	"api.audience_preview_failed":               {Other: "Échec de l'aperçu de l'audience"},
+	"api.email_template_preview_failed":         {Other: "Échec de l'aperçu du modèle d'e-mail"},
+	"api.transaction_reject_failed":             {Other: "Échec du rejet de la transaction"},
+	"api.email_suppression_remove_failed":       {Other: "Échec du retrait de la suppression d'e-mail"},
+	"api.email_resend_failed":                   {Other: "Échec du renvoi de l'e-mail"},
+	"api.activity_logs_retrieve_failed":         {Other: "Échec de la récupération des journaux d'activité"},
+	"api.admin_logs_retrieve_failed":            {Other: "Échec de la récupération des journaux d'administration"},
+	"api.campaign_retrieve_failed":              {Other: "Échec de la récupération de la campagne"},
+	"api.campaigns_retrieve_failed":             {Other: "Échec de la récupération des campagnes"},
+	"api.change_request_retrieve_failed":        {Other: "Échec de la récupération de la demande de modification"},
+	"api.change_requests_retrieve_failed":       {Other: "Échec de la récupération des demandes de modification"},
+	"api.currencies_retrieve_failed":            {Other: "Échec de la récupération des devises"},
+	"api.dashboard_statistics_retrieve_failed":  {Other: "Échec de la récupération des statistiques du tableau de bord"},
+	"api.devices_retrieve_failed":               {Other: "Échec de la récupération des appareils"},
+	"api.approval_policies_retrieve_failed":     {Other: "Échec de la récupération des règles de double approbation"},
+	"api.email_deliveries_retrieve_failed":      {Other: "Échec de la récupération des envois d'e-mails"},
+	"api.email_delivery_stats_retrieve_failed":  {Other: "Échec de la récupération des statistiques d'envoi d'e-mails"},
+	"api.email_delivery_retrieve_failed":        {Other: "Échec de la récupération de l'envoi d'e-mail"},
+	"api.email_suppressions_retrieve_failed":    {Other: "Échec de la récupération des suppressions d'e-mails"},
+	"api.email_template_retrieve_failed":        {Other: "Échec de la récupération du modèle d'e-mail"},
+	"api.email_templates_retrieve_failed":       {Other: "Échec de la récupération des modèles d'e-mail"},
+	"api.failed_transactions_retrieve_failed":   {Other: "Échec de la récupération des transactions échouées"},
+	"api.limit_orders_retrieve_failed":          {Other: "Échec de la récupération des ordres à cours limité"},
+	"api.notification_logs_retrieve_failed":     {Other: "Échec de la récupération des journaux de notifications"},
+	"api.notification_settings_retrieve_failed": {Other: "Échec de la récupération des paramètres de notification"},
+	"api.payment_requests_retrieve_failed":      {Other: "Échec de la récupération des demandes de paiement"},
+	"api.pending_transactions_retrieve_failed":  {Other: "Échec de la récupération des transactions en attente"},
+	"api.permissions_retrieve_failed":           {Other: "Échec de la récupération des autorisations"},
+	"api.platform_settings_retrieve_failed":     {Other: "Échec de la récupération des paramètres de la plateforme"},
+	"api.rate_alerts_retrieve_failed":           {Other: "Échec de la récupération des alertes de taux"},
+	"api.rates_history_retrieve_failed":         {Other: "Échec de la récupération de l'historique des taux"},
+	"api.recipients_retrieve_failed":            {Other: "Échec de la récupération des destinataires"},
+	"api.roles_retrieve_failed":                 {Other: "Échec de la récupération des rôles"},
+	"api.scheduled_transfers_retrieve_failed":   {Other: "Échec de la récupération des transferts programmés"},
+	"api.statements_retrieve_failed":            {Other: "Échec de la récupération des relevés"},
+	"api.transactions_overview_retrieve_failed": {Other: "Échec de la récupération de l'aperçu des transactions"},
+	"api.transactions_retrieve_failed":          {Other: "Échec de la récupération des transactions"},
+	"api.user_activity_logs_retrieve_failed":    {Other: "Échec de la récupération des journaux d'activité de l'utilisateur"},
+	"api.user_information_retrieve_failed":      {Other: "Échec de la récupération des informations de l'utilisateur"},
+	"api.user_transactions_retrieve_failed":     {Other: "Échec de la récupération des transactions de l'utilisateur"},
+	"api.user_wallet_retrieve_failed":           {Other: "Échec de la récupération du portefeuille de l'utilisateur"},
+	"api.users_retrieve_failed":                 {Other: "Échec de la récupération des utilisateurs"},
+	"api.withdrawal_methods_retrieve_failed":    {Other: "Échec de la récupération des moyens de retrait"},
+	"api.change_request_review_failed":          {Other: "Échec de l'examen de la demande de modification"},
+	"api.email_template_rollback_failed":        {Other: "Échec de la restauration du modèle d'e-mail"},
+	"api.email_template_save_failed":            {Other: "Échec de l'enregistrement du modèle d'e-mail"},
+	"api.campaign_schedule_failed":              {Other: "Échec de la programmation de la campagne"},
+	"api.users_search_failed":                   {Other: "Échec de la recherche d'utilisateurs"},
+	"api.cookie_set_failed":                     {Other: "Échec de l'enregistrement du cookie"},
+	"api.email_suppress_failed":                 {Other: "Échec de la suppression de l'adresse e-mail"},
+	"api.user_two_factor_toggle_failed":         {Other: "Échec de la modification de l'authentification à deux facteurs de l'utilisateur"},
+	"api.user_unblock_failed":                   {Other: "Échec du déblocage de l'utilisateur"},
+	"api.campaign_update_failed":                {Other: "Échec de la mise à jour de la campagne"},
+	"api.corridor_update_failed":                {Other: "Échec de la mise à jour du corridor"},
+	"api.currency_update_failed":                {Other: "Échec de la mise à jour de la devise"},
+	"api.approval_policy_update_failed":         {Other: "Échec de la mise à jour de la règle de double approbation"},
+	"api.platform_settings_update_failed":       {Other: "Échec de la mise à jour des paramètres de la plateforme"},
+	"api.rate_status_update_failed":             {Other: "Échec de la mise à jour du statut du taux"},
+	"api.rate_update_failed":                    {Other: "Échec de la mise à jour du taux"},
+	"api.role_update_failed":                    {Other: "Échec de la mise à jour du rôle"},
+	"api.user_update_failed":                    {Other: "Échec de la mise à jour de l'utilisateur"},
+	"api.failed_transactions_retrieved":         {Other: "Transactions échouées récupérées avec succès"},
+	"api.from_amount_required":                  {Other: "FromAmount est requis"},
+	"api.conversion_same_currency":              {Other: "FromCurrency et ToCurrency ne peuvent pas être identiques"},
+	"api.from_currency_required":                {Other: "FromCurrency est requis"},
+	"api.invalid_user_id_format":                {Other: "Format d'identifiant utilisateur invalide"},
+	"api.invalid_alert_id":                      {Other: "Identifiant d'alerte invalide"},
+	"api.invalid_campaign_id":                   {Other: "Identifiant de campagne invalide"},
+	"api.invalid_change_request_id":             {Other: "Identifiant de demande de modification invalide"},
+	"api.invalid_corridor_id":                   {Other: "Identifiant de corridor invalide"},
+	"api.invalid_cursor_format":                 {Other: "Format de curseur invalide"},
+	"api.invalid_cursor":                        {Other: "Paramètre de curseur invalide"},
+	"api.invalid_filter":                        {Other: "Critères de filtre invalides"},
+	"api.invalid_image":                         {Other: "Fichier image invalide"},
+	"api.invalid_last_event_id":                 {Other: "Identifiant du dernier événement invalide"},
+	"api.invalid_limit":                         {Other: "Paramètre de limite invalide"},
+	"api.invalid_order_id":                      {Other: "Identifiant d'ordre invalide"},
+	"api.invalid_rate_id":                       {Other: "Identifiant de taux invalide"},
+	"api.invalid_recipient_id":                  {Other: "Identifiant de destinataire invalide"},
+	"api.invalid_request_format":                {Other: "Format de requête invalide"},
+	"api.invalid_role_id":                       {Other: "Identifiant de rôle invalide"},
+	"api.invalid_schedule_id":                   {Other: "Identifiant de programmation invalide"},
+	"api.invalid_statement_id":                  {Other: "Identifiant de relevé invalide"},
+	"api.invalid_time":                          {Other: "Heure invalide, utilisez le format RFC3339"},
+	"api.invalid_user_id":                       {Other: "Identifiant utilisateur invalide"},
+	"api.invalid_withdrawal_method_id":          {Other: "Identifiant de moyen de retrait invalide"},
+	"api.limit_order_cancelled":                 {Other: "Ordre à cours limité annulé"},
+	"api.limit_order_placed":                    {Other: "Ordre à cours limité passé avec succès"},
+	"api.limit_order_retrieved":                 {Other: "Ordre à cours limité récupéré avec succès"},
+	"api.limit_orders_retrieved":                {Other: "Ordres à cours limité récupérés avec succès"},
+	"api.payment_method_required":               {Other: "MethodOfPayment est requis"},
+	"api.webhook_signature_missing":             {Other: "Signature du webhook manquante"},
+	"api.monthly_statistics_retrieved":          {Other: "Statistiques mensuelles récupérées avec succès"},
+	"api.notification_id_required":              {Other: "L'identifiant de la notification est requis"},
+	"api.notification_created":                  {Other: "Notification créée avec succès"},
+	"api.notification_logs_retrieved":           {Other: "Journaux de notifications récupérés avec succès"},
+	"api.notification_read":                     {Other: "Notification marquée comme lue"},
+	"api.notification_settings_retrieved":       {Other: "Paramètres de notification récupérés avec succès"},
+	"api.notification_settings_updated":         {Other: "Paramètres de notification mis à jour avec succès"},
+	"api.notification_stream_unavailable":       {Other: "Flux de notifications indisponible"},
+	"api.notifications_deleted":                 {Other: "Notifications supprimées avec succès"},
+	"api.notifications_read":                    {Other: "Notifications marquées comme lues avec succès"},
+	"api.otp_challenge_required":                {Other: "Le code OTP et l'identifiant du défi sont requis"},
+	"api.password_changed":                      {Other: "Mot de passe modifié avec succès"},
+	"api.password_reset_email_sent":             {Other: "E-mail de réinitialisation du mot de passe envoyé avec succès"},
+	"api.password_reset":                        {Other: "Mot de passe réinitialisé avec succès"},
+	"api.payment_confirmed":                     {Other: "Paiement confirmé avec succès"},
+	"api.payment_link_retrieved":                {Other: "Lien de paiement récupéré avec succès"},
+	"api.payment_request_created":               {Other: "Demande de paiement créée avec succès"},
+	"api.payment_request_declined":              {Other: "Demande de paiement refusée"},
+	"api.payment_request_paid":                  {Other: "Demande de paiement réglée avec succès"},
+	"api.payment_request_retrieved":             {Other: "Demande de paiement récupérée avec succès"},
+	"api.payment_requests_retrieved":            {Other: "Demandes de paiement récupérées avec succès"},
+	"api.pending_transactions_retrieved":        {Other: "Transactions en attente récupérées avec succès"},
+	"api.permissions_retrieved":                 {Other: "Autorisations récupérées avec succès"},
+	"api.platform_settings_retrieved":           {Other: "Paramètres de la plateforme récupérés avec succès"},
+	"api.platform_settings_updated":             {Other: "Paramètres de la plateforme mis à jour avec succès"},
+	"api.preferences_updated":                   {Other: "Préférences mises à jour avec succès"},
+	"api.profile_image_updated":                 {Other: "Photo de profil mise à jour avec succès"},
+	"api.profile_updated":                       {Other: "Profil mis à jour avec succès"},
+	"api.rate_added":                            {Other: "Taux ajouté avec succès"},
+	"api.rate_alert_created":                    {Other: "Alerte de taux créée avec succès"},
+	"api.rate_alert_deleted":                    {Other: "Alerte de taux supprimée avec succès"},
+	"api.rate_alert_retrieved":                  {Other: "Alerte de taux récupérée avec succès"},
+	"api.rate_alert_updated":                    {Other: "Alerte de taux mise à jour avec succès"},
+	"api.rate_alerts_retrieved":                 {Other: "Alertes de taux récupérées avec succès"},
+	"api.rate_updated":                          {Other: "Taux mis à jour avec succès"},
+	"api.rates_history_retrieved":               {Other: "Historique des taux récupéré avec succès"},
+	"api.recent_activity_retrieved":             {Other: "Activité récente récupérée avec succès"},
+	"api.recipient_deleted":                     {Other: "Destinataire supprimé avec succès"},
+	"api.recipient_found":                       {Other: "Destinataire trouvé"},
+	"api.recipient_retrieved":                   {Other: "Destinataire récupéré avec succès"},
+	"api.recipient_saved":                       {Other: "Destinataire enregistré avec succès"},
+	"api.recipient_updated":                     {Other: "Destinataire mis à jour avec succès"},
+	"api.recipients_retrieved":                  {Other: "Destinataires récupérés avec succès"},
+	"api.refresh_token_required":                {Other: "Le jeton d'actualisation est requis"},
+	"api.role_created":                          {Other: "Rôle créé avec succès"},
+	"api.role_updated":                          {Other: "Rôle mis à jour avec succès"},
+	"api.roles_retrieved":                       {Other: "Rôles récupérés avec succès"},
+	"api.scheduled_transfer_cancelled":          {Other: "Transfert programmé annulé"},
+	"api.scheduled_transfer_created":            {Other: "Transfert programmé créé avec succès"},
+	"api.scheduled_transfer_paused":             {Other: "Transfert programmé suspendu"},
+	"api.scheduled_transfer_resumed":            {Other: "Transfert programmé repris"},
+	"api.scheduled_transfer_retrieved":          {Other: "Transfert programmé récupéré avec succès"},
+	"api.scheduled_transfer_runs_retrieved":     {Other: "Exécutions du transfert programmé récupérées avec succès"},
+	"api.scheduled_transfers_retrieved":         {Other: "Transferts programmés récupérés avec succès"},
+	"api.security_settings_retrieved":           {Other: "Paramètres de sécurité récupérés avec succès"},
+	"api.security_settings_updated":             {Other: "Paramètres de sécurité mis à jour avec succès"},
+	"api.settings_updated":                      {Other: "Paramètres mis à jour avec succès"},
+	"api.statement_generated":                   {Other: "Relevé généré avec succès"},
+	"api.statement_generating":                  {Other: "Le relevé est en cours de génération, nous vous préviendrons dès qu'il sera prêt"},
+	"api.statement_retrieved":                   {Other: "Relevé récupéré avec succès"},
+	"api.statements_retrieved":                  {Other: "Relevés récupérés avec succès"},
+	"api.test_endpoint_development_only":        {Other: "Point de terminaison de test disponible uniquement en développement"},
+	"api.test_webhook_processed":                {Other: "Webhook de test traité avec succès"},
+	"api.to_amount_required":                    {Other: "ToAmount est requis"},
+	"api.to_currency_required":                  {Other: "ToCurrency est requis"},
+	"api.token_verified":                        {Other: "Jeton vérifié avec succès"},
+	"api.top_up_details_retrieved":              {Other: "Détails de la recharge récupérés avec succès"},
+	"api.transaction_pin_changed":               {Other: "Code PIN de transaction modifié avec succès"},
+	"api.transaction_pin_reset":                 {Other: "Code PIN de transaction réinitialisé avec succès"},
+	"api.transaction_pin_set":                   {Other: "Code PIN de transaction défini avec succès"},
+	"api.transaction_details_retrieved":         {Other: "Détails de la transaction récupérés avec succès"},
+	"api.transaction_statistics_retrieved":      {Other: "Statistiques des transactions récupérées avec succès"},
+	"api.transaction_status_retrieved":          {Other: "Statut de la transaction récupéré avec succès"},
+	"api.transaction_summary_retrieved":         {Other: "Résumé des transactions récupéré avec succès"},
+	"api.transaction_trends_retrieved":          {Other: "Tendances des transactions récupérées avec succès"},
+	"api.transactions_overview_retrieved":       {Other: "Aperçu des transactions récupéré avec succès"},
+	"api.transactions_retrieved":                {Other: "Transactions récupérées avec succès"},
+	"api.transfer_sent":                         {Other: "Transfert envoyé avec succès"},
+	"api.two_factor_qr_generated":               {Other: "Code QR d'authentification à deux facteurs généré avec succès"},
+	"api.two_factor_disabled":                   {Other: "Authentification à deux facteurs désactivée avec succès"},
+	"api.two_factor_enabled":                    {Other: "Authentification à deux facteurs activée avec succès"},
+	"api.two_factor_required":                   {Other: "Authentification à deux facteurs requise"},
+	"api.unsupported_provider":                  {Other: "Fournisseur non pris en charge"},
+	"api.user_activity_logs_retrieved":          {Other: "Journaux d'activité de l'utilisateur récupérés avec succès"},
+	"api.user_details_retrieved":                {Other: "Détails de l'utilisateur récupérés avec succès"},
+	"api.user_fetched":                          {Other: "Utilisateur récupéré avec succès"},
+	"api.user_logged_out":                       {Other: "Utilisateur déconnecté avec succès"},
+	"api.user_not_found":                        {Other: "Utilisateur introuvable"},
+	"api.user_preferences_retrieved":            {Other: "Préférences de l'utilisateur récupérées avec succès"},
+	"api.user_registered":                       {Other: "Utilisateur inscrit avec succès"},
+	"api.user_settings_retrieved":               {Other: "Paramètres de l'utilisateur récupérés avec succès"},
+	"api.user_transactions_retrieved":           {Other: "Transactions de l'utilisateur récupérées avec succès"},
+	"api.user_updated":                          {Other: "Utilisateur mis à jour avec succès"},
+	"api.user_wallet_retrieved":                 {Other: "Portefeuille de l'utilisateur récupéré avec succès"},
+	"api.users_retrieved":                       {Other: "Utilisateurs récupérés avec succès"},
+	"api.users_search_completed":                {Other: "Recherche d'utilisateurs effectuée avec succès"},
+	"api.wallet_balance_retrieved":              {Other: "Solde du portefeuille récupéré avec succès"},
+	"api.wallet_history_retrieved":              {Other: "Historique du portefeuille récupéré avec succès"},
+	"api.wallet_overview_retrieved":             {Other: "Aperçu du portefeuille récupéré avec succès"},
+	"api.wallet_settings_updated":               {Other: "Paramètres du portefeuille mis à jour avec succès"},
+	"api.wallet_top_up_initiated":               {Other: "Recharge du portefeuille lancée avec succès"},
+	"api.wallet_updated":                        {Other: "Portefeuille mis à jour avec succès"},
+	"api.wallet_withdrawal_initiated":           {Other: "Retrait du portefeuille lancé avec succès"},
+	"api.webhooks_healthy":                      {Other: "Les points de terminaison des webhooks fonctionnent correctement"},
+	"api.webhook_logs_retrieved":                {Other: "Journaux des webhooks récupérés avec succès"},
+	"api.webhook_processed":                     {Other: "Webhook traité avec succès"},
+	"api.withdrawal_method_deleted":             {Other: "Moyen de retrait supprimé avec succès"},
+	"api.withdrawal_method_saved":               {Other: "Moyen de retrait enregistré avec succès"},
+	"api.withdrawal_method_updated":             {Other: "Moyen de retrait mis à jour avec succès"},
+	"api.withdrawal_methods_retrieved":          {Other: "Moyens de retrait récupérés avec succès"},
+	"api.withdrawal_created":                    {Other: "Transaction de retrait créée avec succès"},
+	"api.email_required":                        {Other: "L'adresse e-mail est requise"},
+	"api.reset_token_failed":                    {Other: "Échec de la génération du jeton de réinitialisation"},
+	"api.password_reset_failed":                 {Other: "Échec de la réinitialisation du mot de passe"},
+	"api.token_required":                        {Other: "Le jeton est requis"},
+	"api.transaction_approved":                  {Other: "Transaction approuvée avec succès"},
+	"api.transaction_rejected":                  {Other: "Transaction rejetée avec succès"},
+	"api.user_blocked":                          {Other: "Utilisateur bloqué avec succès"},
+	"api.user_unblocked":                        {Other: "Utilisateur débloqué avec succès"},
+	"api.rate_status_updated":                   {Other: "Statut du taux mis à jour avec succès"},
+	"api.rate_deleted":                          {Other: "Taux supprimé avec succès"},
+	"api.transaction_note_added":                {Other: "Note ajoutée avec succès"},
+	"api.user_two_factor_enabled":               {Other: "Authentification à deux facteurs de l'utilisateur activée avec succès"},
+	"api.user_two_factor_disabled":              {Other: "Authentification à deux facteurs de l'utilisateur désactivée avec succès"},
+	"api.role_deleted":                          {Other: "Rôle supprimé avec succès"},
+	"api.role_assigned":                         {Other: "Rôle attribué avec succès"},
+	"api.email_resend_queued":                   {Other: "E-mail mis en file d'attente pour renvoi"},
+	"api.email_suppression_removed":             {Other: "Suppression d'e-mail retirée avec succès"},
+	"api.invalid_email_delivery_id":             {Other: "Identifiant d'envoi d'e-mail invalide"},
+	"api.invalid_email_suppression_id":          {Other: "Identifiant de suppression d'e-mail invalide"},
+	"api.change_request_rejected":               {Other: "Demande de modification rejetée"},
+	"api.change_request_approved":               {Other: "Demande de modification approuvée et appliquée"},
+	"api.receipt_verification_unavailable":      {Other: "La vérification des reçus est indisponible"},
+}
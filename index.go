@@ -1,12 +1,14 @@
 package main
 
 import (
+	"log"
 	"time"
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/initializers"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/routes"
+	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	_ "github.com/joho/godotenv/autoload"
@@ -15,6 +17,9 @@ import (
 func init() {
 	go initializers.InitializeQueueServer()
 	database.InitDB()
+	if err := services.LoadCurrencyRegistry(); err != nil {
+		log.Printf("Failed to load currency registry: %v", err)
+	}
 }
 
 func main() {
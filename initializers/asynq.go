@@ -8,6 +8,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/Veedsify/JeanPayGoBackend/constants"
 	"github.com/Veedsify/JeanPayGoBackend/jobs"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/services"
@@ -33,9 +34,10 @@ type QueueConfig struct {
 
 // QueueServer wraps the asynq server with additional functionality
 type QueueServer struct {
-	server *asynq.Server
-	config *QueueConfig
-	mux    *asynq.ServeMux
+	server    *asynq.Server
+	scheduler *asynq.Scheduler
+	config    *QueueConfig
+	mux       *asynq.ServeMux
 }
 
 // NewQueueConfig creates a new queue configuration from environment variables
@@ -100,10 +102,15 @@ func NewQueueServer(config *QueueConfig) *QueueServer {
 	mux := asynq.NewServeMux()
 	registerHandlers(mux)
 
+	// Periodic tasks are enqueued by the scheduler and processed by the server above
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{LogLevel: config.LogLevel})
+	registerPeriodicTasks(scheduler)
+
 	return &QueueServer{
-		server: server,
-		config: config,
-		mux:    mux,
+		server:    server,
+		scheduler: scheduler,
+		config:    config,
+		mux:       mux,
 	}
 }
 
@@ -117,6 +124,8 @@ func registerHandlers(mux *asynq.ServeMux) {
 	mux.HandleFunc(jobs.TypeTwoFactorEmail, jobs.HandleTwoFactorEmailTask)
 	mux.HandleFunc(jobs.TypeTransactionApproved, jobs.HandleTransactionApprovedTask)
 	mux.HandleFunc(jobs.TypeTransactionRejected, jobs.HandleTransactionRejectedTask)
+	mux.HandleFunc(jobs.TypeSecurityAlertEmail, jobs.HandleSecurityAlertEmailTask)
+	mux.HandleFunc(jobs.TypeEmailResend, services.HandleEmailResendTask)
 	// Activity Log
 	mux.HandleFunc(jobs.TypeActivityLog, jobs.HandleActivityJobTask)
 	// Notification Log
@@ -125,12 +134,48 @@ func registerHandlers(mux *asynq.ServeMux) {
 	mux.HandleFunc(jobs.TypeNotificationUpdate, jobs.HandleUpdateNotificationTask)
 	mux.HandleFunc(jobs.TypeNotificationMarkAllRead, jobs.HandleMarkAllNotificationsReadTask)
 	mux.HandleFunc(jobs.TypeNotificationMarkRead, jobs.HandleMarkNotificationReadTask)
+	// Payment requests notify through the dispatcher, so their handlers live in services
+	mux.HandleFunc(jobs.TypePaymentRequestReminder, services.HandlePaymentRequestReminderTask)
+	mux.HandleFunc(jobs.TypePaymentRequestExpire, services.HandlePaymentRequestExpireTask)
+	// Scheduled transfers run through the transaction service, so their handlers live there
+	mux.HandleFunc(jobs.TypeScheduledTransferSweep, services.HandleScheduledTransferSweepTask)
+	mux.HandleFunc(jobs.TypeScheduledTransferRun, services.HandleScheduledTransferRunTask)
+	mux.HandleFunc(jobs.TypeCampaignStart, services.HandleCampaignStartTask)
+	mux.HandleFunc(jobs.TypeCampaignBatch, services.HandleCampaignBatchTask)
+	// Exchange rates and limit orders
+	mux.HandleFunc(jobs.TypeExchangeRateActivated, services.HandleExchangeRateActivatedTask)
+	mux.HandleFunc(jobs.TypeExchangeRateFollowUpSweep, services.HandleExchangeRateFollowUpSweepTask)
+	mux.HandleFunc(jobs.TypeLimitOrderExpire, services.HandleLimitOrderExpireTask)
+	mux.HandleFunc(jobs.TypeLimitOrderSweep, services.HandleLimitOrderSweepTask)
+	// Account statements
+	mux.HandleFunc(jobs.TypeStatementGenerate, services.HandleStatementGenerateTask)
+	mux.HandleFunc(jobs.TypeStatementPurge, services.HandleStatementPurgeTask)
+	// SMS
+	mux.HandleFunc(jobs.TypeSMSDelivery, services.HandleSMSDeliveryTask)
+	// Push
+	mux.HandleFunc(jobs.TypePushDelivery, services.HandlePushDeliveryTask)
 
 	// Add middleware for logging
 	mux.Use(loggingMiddleware)
 	mux.Use(metricsMiddleware)
 }
 
+// registerPeriodicTasks registers tasks the scheduler enqueues on a fixed schedule
+func registerPeriodicTasks(scheduler *asynq.Scheduler) {
+	if _, err := scheduler.Register(constants.ScheduledTransferSweepSpec, jobs.NewScheduledTransferSweepTask(), asynq.Queue("high")); err != nil {
+		log.Fatalf("Failed to register scheduled transfer sweep: %v", err)
+	}
+	if _, err := scheduler.Register(constants.ExchangeRateFollowUpSweepSpec, jobs.NewExchangeRateFollowUpSweepTask(), asynq.Queue("critical")); err != nil {
+		log.Fatalf("Failed to register exchange rate follow-up sweep: %v", err)
+	}
+	if _, err := scheduler.Register(constants.LimitOrderSweepSpec, jobs.NewLimitOrderSweepTask(), asynq.Queue("default")); err != nil {
+		log.Fatalf("Failed to register limit order sweep: %v", err)
+	}
+	if _, err := scheduler.Register(constants.StatementPurgeSpec, jobs.NewStatementPurgeTask(), asynq.Queue("low")); err != nil {
+		log.Fatalf("Failed to register statement purge: %v", err)
+	}
+}
+
 // Start starts the queue server with graceful shutdown
 func (qs *QueueServer) Start() error {
 	// Channel to listen for interrupt signal
@@ -148,6 +193,11 @@ func (qs *QueueServer) Start() error {
 		}
 	}()
 
+	if err := qs.scheduler.Start(); err != nil {
+		log.Printf("Queue scheduler error: %v", err)
+		return err
+	}
+
 	// Start health check server if configured
 	if qs.config.HealthCheckAddr != "" {
 		go startHealthCheckServer(qs.config.HealthCheckAddr)
@@ -162,6 +212,7 @@ func (qs *QueueServer) Start() error {
 		log.Printf("Received signal %v, initiating graceful shutdown...", sig)
 
 		// Shutdown the server gracefully
+		qs.scheduler.Shutdown()
 		qs.server.Shutdown()
 
 		log.Println("Queue server shutdown completed")
@@ -171,6 +222,7 @@ func (qs *QueueServer) Start() error {
 
 // Stop stops the queue server
 func (qs *QueueServer) Stop() {
+	qs.scheduler.Shutdown()
 	qs.server.Shutdown()
 }
 
@@ -183,6 +235,16 @@ func InitializeQueueServer() {
 	}
 	log.Println("Global email sender initialized successfully")
 
+	if err := services.InitializeGlobalSMSSender(); err != nil {
+		log.Fatalf("Failed to initialize global SMS sender: %v", err)
+	}
+	log.Println("Global SMS sender initialized successfully")
+
+	if err := services.InitializeGlobalPushSender(); err != nil {
+		log.Fatalf("Failed to initialize global push sender: %v", err)
+	}
+	log.Println("Global push sender initialized successfully")
+
 	config := NewQueueConfig()
 	server := NewQueueServer(config)
 
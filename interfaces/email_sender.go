@@ -2,16 +2,17 @@ package interfaces
 
 import "github.com/Veedsify/JeanPayGoBackend/database/models"
 
-// EmailSender defines the interface for sending emails
+// EmailSender defines the interface for sending emails. Locales are tags such as fr-GH; templated
+// emails take theirs from the "Locale" entry of the data.
 type EmailSender interface {
 	// SendWelcomeEmail sends a welcome email to a new user
-	SendWelcomeEmail(to, userName, token string) error
+	SendWelcomeEmail(to, userName, token, locale string) error
 
 	// SendPasswordResetEmail sends a password reset email
-	SendPasswordResetEmail(to, resetToken string) error
+	SendPasswordResetEmail(to, resetToken, locale string) error
 
 	// SendEmailVerification sends an email verification email
-	SendEmailVerification(to, userName, verificationToken string) error
+	SendEmailVerification(to, userName, verificationToken, locale string) error
 
 	// SendTemplatedEmail sends an email using a template
 	SendTemplatedEmail(to []string, templateName string, data map[string]interface{}) error
@@ -23,13 +24,13 @@ type EmailSender interface {
 	SendHTMLEmail(to []string, subject, htmlBody string) error
 
 	// SendTwoFactorAuthenticationEmail sends a 2FA email
-	SendTwoFactorAuthenticationEmail(to, userName, verificationUrl string) error
+	SendTwoFactorAuthenticationEmail(to, userName, verificationUrl, locale string) error
 
 	// SendTransactionApprovedEmail sends a transaction approved email
-	SendTransactionApprovedEmail(to string, userName string, transaction models.Transaction) error
+	SendTransactionApprovedEmail(to string, userName string, transaction models.Transaction, locale string) error
 
 	// SendTransactionRejectedEmail sends a transaction rejected email
-	SendTransactionRejectedEmail(to string, userName string, transaction models.Transaction, reason string) error
+	SendTransactionRejectedEmail(to string, userName string, transaction models.Transaction, reason, locale string) error
 }
 
 // EmailJobHandler defines the interface for handling email jobs
@@ -0,0 +1,36 @@
+package interfaces
+
+import "errors"
+
+// ErrInvalidPushToken is returned by a PushSender when the provider reports that a
+// device token is unknown or expired, so the caller can forget it
+var ErrInvalidPushToken = errors.New("invalid push token")
+
+// PushMessage is the content of a push notification
+type PushMessage struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushSender defines the interface for delivering push notifications to devices
+type PushSender interface {
+	// SendPush delivers a message to a single device token
+	SendPush(token string, message PushMessage) error
+
+	// Name identifies the adapter in logs
+	Name() string
+}
+
+// Global push sender instance that can be set at runtime
+var GlobalPushSender PushSender
+
+// SetGlobalPushSender sets the global push sender implementation
+func SetGlobalPushSender(sender PushSender) {
+	GlobalPushSender = sender
+}
+
+// GetGlobalPushSender returns the global push sender
+func GetGlobalPushSender() PushSender {
+	return GlobalPushSender
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+// SMSSender defines the interface for sending text messages through an SMS gateway
+type SMSSender interface {
+	// SendSMS sends a text message and returns the gateway's message ID, which
+	// delivery reports refer back to
+	SendSMS(to, message string) (string, error)
+
+	// Name identifies the adapter on delivery records
+	Name() string
+}
+
+// Global SMS sender instance that can be set at runtime
+var GlobalSMSSender SMSSender
+
+// SetGlobalSMSSender sets the global SMS sender implementation
+func SetGlobalSMSSender(sender SMSSender) {
+	GlobalSMSSender = sender
+}
+
+// GetGlobalSMSSender returns the global SMS sender
+func GetGlobalSMSSender() SMSSender {
+	return GlobalSMSSender
+}
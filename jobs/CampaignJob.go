@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeCampaignStart = "campaign:start"
+	TypeCampaignBatch = "campaign:batch"
+)
+
+// CampaignStartPayload identifies the scheduled send a start job belongs to. A rescheduled
+// campaign gets a new ScheduledAt, so the job queued for the old time becomes a no-op.
+type CampaignStartPayload struct {
+	CampaignID  uint  `json:"campaign_id"`
+	ScheduledAt int64 `json:"scheduled_at"`
+}
+
+// CampaignBatchPayload identifies one throttled slice of a campaign's recipients
+type CampaignBatchPayload struct {
+	CampaignID uint `json:"campaign_id"`
+	Batch      int  `json:"batch"`
+}
+
+// CampaignJobClient queues broadcast campaign deliveries
+type CampaignJobClient struct {
+	client *asynq.Client
+}
+
+// NewCampaignJobClient creates a new campaign job client
+func NewCampaignJobClient() *CampaignJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &CampaignJobClient{
+		client: client,
+	}
+}
+
+// Close closes the campaign job client
+func (cjc *CampaignJobClient) Close() error {
+	return cjc.client.Close()
+}
+
+// EnqueueStart queues the job that builds a campaign's recipient list at its send time
+func (cjc *CampaignJobClient) EnqueueStart(campaignID uint, sendAt time.Time) error {
+	payloadBytes, err := json.Marshal(CampaignStartPayload{CampaignID: campaignID, ScheduledAt: sendAt.Unix()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal campaign payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeCampaignStart, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("low"),
+		asynq.MaxRetry(constants.CampaignMaxRetries),
+		asynq.Timeout(10 * time.Minute),
+		asynq.ProcessAt(sendAt),
+		asynq.TaskID(fmt.Sprintf("campaign:start:%d:%d", campaignID, sendAt.Unix())),
+	}
+
+	info, err := cjc.client.Enqueue(task, opts...)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue campaign start: %w", err)
+	}
+
+	log.Printf("Enqueued campaign start: id=%s queue=%s campaign_id=%d send_at=%s", info.ID, info.Queue, campaignID, sendAt.Format(time.RFC3339))
+	return nil
+}
+
+// EnqueueBatch queues delivery of one batch of recipients after the given delay
+func (cjc *CampaignJobClient) EnqueueBatch(campaignID uint, batch int, delay time.Duration) error {
+	payloadBytes, err := json.Marshal(CampaignBatchPayload{CampaignID: campaignID, Batch: batch})
+	if err != nil {
+		return fmt.Errorf("failed to marshal campaign batch payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeCampaignBatch, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("low"),
+		asynq.MaxRetry(constants.CampaignMaxRetries),
+		asynq.Timeout(10 * time.Minute),
+		asynq.ProcessIn(delay),
+		asynq.TaskID(fmt.Sprintf("campaign:batch:%d:%d", campaignID, batch)),
+	}
+
+	info, err := cjc.client.Enqueue(task, opts...)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue campaign batch: %w", err)
+	}
+
+	log.Printf("Enqueued campaign batch: id=%s queue=%s campaign_id=%d batch=%d", info.ID, info.Queue, campaignID, batch)
+	return nil
+}
@@ -9,9 +9,9 @@ import (
 	"time"
 
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/interfaces"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
-	"github.com/Veedsify/JeanPayGoBackend/utils"
 	"github.com/hibiken/asynq"
 )
 
@@ -26,6 +26,8 @@ const (
 	TypeTwoFactorEmail      = "email:two_factor_authentication"
 	TypeTransactionApproved = "email:transaction_approved"
 	TypeTransactionRejected = "email:transaction_rejected"
+	TypeSecurityAlertEmail  = "email:security_alert"
+	TypeEmailResend         = "email:resend"
 )
 
 // Base email job payload
@@ -36,6 +38,7 @@ type EmailJobPayload struct {
 	Data        map[string]any `json:"data"`
 	Priority    string         `json:"priority,omitempty"`
 	ScheduledAt *time.Time     `json:"scheduled_at,omitempty"`
+	Locale      string         `json:"locale,omitempty"` // the recipient's locale, e.g. fr-GH
 }
 
 // Specific email job payloads
@@ -75,9 +78,15 @@ type TransactionRejectedPayload struct {
 	Transaction     models.Transaction `json:"transaction"`
 }
 
+// EmailResendPayload identifies the logged delivery a resend job sends again
+type EmailResendPayload struct {
+	DeliveryID uint `json:"delivery_id"`
+}
+
 // EmailJobClient handles email job creation and queuing
 type EmailJobClient struct {
 	client *asynq.Client
+	locale i18n.Locale
 }
 
 // NewEmailJobClient creates a new email job client
@@ -85,6 +94,7 @@ func NewEmailJobClient() *EmailJobClient {
 	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
 	return &EmailJobClient{
 		client: client,
+		locale: i18n.Default,
 	}
 }
 
@@ -93,6 +103,16 @@ func (ejc *EmailJobClient) Close() error {
 	return ejc.client.Close()
 }
 
+// SetLocale sets the locale the emails queued by this client are written in
+func (ejc *EmailJobClient) SetLocale(locale i18n.Locale) {
+	ejc.locale = locale
+}
+
+// Locale returns the locale the emails queued by this client are written in
+func (ejc *EmailJobClient) Locale() i18n.Locale {
+	return ejc.locale
+}
+
 // EnqueueWelcomeEmail queues a welcome email job
 func (ejc *EmailJobClient) EnqueueWelcomeEmail(email, userName, token string) error {
 	payload := WelcomeEmailPayload{
@@ -105,6 +125,7 @@ func (ejc *EmailJobClient) EnqueueWelcomeEmail(email, userName, token string) er
 				"token":     token,
 			},
 			Priority: "high",
+			Locale:   ejc.locale.String(),
 		},
 		UserName: userName,
 		Token:    token,
@@ -141,6 +162,7 @@ func (ejc *EmailJobClient) EnqueuePasswordResetEmail(email, resetToken string) e
 				"reset_token": resetToken,
 			},
 			Priority: "high",
+			Locale:   ejc.locale.String(),
 		},
 		ResetToken: resetToken,
 	}
@@ -177,6 +199,7 @@ func (ejc *EmailJobClient) EnqueueEmailVerification(email, userName, verificatio
 				"verification_token": verificationToken,
 			},
 			Priority: "high",
+			Locale:   ejc.locale.String(),
 		},
 		UserName:          userName,
 		VerificationToken: verificationToken,
@@ -213,6 +236,7 @@ func (ejc *EmailJobClient) EnqueueTwoFactorEmail(email, userName, verificationLi
 			"verification_link": verificationLink,
 		},
 		Priority: "high",
+		Locale:   ejc.locale.String(),
 	}
 	task, err := createEmailTask(TypeTwoFactorEmail, payload)
 	if err != nil {
@@ -244,14 +268,15 @@ func (ejc *EmailJobClient) EnqueueTransactionApproved(email string, userName str
 				"user_name":        userName,
 				"transaction_id":   transaction.TransactionID,
 				"transaction_type": transaction.TransactionType,
-				"amount":           utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+				"amount":           ejc.locale.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
 				"email":            email,
 			},
 			Priority: "high",
+			Locale:   ejc.locale.String(),
 		},
 		UserName:        userName,
 		TransactionType: string(transaction.TransactionType),
-		Amount:          utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		Amount:          ejc.locale.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
 		TransactionID:   transaction.TransactionID,
 		Transaction:     transaction,
 	}
@@ -287,15 +312,16 @@ func (ejc *EmailJobClient) EnqueueTransactionRejected(email string, userName str
 				"user_name":        userName,
 				"transaction_id":   transaction.TransactionID,
 				"transaction_type": transaction.TransactionType,
-				"amount":           utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+				"amount":           ejc.locale.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
 				"reason":           reason,
 				"email":            email,
 			},
 			Priority: "high",
+			Locale:   ejc.locale.String(),
 		},
 		UserName:        userName,
 		TransactionType: string(transaction.TransactionType),
-		Amount:          utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		Amount:          ejc.locale.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
 		TransactionID:   transaction.TransactionID,
 		Reason:          reason,
 		Transaction:     transaction,
@@ -321,6 +347,137 @@ func (ejc *EmailJobClient) EnqueueTransactionRejected(email string, userName str
 	return nil
 }
 
+// EnqueueAccountLockedEmail queues a security alert email after an account lockout
+func (ejc *EmailJobClient) EnqueueAccountLockedEmail(email, userName, lockDuration, ipAddress string) error {
+	payload := EmailJobPayload{
+		To:         []string{email},
+		Subject:    "Your JeanPay Account Has Been Temporarily Locked",
+		TemplateID: "account_locked",
+		Data: map[string]any{
+			"UserName":     userName,
+			"LockDuration": lockDuration,
+			"IPAddress":    ipAddress,
+		},
+		Priority: "high",
+		Locale:   ejc.locale.String(),
+	}
+
+	task, err := createEmailTask(TypeSecurityAlertEmail, payload)
+	if err != nil {
+		return fmt.Errorf("failed to create security alert email task: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue("high"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(5 * time.Minute),
+	}
+
+	info, err := ejc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue security alert email task: %w", err)
+	}
+
+	log.Printf("Enqueued security alert email task: id=%s queue=%s", info.ID, info.Queue)
+	return nil
+}
+
+// EnqueueRateAlertEmail queues an email telling the user a watched rate crossed their threshold
+func (ejc *EmailJobClient) EnqueueRateAlertEmail(email, userName, pair, direction, threshold, rate string) error {
+	payload := EmailJobPayload{
+		To:         []string{email},
+		Subject:    fmt.Sprintf("%s is %s %s", pair, direction, threshold),
+		TemplateID: "rate_alert",
+		Data: map[string]any{
+			"UserName":  userName,
+			"Pair":      pair,
+			"Direction": direction,
+			"Threshold": threshold,
+			"Rate":      rate,
+		},
+		Locale: ejc.locale.String(),
+	}
+
+	task, err := createEmailTask(TypeEmailDelivery, payload)
+	if err != nil {
+		return fmt.Errorf("failed to create rate alert email task: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(5 * time.Minute),
+	}
+
+	info, err := ejc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue rate alert email task: %w", err)
+	}
+
+	log.Printf("Enqueued rate alert email task: id=%s queue=%s", info.ID, info.Queue)
+	return nil
+}
+
+// EnqueueResend queues a logged delivery to be sent again to its recipients
+func (ejc *EmailJobClient) EnqueueResend(deliveryID uint) error {
+	task, err := createEmailTask(TypeEmailResend, EmailResendPayload{DeliveryID: deliveryID})
+	if err != nil {
+		return fmt.Errorf("failed to create email resend task: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(5 * time.Minute),
+	}
+
+	info, err := ejc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue email resend task: %w", err)
+	}
+
+	log.Printf("Enqueued email resend task: id=%s queue=%s delivery_id=%d", info.ID, info.Queue, deliveryID)
+	return nil
+}
+
+// EnqueueCampaignEmail queues the email part of an admin broadcast on the low priority queue,
+// so campaigns never hold up transactional mail. The campaign ID lets the delivery log report
+// the outcome back to the campaign.
+func (ejc *EmailJobClient) EnqueueCampaignEmail(campaignID uint, email, userName, title, message string) error {
+	payload := EmailJobPayload{
+		To:         []string{email},
+		Subject:    title,
+		TemplateID: "campaign",
+		Data: map[string]any{
+			"UserName":   userName,
+			"Title":      title,
+			"Message":    message,
+			"CampaignID": campaignID,
+		},
+		Priority: "low",
+		Locale:   ejc.locale.String(),
+	}
+
+	task, err := createEmailTask(TypeEmailDelivery, payload)
+	if err != nil {
+		return fmt.Errorf("failed to create campaign email task: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue("low"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(5 * time.Minute),
+	}
+
+	info, err := ejc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue campaign email task: %w", err)
+	}
+
+	log.Printf("Enqueued campaign email task: id=%s queue=%s", info.ID, info.Queue)
+	return nil
+}
+
 // Helper function to get user-friendly transaction type display names
 func getTransactionTypeDisplay(transactionType string) string {
 	switch transactionType {
@@ -340,6 +497,9 @@ func getTransactionTypeDisplay(transactionType string) string {
 // EnqueueScheduledEmail queues an email to be sent at a specific time
 func (ejc *EmailJobClient) EnqueueScheduledEmail(payload EmailJobPayload, scheduledAt time.Time) error {
 	payload.ScheduledAt = &scheduledAt
+	if payload.Locale == "" {
+		payload.Locale = ejc.locale.String()
+	}
 
 	task, err := createEmailTask(TypeEmailDelivery, payload)
 	if err != nil {
@@ -389,7 +549,7 @@ func HandleWelcomeEmailTask(ctx context.Context, t *asynq.Task) error {
 		return fmt.Errorf("email sender not initialized")
 	}
 
-	err := emailSender.SendWelcomeEmail(payload.To[0], payload.UserName, payload.Token)
+	err := emailSender.SendWelcomeEmail(payload.To[0], payload.UserName, payload.Token, payload.Locale)
 	if err != nil {
 		return fmt.Errorf("failed to send welcome email: %w", err)
 	}
@@ -415,7 +575,7 @@ func HandleTwoFactorEmailTask(ctx context.Context, t *asynq.Task) error {
 		return fmt.Errorf("email sender not initialized")
 	}
 
-	err := emailSender.SendTwoFactorAuthenticationEmail(payload.To[0], payload.Data["user_name"].(string), payload.Data["verification_link"].(string))
+	err := emailSender.SendTwoFactorAuthenticationEmail(payload.To[0], payload.Data["user_name"].(string), payload.Data["verification_link"].(string), payload.Locale)
 	if err != nil {
 		return fmt.Errorf("failed to send two-factor email: %w", err)
 	}
@@ -440,7 +600,7 @@ func HandlePasswordResetEmailTask(ctx context.Context, t *asynq.Task) error {
 		return fmt.Errorf("email sender not initialized")
 	}
 
-	err := emailSender.SendPasswordResetEmail(payload.To[0], payload.ResetToken)
+	err := emailSender.SendPasswordResetEmail(payload.To[0], payload.ResetToken, payload.Locale)
 	if err != nil {
 		return fmt.Errorf("failed to send password reset email: %w", err)
 	}
@@ -469,6 +629,7 @@ func HandleEmailVerificationTask(ctx context.Context, t *asynq.Task) error {
 		payload.To[0],
 		payload.UserName,
 		payload.VerificationToken,
+		payload.Locale,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to send email verification: %w", err)
@@ -498,6 +659,7 @@ func HandleTransactionApprovedTask(ctx context.Context, t *asynq.Task) error {
 		payload.To[0],
 		payload.UserName,
 		payload.Transaction,
+		payload.Locale,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to send transaction approved email: %w", err)
@@ -528,6 +690,7 @@ func HandleTransactionRejectedTask(ctx context.Context, t *asynq.Task) error {
 		payload.UserName,
 		payload.Transaction,
 		payload.Reason,
+		payload.Locale,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to send transaction rejected email: %w", err)
@@ -537,6 +700,31 @@ func HandleTransactionRejectedTask(ctx context.Context, t *asynq.Task) error {
 	return nil
 }
 
+// HandleSecurityAlertEmailTask handles security alert email delivery
+func HandleSecurityAlertEmailTask(ctx context.Context, t *asynq.Task) error {
+	var payload EmailJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal security alert payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	if err := validateEmailPayload(payload); err != nil {
+		return fmt.Errorf("invalid security alert payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	emailSender := interfaces.GetGlobalEmailSender()
+	if emailSender == nil {
+		return fmt.Errorf("email sender not initialized")
+	}
+
+	err := emailSender.SendTemplatedEmail(payload.To, payload.TemplateID, payload.templateData())
+	if err != nil {
+		return fmt.Errorf("failed to send security alert email: %w", err)
+	}
+
+	log.Printf("Security alert email sent successfully to: %s", payload.To[0])
+	return nil
+}
+
 // HandleGenericEmailTask handles generic email delivery
 func HandleGenericEmailTask(ctx context.Context, t *asynq.Task) error {
 	var payload EmailJobPayload
@@ -555,7 +743,7 @@ func HandleGenericEmailTask(ctx context.Context, t *asynq.Task) error {
 
 	var err error
 	if payload.TemplateID != "" && payload.Data != nil {
-		err = emailSender.SendTemplatedEmail(payload.To, payload.TemplateID, payload.Data)
+		err = emailSender.SendTemplatedEmail(payload.To, payload.TemplateID, payload.templateData())
 	} else {
 		body := fmt.Sprintf("Template: %s\nData: %+v", payload.TemplateID, payload.Data)
 		err = emailSender.SendSimpleEmail(payload.To, payload.Subject, body)
@@ -569,6 +757,18 @@ func HandleGenericEmailTask(ctx context.Context, t *asynq.Task) error {
 	return nil
 }
 
+// templateData returns the template data with the recipient's locale for the email service
+func (p EmailJobPayload) templateData() map[string]any {
+	if p.Locale == "" {
+		return p.Data
+	}
+	if p.Data == nil {
+		p.Data = make(map[string]any)
+	}
+	p.Data["Locale"] = p.Locale
+	return p.Data
+}
+
 // validateEmailPayload validates the email job payload
 func validateEmailPayload(payload EmailJobPayload) error {
 	if len(payload.To) == 0 {
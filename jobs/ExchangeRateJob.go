@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeExchangeRateActivated     = "exchange_rate:activated"
+	TypeExchangeRateFollowUpSweep = "exchange_rate:follow_up_sweep"
+)
+
+// ExchangeRateActivatedPayload describes the rate that just became active for a pair
+type ExchangeRateActivatedPayload struct {
+	ExchangeRateID uint    `json:"exchange_rate_id"`
+	FromCurrency   string  `json:"from_currency"`
+	ToCurrency     string  `json:"to_currency"`
+	Rate           float64 `json:"rate"`
+}
+
+// ExchangeRateJobClient queues work that reacts to exchange rate changes
+type ExchangeRateJobClient struct {
+	client *asynq.Client
+}
+
+// NewExchangeRateJobClient creates a new exchange rate job client
+func NewExchangeRateJobClient() *ExchangeRateJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &ExchangeRateJobClient{
+		client: client,
+	}
+}
+
+// Close closes the exchange rate job client
+func (ejc *ExchangeRateJobClient) Close() error {
+	return ejc.client.Close()
+}
+
+// EnqueueRateActivated queues processing of a newly activated exchange rate
+func (ejc *ExchangeRateJobClient) EnqueueRateActivated(rate models.ExchangeRate) error {
+	payloadBytes, err := json.Marshal(ExchangeRateActivatedPayload{
+		ExchangeRateID: rate.ID,
+		FromCurrency:   rate.FromCurrency,
+		ToCurrency:     rate.ToCurrency,
+		Rate:           rate.Rate,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal exchange rate payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeExchangeRateActivated, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("critical"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(5 * time.Minute),
+	}
+
+	info, err := ejc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue exchange rate task: %w", err)
+	}
+
+	log.Printf("Enqueued exchange rate task: id=%s queue=%s pair=%s-%s rate=%.6f", info.ID, info.Queue, rate.FromCurrency, rate.ToCurrency, rate.Rate)
+	return nil
+}
+
+// NewExchangeRateFollowUpSweepTask is the periodic task that queues follow-ups activations missed
+func NewExchangeRateFollowUpSweepTask() *asynq.Task {
+	return asynq.NewTask(TypeExchangeRateFollowUpSweep, nil)
+}
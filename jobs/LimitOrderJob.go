@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeLimitOrderExpire = "limit_order:expire"
+	TypeLimitOrderSweep  = "limit_order:sweep"
+)
+
+// LimitOrderJobPayload identifies the limit order a scheduled job acts on
+type LimitOrderJobPayload struct {
+	OrderID uint `json:"order_id"`
+}
+
+// LimitOrderJobClient schedules limit order expiry
+type LimitOrderJobClient struct {
+	client *asynq.Client
+}
+
+// NewLimitOrderJobClient creates a new limit order job client
+func NewLimitOrderJobClient() *LimitOrderJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &LimitOrderJobClient{
+		client: client,
+	}
+}
+
+// Close closes the limit order job client
+func (ljc *LimitOrderJobClient) Close() error {
+	return ljc.client.Close()
+}
+
+// ScheduleExpiry queues the order to be expired, and its funds released, at the given time
+func (ljc *LimitOrderJobClient) ScheduleExpiry(orderID uint, at time.Time) error {
+	payloadBytes, err := json.Marshal(LimitOrderJobPayload{OrderID: orderID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal limit order payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeLimitOrderExpire, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("default"),
+		asynq.MaxRetry(5),
+		asynq.Timeout(2 * time.Minute),
+		asynq.ProcessAt(at),
+	}
+
+	info, err := ljc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s task: %w", TypeLimitOrderExpire, err)
+	}
+
+	log.Printf("Scheduled %s task: id=%s queue=%s at=%s", TypeLimitOrderExpire, info.ID, info.Queue, at.Format(time.RFC3339))
+	return nil
+}
+
+// NewLimitOrderSweepTask is the periodic task that expires open orders past their deadline
+func NewLimitOrderSweepTask() *asynq.Task {
+	return asynq.NewTask(TypeLimitOrderSweep, nil)
+}
@@ -7,8 +7,11 @@ import (
 	"log"
 	"time"
 
+	"github.com/Veedsify/JeanPayGoBackend/constants"
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
 	"github.com/hibiken/asynq"
 )
 
@@ -26,6 +29,8 @@ type NotificationJobPayload struct {
 	Type    models.NotificationType `json:"type"`
 	Title   string                  `json:"title"`
 	Message string                  `json:"message"`
+	// CampaignID is set when the notification is part of an admin broadcast
+	CampaignID *uint `json:"campaign_id,omitempty"`
 }
 
 // NotificationUpdatePayload represents the payload for updating a notification
@@ -72,15 +77,37 @@ func (njc *NotificationJobClient) Close() error {
 	return njc.client.Close()
 }
 
-// EnqueueCreateNotification queues a notification creation job
+// EnqueueCreateNotification queues a notification creation job, along with a push to the
+// user's devices
 func (njc *NotificationJobClient) EnqueueCreateNotification(userID uint, notificationType models.NotificationType, title string, message string) error {
-	payload := NotificationJobPayload{
+	if err := njc.EnqueueInAppNotification(userID, notificationType, title, message); err != nil {
+		return err
+	}
+
+	// A failed push must not fail the notification itself
+	if err := njc.EnqueuePush(PushJobPayload{
 		UserID:  userID,
 		Type:    notificationType,
 		Title:   title,
 		Message: message,
+	}); err != nil {
+		log.Printf("Failed to enqueue push for user %d: %v", userID, err)
 	}
+	return nil
+}
 
+// EnqueueInAppNotification queues a notification creation job without a push
+func (njc *NotificationJobClient) EnqueueInAppNotification(userID uint, notificationType models.NotificationType, title string, message string) error {
+	return njc.EnqueueNotification(NotificationJobPayload{
+		UserID:  userID,
+		Type:    notificationType,
+		Title:   title,
+		Message: message,
+	})
+}
+
+// EnqueueNotification queues a notification creation job from a complete payload
+func (njc *NotificationJobClient) EnqueueNotification(payload NotificationJobPayload) error {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal notification payload: %w", err)
@@ -241,18 +268,29 @@ func HandleCreateNotificationTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	notification := models.Notification{
-		UserID:  payload.UserID,
-		Title:   payload.Title,
-		Type:    payload.Type,
-		Message: payload.Message,
-		Read:    false,
+		UserID:     payload.UserID,
+		Title:      payload.Title,
+		Type:       payload.Type,
+		Message:    payload.Message,
+		Read:       false,
+		CampaignID: payload.CampaignID,
 	}
 
 	if err := database.DB.Create(&notification).Error; err != nil {
 		return fmt.Errorf("failed to create notification: %w", err)
 	}
 
+	// A campaign message is delivered in-app once it is in the user's inbox
+	if notification.CampaignID != nil {
+		if err := database.DB.Model(&models.CampaignRecipient{}).
+			Where("campaign_id = ? AND user_id = ? AND status = ?", *notification.CampaignID, notification.UserID, models.RecipientQueued).
+			Updates(map[string]any{"status": models.RecipientDelivered, "delivered_at": time.Now()}).Error; err != nil {
+			log.Printf("Failed to record campaign %d delivery to user %d: %v", *notification.CampaignID, notification.UserID, err)
+		}
+	}
+
 	log.Printf("Notification created successfully for user_id: %d, type: %s", payload.UserID, payload.Type)
+	PublishNotificationCreated(notification)
 	return nil
 }
 
@@ -297,6 +335,12 @@ func HandleUpdateNotificationTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	log.Printf("Notification updated successfully: id=%d", payload.NotificationID)
+	if payload.Read != nil {
+		var notification models.Notification
+		if err := database.DB.Select("user_id").First(&notification, payload.NotificationID).Error; err == nil {
+			PublishUnreadCount(notification.UserID)
+		}
+	}
 	return nil
 }
 
@@ -329,6 +373,7 @@ func HandleMarkNotificationReadTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	log.Printf("Notification marked as read: id=%d, user_id=%d", payload.NotificationID, payload.UserID)
+	PublishUnreadCount(payload.UserID)
 	return nil
 }
 
@@ -353,6 +398,9 @@ func HandleMarkAllNotificationsReadTask(ctx context.Context, t *asynq.Task) erro
 	}
 
 	log.Printf("Marked %d notifications as read for user_id: %d", result.RowsAffected, payload.UserID)
+	if result.RowsAffected > 0 {
+		PublishUnreadCount(payload.UserID)
+	}
 	return nil
 }
 
@@ -384,5 +432,40 @@ func HandleDeleteNotificationTask(ctx context.Context, t *asynq.Task) error {
 	}
 
 	log.Printf("Notification deleted successfully: id=%d, user_id=%d", payload.NotificationID, payload.UserID)
+	PublishUnreadCount(payload.UserID)
 	return nil
 }
+
+// Real-time events
+
+// PublishNotificationCreated pushes a new notification, and the unread count it changes, to the user's open streams
+func PublishNotificationCreated(notification models.Notification) {
+	if err := utils.PublishUserEvent(notification.UserID, constants.EventNotificationCreated, types.NotificationResponse{
+		ID:        notification.ID,
+		UserID:    notification.UserID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Read:      notification.Read,
+		CreatedAt: notification.CreatedAt,
+		UpdatedAt: notification.UpdatedAt,
+	}); err != nil {
+		log.Printf("Failed to publish notification %d: %v", notification.ID, err)
+		return
+	}
+	PublishUnreadCount(notification.UserID)
+}
+
+// PublishUnreadCount pushes the user's current unread notification count to their open streams
+func PublishUnreadCount(userID uint) {
+	var count int64
+	if err := database.DB.Model(&models.Notification{}).
+		Where("user_id = ? AND read = ?", userID, false).
+		Count(&count).Error; err != nil {
+		log.Printf("Failed to count unread notifications for user %d: %v", userID, err)
+		return
+	}
+	if err := utils.PublishUserEvent(userID, constants.EventUnreadCount, map[string]int64{"unread_count": count}); err != nil {
+		log.Printf("Failed to publish unread count for user %d: %v", userID, err)
+	}
+}
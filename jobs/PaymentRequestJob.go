@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypePaymentRequestReminder = "payment_request:reminder"
+	TypePaymentRequestExpire   = "payment_request:expire"
+)
+
+// PaymentRequestJobPayload identifies the payment request a scheduled job acts on
+type PaymentRequestJobPayload struct {
+	RequestID uint `json:"request_id"`
+}
+
+// PaymentRequestJobClient schedules payment request reminders and expiry
+type PaymentRequestJobClient struct {
+	client *asynq.Client
+}
+
+// NewPaymentRequestJobClient creates a new payment request job client
+func NewPaymentRequestJobClient() *PaymentRequestJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &PaymentRequestJobClient{
+		client: client,
+	}
+}
+
+// Close closes the payment request job client
+func (pjc *PaymentRequestJobClient) Close() error {
+	return pjc.client.Close()
+}
+
+// ScheduleReminder queues a reminder to the payer at the given time
+func (pjc *PaymentRequestJobClient) ScheduleReminder(requestID uint, at time.Time) error {
+	return pjc.schedule(TypePaymentRequestReminder, requestID, at)
+}
+
+// ScheduleExpiry queues the request to be expired at the given time
+func (pjc *PaymentRequestJobClient) ScheduleExpiry(requestID uint, at time.Time) error {
+	return pjc.schedule(TypePaymentRequestExpire, requestID, at)
+}
+
+func (pjc *PaymentRequestJobClient) schedule(taskType string, requestID uint, at time.Time) error {
+	payloadBytes, err := json.Marshal(PaymentRequestJobPayload{RequestID: requestID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payment request payload: %w", err)
+	}
+
+	task := asynq.NewTask(taskType, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("low"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(2 * time.Minute),
+		asynq.ProcessAt(at),
+	}
+
+	info, err := pjc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s task: %w", taskType, err)
+	}
+
+	log.Printf("Scheduled %s task: id=%s queue=%s at=%s", taskType, info.ID, info.Queue, at.Format(time.RFC3339))
+	return nil
+}
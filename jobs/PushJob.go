@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/hibiken/asynq"
+)
+
+const TypePushDelivery = "push:delivery"
+
+// PushJobPayload carries an in-app notification out to the user's registered devices
+type PushJobPayload struct {
+	UserID  uint                    `json:"user_id"`
+	Type    models.NotificationType `json:"type"`
+	Title   string                  `json:"title"`
+	Message string                  `json:"message"`
+	// Category selects the preference the worker checks; when empty it is derived from Type
+	Category models.NotificationCategory `json:"category,omitempty"`
+	// CampaignID is set when the push is part of an admin broadcast
+	CampaignID *uint `json:"campaign_id,omitempty"`
+}
+
+// EnqueuePush queues a push for every device the user has registered. Whether the push is
+// actually sent is decided by the worker, which checks the user's preferences.
+func (njc *NotificationJobClient) EnqueuePush(payload PushJobPayload) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypePushDelivery, payloadBytes)
+
+	queue := "default"
+	if payload.Type == models.SecurityType {
+		queue = "critical"
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue(queue),
+		asynq.MaxRetry(constants.PushMaxRetries),
+		asynq.Timeout(time.Minute),
+	}
+
+	info, err := njc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s task: %w", TypePushDelivery, err)
+	}
+
+	log.Printf("Enqueued %s task: id=%s queue=%s user_id=%d", TypePushDelivery, info.ID, info.Queue, payload.UserID)
+	return nil
+}
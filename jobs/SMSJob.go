@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/hibiken/asynq"
+)
+
+const TypeSMSDelivery = "sms:delivery"
+
+// SMSJobPayload identifies the recorded message a delivery job sends
+type SMSJobPayload struct {
+	MessageID uint `json:"message_id"`
+}
+
+// SMSJobClient queues text messages for delivery through the SMS gateway
+type SMSJobClient struct {
+	client *asynq.Client
+}
+
+// NewSMSJobClient creates a new SMS job client
+func NewSMSJobClient() *SMSJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &SMSJobClient{
+		client: client,
+	}
+}
+
+// Close closes the SMS job client
+func (sjc *SMSJobClient) Close() error {
+	return sjc.client.Close()
+}
+
+// EnqueueDelivery queues a recorded message for sending. OTPs go on the critical queue
+// since the user is waiting on them; everything else uses the default queue.
+func (sjc *SMSJobClient) EnqueueDelivery(messageID uint, template string) error {
+	payloadBytes, err := json.Marshal(SMSJobPayload{MessageID: messageID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal SMS payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeSMSDelivery, payloadBytes)
+
+	queue := "default"
+	if template == constants.SMSTemplateOTP {
+		queue = "critical"
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue(queue),
+		asynq.MaxRetry(constants.SMSMaxRetries),
+		asynq.Timeout(30 * time.Second),
+	}
+
+	info, err := sjc.client.Enqueue(task, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s task: %w", TypeSMSDelivery, err)
+	}
+
+	log.Printf("Enqueued %s task: id=%s queue=%s message_id=%d", TypeSMSDelivery, info.ID, info.Queue, messageID)
+	return nil
+}
@@ -0,0 +1,74 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeScheduledTransferSweep = "scheduled_transfer:sweep"
+	TypeScheduledTransferRun   = "scheduled_transfer:run"
+)
+
+// ScheduledTransferRunPayload identifies the schedule occurrence a run job executes
+type ScheduledTransferRunPayload struct {
+	ScheduleID uint `json:"schedule_id"`
+	Occurrence int  `json:"occurrence"`
+}
+
+// ScheduledTransferJobClient queues runs of scheduled transfers
+type ScheduledTransferJobClient struct {
+	client *asynq.Client
+}
+
+// NewScheduledTransferJobClient creates a new scheduled transfer job client
+func NewScheduledTransferJobClient() *ScheduledTransferJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &ScheduledTransferJobClient{
+		client: client,
+	}
+}
+
+// Close closes the scheduled transfer job client
+func (sjc *ScheduledTransferJobClient) Close() error {
+	return sjc.client.Close()
+}
+
+// EnqueueRun queues one occurrence of a schedule. The task ID is derived from the occurrence,
+// so overlapping sweeps cannot queue the same run twice.
+func (sjc *ScheduledTransferJobClient) EnqueueRun(scheduleID uint, occurrence int) error {
+	payloadBytes, err := json.Marshal(ScheduledTransferRunPayload{ScheduleID: scheduleID, Occurrence: occurrence})
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduled transfer payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeScheduledTransferRun, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("high"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(2 * time.Minute),
+		asynq.TaskID(fmt.Sprintf("scheduled_transfer:%d:%d", scheduleID, occurrence)),
+	}
+
+	info, err := sjc.client.Enqueue(task, opts...)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue scheduled transfer run: %w", err)
+	}
+
+	log.Printf("Enqueued scheduled transfer run: id=%s queue=%s schedule_id=%d occurrence=%d", info.ID, info.Queue, scheduleID, occurrence)
+	return nil
+}
+
+// NewScheduledTransferSweepTask is the periodic task that finds schedules due to run
+func NewScheduledTransferSweepTask() *asynq.Task {
+	return asynq.NewTask(TypeScheduledTransferSweep, nil)
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeStatementGenerate = "statement:generate"
+	TypeStatementPurge    = "statement:purge"
+)
+
+// StatementJobPayload identifies the statement a generate job renders
+type StatementJobPayload struct {
+	StatementID uint `json:"statement_id"`
+}
+
+// StatementJobClient queues statements that are too large to generate during a request
+type StatementJobClient struct {
+	client *asynq.Client
+}
+
+// NewStatementJobClient creates a new statement job client
+func NewStatementJobClient() *StatementJobClient {
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr})
+	return &StatementJobClient{
+		client: client,
+	}
+}
+
+// Close closes the statement job client
+func (sjc *StatementJobClient) Close() error {
+	return sjc.client.Close()
+}
+
+// EnqueueGenerate queues a pending statement to be rendered and delivered
+func (sjc *StatementJobClient) EnqueueGenerate(statementID uint) error {
+	payloadBytes, err := json.Marshal(StatementJobPayload{StatementID: statementID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal statement payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeStatementGenerate, payloadBytes)
+
+	opts := []asynq.Option{
+		asynq.Queue("low"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(10 * time.Minute),
+		asynq.TaskID(fmt.Sprintf("statement:%d", statementID)),
+	}
+
+	info, err := sjc.client.Enqueue(task, opts...)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue %s task: %w", TypeStatementGenerate, err)
+	}
+
+	log.Printf("Enqueued %s task: id=%s queue=%s statement_id=%d", TypeStatementGenerate, info.ID, info.Queue, statementID)
+	return nil
+}
+
+// NewStatementPurgeTask is the periodic task that removes expired statement files
+func NewStatementPurgeTask() *asynq.Task {
+	return asynq.NewTask(TypeStatementPurge, nil)
+}
@@ -45,6 +45,7 @@ type TokenPair struct {
 	TokenType          string    `json:"token_type"`
 	IsAdmin            bool      `json:"is_admin"` // Optional, can be nil if not applicable
 	IsTwoFactorEnabled *bool     `json:"is_two_factor_enabled"`
+	ChallengeID        string    `json:"challenge_id,omitempty"` // Set when a 2FA code must be verified
 }
 
 // UserInfo represents user information for token generation
@@ -1,8 +1,10 @@
 package libs
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -15,7 +17,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/dustin/go-humanize"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -106,15 +108,7 @@ func SanitizeString(input string) string {
 
 // FormatCurrency formats a float64 as currency string
 func FormatCurrency(amount float64, currency string) string {
-	formattedAmount := humanize.CommafWithDigits(amount, 2)
-	switch currency {
-	case "NGN":
-		return fmt.Sprintf("₦ %s", formattedAmount)
-	case "GHS":
-		return fmt.Sprintf("GH₵ %s", formattedAmount)
-	default:
-		return fmt.Sprintf(" %s %s", formattedAmount, currency)
-	}
+	return utils.FormatCurrency(amount, currency)
 }
 
 // MaskEmail masks an email address for privacy
@@ -439,33 +433,64 @@ func int64Pow(a, b int) int64 {
 	return result
 }
 
-func GenerateUniqueWalletId() (uint64, uint64) {
-	var NGN, GHS int64
-	var err error
-	if NGN, err = SecureRandomNumber(12); err != nil {
-		return 0, 0
+func SHA256(value string) string {
+	hasher := sha256.New()
+	hasher.Write([]byte(value))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// VerifyHMACSHA512 reports whether signature is the hex HMAC-SHA512 of payload under secret, the
+// scheme Paystack and our SMS and email providers sign webhooks with. An empty secret never verifies.
+func VerifyHMACSHA512(payload []byte, signature, secret string) bool {
+	if secret == "" {
+		return false
 	}
-	if GHS, err = SecureRandomNumber(12); err != nil {
-		return 0, 0
+
+	h := hmac.New(sha512.New, []byte(secret))
+	h.Write(payload)
+	expectedSignature := hex.EncodeToString(h.Sum(nil))
+
+	return hmac.Equal([]byte(signature), []byte(expectedSignature))
+}
+
+// linkSigningLabel separates the link key derived from JWT_SECRET_KEY from the token key itself
+const linkSigningLabel = "jeanpay/link-signing/v1"
+
+// SignLink returns an HMAC-SHA256 signature for a shareable link payload. The key comes from
+// LINK_SIGNING_KEY. Without it a key is derived from JWT_SECRET_KEY, so link signatures never
+// share a key with login tokens.
+func SignLink(payload string) string {
+	key := linkSigningKey()
+	if key == nil {
+		return ""
 	}
-	return uint64(NGN), uint64(GHS)
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(payload))
+	return hex.EncodeToString(h.Sum(nil))
 }
 
-func GetDefaultCurrency(country string) string {
-	switch country {
-	case "NG":
-		return "NGN"
-	case "GH":
-		return "GHS"
-	default:
-		return "NGN"
+func linkSigningKey() []byte {
+	if secret := os.Getenv("LINK_SIGNING_KEY"); secret != "" {
+		return []byte(secret)
 	}
+	jwtSecret := os.Getenv("JWT_SECRET_KEY")
+	if jwtSecret == "" {
+		return nil
+	}
+
+	h := hmac.New(sha256.New, []byte(jwtSecret))
+	h.Write([]byte(linkSigningLabel))
+	return h.Sum(nil)
 }
 
-func SHA256(value string) string {
-	hasher := sha256.New()
-	hasher.Write([]byte(value))
-	return hex.EncodeToString(hasher.Sum(nil))
+// VerifyLinkSignature reports whether signature was produced by SignLink for payload
+func VerifyLinkSignature(payload, signature string) bool {
+	expected := SignLink(payload)
+	if expected == "" || signature == "" {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(expected))
 }
 
 func SetCookie(c *gin.Context, name string, value string, maxAge int64, path string) error {
@@ -0,0 +1,28 @@
+package middlewares
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAuditContext stores who is acting and from where, so admin log entries can record it
+func AdminAuditContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if claims, exists := c.Get("user"); exists {
+			if user, ok := claims.(*libs.JWTClaims); ok {
+				c.Set("admin_actor", newAdminActor(c, user.ID))
+			}
+		}
+		c.Next()
+	}
+}
+
+func newAdminActor(c *gin.Context, adminID uint) types.AdminActor {
+	return types.AdminActor{
+		ID:        adminID,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		RequestID: c.GetString("request_id"),
+	}
+}
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"net/http"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/gin-gonic/gin"
 )
@@ -20,7 +21,7 @@ func AuthMiddleware(jwtService *libs.JWTService) gin.HandlerFunc {
 			if err != nil {
 				tokenString, err = c.Cookie("admin_token")
 				if err != nil {
-					c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": "no token provided"})
+					c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": i18n.T(c, "api.token_missing")})
 					c.Abort()
 					return
 				}
@@ -29,7 +30,7 @@ func AuthMiddleware(jwtService *libs.JWTService) gin.HandlerFunc {
 
 		claims, err := jwtService.ValidateAccessToken(tokenString)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": "invalid or expired token"})
+			c.JSON(http.StatusUnauthorized, gin.H{"error": true, "message": i18n.T(c, "api.token_invalid")})
 			c.Abort()
 			return
 		}
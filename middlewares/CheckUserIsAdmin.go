@@ -3,6 +3,7 @@ package middlewares
 import (
 	"net/http"
 
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/gin-gonic/gin"
 )
@@ -13,16 +14,18 @@ func CheckUserIsAdmin() gin.HandlerFunc {
 		if !exists {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"error":   true,
-				"message": "User not authenticated",
+				"message": i18n.T(c, "api.user_not_authenticated"),
 			})
+			c.Abort()
 			return
 		}
 		IsAdmin := claims.(*libs.JWTClaims).IsAdmin
 		if !IsAdmin {
 			c.JSON(http.StatusForbidden, gin.H{
 				"error":   true,
-				"message": "You do not have permission to access this resource",
+				"message": i18n.T(c, "api.access_denied"),
 			})
+			c.Abort()
 			return
 		}
 		c.Next()
@@ -0,0 +1,20 @@
+package middlewares
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// Locale picks the language API messages are written in, from a lang query parameter or the
+// Accept-Language header, and echoes it in Content-Language
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.Query("lang")
+		if !i18n.Supported(language) {
+			language = i18n.Negotiate(c.GetHeader("Accept-Language"))
+		}
+		i18n.SetContext(c, i18n.NewLocale(language, ""))
+		c.Header("Content-Language", language)
+		c.Next()
+	}
+}
@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"regexp"
+
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/gin-gonic/gin"
+)
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9\-_.]{1,64}$`)
+
+// RequestID tags every request with an ID, reusing a well-formed X-Request-ID header
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-ID")
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = libs.GenerateUUID()
+		}
+		c.Set("request_id", requestID)
+		c.Header("X-Request-ID", requestID)
+		c.Next()
+	}
+}
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/services"
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission only lets admins whose role grants the permission through.
+// Denied requests are recorded in the admin log.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, exists := c.Get("user")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   true,
+				"message": i18n.T(c, "api.admin_authentication_required"),
+			})
+			c.Abort()
+			return
+		}
+
+		adminID := claims.(*libs.JWTClaims).ID
+		allowed, err := services.AdminHasPermission(adminID, permission)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   true,
+				"message": i18n.T(c, "api.permission_check_failed"),
+			})
+			c.Abort()
+			return
+		}
+
+		if !allowed {
+			services.LogPermissionDenied(newAdminActor(c, adminID), permission, c.Request.Method, c.FullPath())
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":      true,
+				"message":    i18n.T(c, "api.permission_denied"),
+				"permission": permission,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
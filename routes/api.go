@@ -7,15 +7,26 @@ import (
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/middlewares"
 	"github.com/Veedsify/JeanPayGoBackend/routes/endpoints"
+	"github.com/Veedsify/JeanPayGoBackend/services"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 )
 
 func ApiRoutes(router *gin.Engine) {
+	registerValidators()
+	router.Use(middlewares.RequestID())
+	router.Use(middlewares.Locale())
 
 	v1 := router.Group(constants.APIBase)
 	public := v1.Group("/")
 	{
 		endpoints.AuthRoutes(public)
+		endpoints.PaymentLinkRoutes(public)
+		endpoints.StatementFileRoutes(public)
+		endpoints.ReceiptRoutes(public)
+		endpoints.SMSWebhookRoutes(public)
+		endpoints.EmailWebhookRoutes(public)
 	}
 	jwtService, err := libs.NewJWTServiceFromEnv()
 	if err != nil {
@@ -28,15 +39,38 @@ func ApiRoutes(router *gin.Engine) {
 		endpoints.WalletRoutes(protected)
 		endpoints.ConvertRoutes(protected)
 		endpoints.TransactionRoutes(protected)
+		endpoints.RecipientRoutes(protected)
+		endpoints.P2PRoutes(protected)
+		endpoints.PaymentRequestRoutes(protected)
+		endpoints.ScheduledTransferRoutes(protected)
+		endpoints.RateAlertRoutes(protected)
+		endpoints.CurrencyRoutes(protected)
+		endpoints.StatementRoutes(protected)
 		endpoints.NotificationRoutes(protected)
+		endpoints.DeviceRoutes(protected)
 		endpoints.SettingsRoutes(protected)
 		endpoints.DashboardRoutes(protected)
 	}
 	admin := v1.Group(constants.AdminBase)
 	admin.Use(middlewares.AuthMiddleware(jwtService))
 	admin.Use(middlewares.CheckUserIsAdmin())
+	admin.Use(middlewares.AdminAuditContext())
 	{
 		endpoints.AdminRoutes(admin)
 	}
 
 }
+
+// registerValidators adds the custom binding tags used by request types
+func registerValidators() {
+	engine, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	// currency accepts any currency enabled in the registry
+	if err := engine.RegisterValidation("currency", func(fl validator.FieldLevel) bool {
+		return services.IsSupportedCurrency(fl.Field().String())
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
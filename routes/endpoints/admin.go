@@ -3,46 +3,101 @@ package endpoints
 import (
 	"github.com/Veedsify/JeanPayGoBackend/constants"
 	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/Veedsify/JeanPayGoBackend/middlewares"
 	"github.com/gin-gonic/gin"
 )
 
 func AdminRoutes(admin *gin.RouterGroup) {
 	{
 		// Admin dashboard routes
-		admin.POST(constants.AdminDashboard, controllers.GetAdminDashboardStatistics)
+		admin.POST(constants.AdminDashboard, middlewares.RequirePermission(constants.PermDashboardView), controllers.GetAdminDashboardStatistics)
 
 		// Admin user management routes
-		admin.POST(constants.AdminUsersBase+constants.AdminUsersAll, controllers.GetAdminUsersAll)
-		admin.POST(constants.AdminUsersBase+constants.AdminUsersDetails, controllers.AdminUsersDetails)
-		admin.PATCH(constants.AdminUsersBase+constants.AdminUserUpdate, controllers.AdminUserUpdate)
-		admin.PATCH(constants.AdminUsersBase+constants.AdminUsersBlock, controllers.BlockUser)
-		admin.PATCH(constants.AdminUsersBase+constants.AdminUsersUnblock, controllers.UnblockUser)
-		admin.GET(constants.AdminUsersBase+constants.AdminUsersTransactions, controllers.GetUserTransactions)
-		admin.GET(constants.AdminUsersBase+constants.AdminUsersWallet, controllers.GetUserWallet)
-		admin.GET(constants.AdminUsersBase+constants.AdminUsersActivityLogs, controllers.GetUserActivityLogs)
-		admin.PATCH(constants.AdminUsersBase+constants.AdminUsersTwoFactor, controllers.ToggleUserTwoFactor)
-		admin.POST(constants.AdminUsersBase+constants.AdminUsersSearch, controllers.SearchUsers)
+		admin.POST(constants.AdminUsersBase+constants.AdminUsersAll, middlewares.RequirePermission(constants.PermUsersView), controllers.GetAdminUsersAll)
+		admin.POST(constants.AdminUsersBase+constants.AdminUsersDetails, middlewares.RequirePermission(constants.PermUsersView), controllers.AdminUsersDetails)
+		admin.PATCH(constants.AdminUsersBase+constants.AdminUserUpdate, middlewares.RequirePermission(constants.PermUsersUpdate), controllers.AdminUserUpdate)
+		admin.PATCH(constants.AdminUsersBase+constants.AdminUsersBlock, middlewares.RequirePermission(constants.PermUsersBlock), controllers.BlockUser)
+		admin.PATCH(constants.AdminUsersBase+constants.AdminUsersUnblock, middlewares.RequirePermission(constants.PermUsersBlock), controllers.UnblockUser)
+		admin.GET(constants.AdminUsersBase+constants.AdminUsersTransactions, middlewares.RequirePermission(constants.PermUsersView), controllers.GetUserTransactions)
+		admin.GET(constants.AdminUsersBase+constants.AdminUsersWallet, middlewares.RequirePermission(constants.PermUsersView), controllers.GetUserWallet)
+		admin.GET(constants.AdminUsersBase+constants.AdminUsersActivityLogs, middlewares.RequirePermission(constants.PermUsersView), controllers.GetUserActivityLogs)
+		admin.PATCH(constants.AdminUsersBase+constants.AdminUsersTwoFactor, middlewares.RequirePermission(constants.PermUsersSecurity), controllers.ToggleUserTwoFactor)
+		admin.POST(constants.AdminUsersBase+constants.AdminUsersSearch, middlewares.RequirePermission(constants.PermUsersView), controllers.SearchUsers)
 
 		// Admin transaction management routes
-		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsAll, controllers.GetAdminTransactionsAll)
-		admin.POST(constants.AdminTransactionsBase+constants.AdminTransactionsDetails, controllers.GetAdminTransactionDetails)
-		admin.PATCH(constants.AdminTransactionsBase+constants.AdminTransactionsApprove, controllers.ApproveAdminTransaction)
-		admin.PATCH(constants.AdminTransactionsBase+constants.AdminTransactionsReject, controllers.RejectAdminTransaction)
-		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsStatus, controllers.AdminTransactionStatus)
-		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsOverview, controllers.AdminTransactionsOverview)
-		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsPending, controllers.GetPendingTransactions)
-		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsFailed, controllers.GetFailedTransactions)
-		admin.POST(constants.AdminTransactionsBase+constants.AdminTransactionsNotes, controllers.AddTransactionNote)
+		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsAll, middlewares.RequirePermission(constants.PermTransactionsView), controllers.GetAdminTransactionsAll)
+		admin.POST(constants.AdminTransactionsBase+constants.AdminTransactionsDetails, middlewares.RequirePermission(constants.PermTransactionsView), controllers.GetAdminTransactionDetails)
+		admin.PATCH(constants.AdminTransactionsBase+constants.AdminTransactionsApprove, middlewares.RequirePermission(constants.PermTransactionsApprove), controllers.ApproveAdminTransaction)
+		admin.PATCH(constants.AdminTransactionsBase+constants.AdminTransactionsReject, middlewares.RequirePermission(constants.PermTransactionsApprove), controllers.RejectAdminTransaction)
+		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsStatus, middlewares.RequirePermission(constants.PermTransactionsView), controllers.AdminTransactionStatus)
+		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsOverview, middlewares.RequirePermission(constants.PermTransactionsView), controllers.AdminTransactionsOverview)
+		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsPending, middlewares.RequirePermission(constants.PermTransactionsView), controllers.GetPendingTransactions)
+		admin.GET(constants.AdminTransactionsBase+constants.AdminTransactionsFailed, middlewares.RequirePermission(constants.PermTransactionsView), controllers.GetFailedTransactions)
+		admin.POST(constants.AdminTransactionsBase+constants.AdminTransactionsNotes, middlewares.RequirePermission(constants.PermTransactionsNotes), controllers.AddTransactionNote)
 
 		// Admin rates management routes
-		admin.GET(constants.AdminRatesBase+constants.AdminRatesHistory, controllers.AdminRatesHistory)
-		admin.POST(constants.AdminRatesBase+constants.AdminRatesAdd, controllers.AdminRatesAdd)
-		admin.PATCH(constants.AdminRatesBase+constants.AdminRatesUpdateById, controllers.UpdateRate)
-		admin.PATCH(constants.AdminRatesBase+constants.AdminRatesToggle, controllers.ToggleRateStatus)
-		admin.DELETE(constants.AdminRatesBase+constants.AdminRatesDelete, controllers.DeleteRate)
+		admin.GET(constants.AdminRatesBase+constants.AdminRatesHistory, middlewares.RequirePermission(constants.PermRatesView), controllers.AdminRatesHistory)
+		admin.POST(constants.AdminRatesBase+constants.AdminRatesAdd, middlewares.RequirePermission(constants.PermRatesManage), controllers.AdminRatesAdd)
+		admin.PATCH(constants.AdminRatesBase+constants.AdminRatesUpdateById, middlewares.RequirePermission(constants.PermRatesManage), controllers.UpdateRate)
+		admin.PATCH(constants.AdminRatesBase+constants.AdminRatesToggle, middlewares.RequirePermission(constants.PermRatesManage), controllers.ToggleRateStatus)
+		admin.DELETE(constants.AdminRatesBase+constants.AdminRatesDelete, middlewares.RequirePermission(constants.PermRatesManage), controllers.DeleteRate)
 
 		// Admin platform settings routes
-		admin.GET(constants.AdminSettingsBase+constants.AdminSettingsGet, controllers.AdminGetPlatformSettings)
-		admin.PATCH(constants.AdminSettingsBase+constants.AdminSettingsUpdate, controllers.AdminUpdatePlatformSettings)
+		admin.GET(constants.AdminSettingsBase+constants.AdminSettingsGet, middlewares.RequirePermission(constants.PermSettingsView), controllers.AdminGetPlatformSettings)
+		admin.PATCH(constants.AdminSettingsBase+constants.AdminSettingsUpdate, middlewares.RequirePermission(constants.PermSettingsManage), controllers.AdminUpdatePlatformSettings)
+
+		// Admin log routes
+		admin.GET(constants.AdminLogsBase+constants.AdminLogsAll, middlewares.RequirePermission(constants.PermLogsView), controllers.GetAdminLogs)
+		admin.GET(constants.AdminLogsBase+constants.AdminLogsNotifications, middlewares.RequirePermission(constants.PermLogsView), controllers.GetNotificationLogs)
+		admin.GET(constants.AdminLogsBase+constants.AdminLogsAudit, middlewares.RequirePermission(constants.PermLogsView), controllers.GetActivityLogs)
+
+		// Admin role management routes
+		admin.GET(constants.AdminRolesBase+constants.AdminRolesMine, controllers.GetMyAdminPermissions)
+		admin.GET(constants.AdminRolesBase+constants.AdminRolesAll, middlewares.RequirePermission(constants.PermRolesManage), controllers.GetAdminRoles)
+		admin.GET(constants.AdminRolesBase+constants.AdminRolesPermissions, middlewares.RequirePermission(constants.PermRolesManage), controllers.GetAdminPermissionsList)
+		admin.POST(constants.AdminRolesBase+constants.AdminRolesCreate, middlewares.RequirePermission(constants.PermRolesManage), controllers.CreateAdminRole)
+		admin.PATCH(constants.AdminRolesBase+constants.AdminRolesUpdate, middlewares.RequirePermission(constants.PermRolesManage), controllers.UpdateAdminRole)
+		admin.DELETE(constants.AdminRolesBase+constants.AdminRolesDelete, middlewares.RequirePermission(constants.PermRolesManage), controllers.DeleteAdminRole)
+		admin.PATCH(constants.AdminRolesBase+constants.AdminRolesAssign, middlewares.RequirePermission(constants.PermRolesManage), controllers.AssignAdminRole)
+
+		// Admin dual approval routes
+		admin.GET(constants.AdminApprovalsBase+constants.AdminApprovalsAll, middlewares.RequirePermission(constants.PermApprovalsReview), controllers.GetChangeRequests)
+		admin.GET(constants.AdminApprovalsBase+constants.AdminApprovalsDetails, middlewares.RequirePermission(constants.PermApprovalsReview), controllers.GetChangeRequestDetails)
+		admin.PATCH(constants.AdminApprovalsBase+constants.AdminApprovalsApprove, middlewares.RequirePermission(constants.PermApprovalsReview), controllers.ApproveChangeRequest)
+		admin.PATCH(constants.AdminApprovalsBase+constants.AdminApprovalsReject, middlewares.RequirePermission(constants.PermApprovalsReview), controllers.RejectChangeRequest)
+		admin.GET(constants.AdminApprovalsBase+constants.AdminApprovalsPolicies, middlewares.RequirePermission(constants.PermApprovalsManage), controllers.GetDualApprovalPolicies)
+		admin.PATCH(constants.AdminApprovalsBase+constants.AdminApprovalsPolicyUpdate, middlewares.RequirePermission(constants.PermApprovalsManage), controllers.UpdateDualApprovalPolicy)
+
+		// Admin currency registry routes
+		admin.GET(constants.AdminCurrenciesBase+constants.AdminCurrenciesAll, middlewares.RequirePermission(constants.PermRatesView), controllers.AdminGetCurrencies)
+		admin.POST(constants.AdminCurrenciesBase+constants.AdminCurrenciesCreate, middlewares.RequirePermission(constants.PermCurrenciesManage), controllers.AdminCreateCurrency)
+		admin.PATCH(constants.AdminCurrenciesBase+constants.AdminCurrenciesUpdate, middlewares.RequirePermission(constants.PermCurrenciesManage), controllers.AdminUpdateCurrency)
+		admin.POST(constants.AdminCorridorsBase+constants.AdminCorridorsCreate, middlewares.RequirePermission(constants.PermCurrenciesManage), controllers.AdminCreateCorridor)
+		admin.PATCH(constants.AdminCorridorsBase+constants.AdminCorridorsUpdate, middlewares.RequirePermission(constants.PermCurrenciesManage), controllers.AdminUpdateCorridor)
+
+		// Admin broadcast campaign routes
+		admin.GET(constants.AdminCampaignsBase+constants.AdminCampaignsAll, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminGetCampaigns)
+		admin.GET(constants.AdminCampaignsBase+constants.AdminCampaignsDetails, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminGetCampaignDetails)
+		admin.POST(constants.AdminCampaignsBase+constants.AdminCampaignsAudience, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminPreviewCampaignAudience)
+		admin.POST(constants.AdminCampaignsBase+constants.AdminCampaignsCreate, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminCreateCampaign)
+		admin.PATCH(constants.AdminCampaignsBase+constants.AdminCampaignsUpdate, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminUpdateCampaign)
+		admin.POST(constants.AdminCampaignsBase+constants.AdminCampaignsSchedule, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminScheduleCampaign)
+		admin.POST(constants.AdminCampaignsBase+constants.AdminCampaignsCancel, middlewares.RequirePermission(constants.PermCampaignsManage), controllers.AdminCancelCampaign)
+
+		// Admin email template routes
+		admin.GET(constants.AdminEmailTemplatesBase+constants.AdminEmailTemplatesAll, middlewares.RequirePermission(constants.PermTemplatesManage), controllers.AdminGetEmailTemplates)
+		admin.GET(constants.AdminEmailTemplatesBase+constants.AdminEmailTemplatesDetails, middlewares.RequirePermission(constants.PermTemplatesManage), controllers.AdminGetEmailTemplate)
+		admin.POST(constants.AdminEmailTemplatesBase+constants.AdminEmailTemplatesCreate, middlewares.RequirePermission(constants.PermTemplatesManage), controllers.AdminCreateEmailTemplateVersion)
+		admin.POST(constants.AdminEmailTemplatesBase+constants.AdminEmailTemplatesPreview, middlewares.RequirePermission(constants.PermTemplatesManage), controllers.AdminPreviewEmailTemplate)
+		admin.POST(constants.AdminEmailTemplatesBase+constants.AdminEmailTemplatesRollback, middlewares.RequirePermission(constants.PermTemplatesManage), controllers.AdminRollbackEmailTemplate)
+
+		// Admin email delivery log and suppression list routes
+		admin.GET(constants.AdminEmailDeliveriesBase+constants.AdminEmailDeliveriesAll, middlewares.RequirePermission(constants.PermLogsView), controllers.AdminGetEmailDeliveries)
+		admin.GET(constants.AdminEmailDeliveriesBase+constants.AdminEmailDeliveriesStats, middlewares.RequirePermission(constants.PermLogsView), controllers.AdminGetEmailDeliveryStats)
+		admin.GET(constants.AdminEmailDeliveriesBase+constants.AdminEmailDeliveriesDetails, middlewares.RequirePermission(constants.PermLogsView), controllers.AdminGetEmailDelivery)
+		admin.POST(constants.AdminEmailDeliveriesBase+constants.AdminEmailDeliveriesResend, middlewares.RequirePermission(constants.PermEmailsManage), controllers.AdminResendEmailDelivery)
+		admin.GET(constants.AdminEmailSuppressionsBase+constants.AdminEmailSuppressionsAll, middlewares.RequirePermission(constants.PermLogsView), controllers.AdminGetEmailSuppressions)
+		admin.POST(constants.AdminEmailSuppressionsBase+constants.AdminEmailSuppressionsCreate, middlewares.RequirePermission(constants.PermEmailsManage), controllers.AdminAddEmailSuppression)
+		admin.DELETE(constants.AdminEmailSuppressionsBase+constants.AdminEmailSuppressionsDelete, middlewares.RequirePermission(constants.PermEmailsManage), controllers.AdminRemoveEmailSuppression)
 	}
 }
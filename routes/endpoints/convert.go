@@ -10,8 +10,14 @@ func ConvertRoutes(router *gin.RouterGroup) {
 	convert := router.Group(constants.ConvertBase)
 	{
 		convert.GET(constants.ConvertRates, controllers.GetExchangeRatesEndpoint)
+		convert.GET(constants.ConvertRatesAt, controllers.GetRateAtEndpoint)
+		convert.GET(constants.ConvertRatesSeries, controllers.GetRateSeriesEndpoint)
 		convert.POST(constants.ConvertCalculate, controllers.CalculateConversionEndpoint)
 		convert.POST(constants.ConvertExchange, controllers.ExecuteConversionEndpoint)
 		convert.GET(constants.ConvertHistory, controllers.GetConversionHistoryEndpoint)
+		convert.GET(constants.ConvertOrders, controllers.GetLimitOrdersEndpoint)
+		convert.POST(constants.ConvertOrdersNew, controllers.CreateLimitOrderEndpoint)
+		convert.GET(constants.ConvertOrderDetail, controllers.GetLimitOrderEndpoint)
+		convert.PUT(constants.ConvertOrderCancel, controllers.CancelLimitOrderEndpoint)
 	}
 }
@@ -0,0 +1,14 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func CurrencyRoutes(router *gin.RouterGroup) {
+	currencies := router.Group(constants.CurrenciesBase)
+	{
+		currencies.GET(constants.CurrenciesAll, controllers.GetCurrenciesEndpoint)
+	}
+}
@@ -0,0 +1,16 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func DeviceRoutes(router *gin.RouterGroup) {
+	devices := router.Group(constants.DevicesBase)
+	{
+		devices.GET(constants.DevicesAll, controllers.GetDevicesEndpoint)
+		devices.POST(constants.DevicesRegister, controllers.RegisterDeviceEndpoint)
+		devices.POST(constants.DevicesUnregister, controllers.UnregisterDeviceEndpoint)
+	}
+}
@@ -10,6 +10,7 @@ func NotificationRoutes(router *gin.RouterGroup) {
 	notifications := router.Group(constants.NotificationsBase)
 	{
 		notifications.GET(constants.NotificationsAll, controllers.GetAllNotificationsEndpoint)
+		notifications.GET(constants.NotificationsStream, controllers.NotificationStreamEndpoint)
 		notifications.PUT(constants.NotificationsMarkRead, controllers.MarkNotificationReadEndpoint)
 		notifications.PUT(constants.NotificationsMarkAllRead, controllers.MarkAllNotificationsReadEndpoint)
 		notifications.POST(constants.NotificationMarkReadBulk, controllers.NotificationMarkReadBulkEndpoint)
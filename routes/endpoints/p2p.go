@@ -0,0 +1,15 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func P2PRoutes(router *gin.RouterGroup) {
+	p2p := router.Group(constants.P2PBase)
+	{
+		p2p.GET(constants.P2PLookup, controllers.LookupP2PRecipientEndpoint)
+		p2p.POST(constants.P2PSend, controllers.SendP2PTransferEndpoint)
+	}
+}
@@ -0,0 +1,28 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func PaymentRequestRoutes(router *gin.RouterGroup) {
+	requests := router.Group(constants.PaymentRequestsBase)
+	{
+		requests.POST(constants.PaymentRequestsNew, controllers.CreatePaymentRequestEndpoint)
+		requests.GET(constants.PaymentRequestsAll, controllers.GetPaymentRequestsEndpoint)
+		requests.GET(constants.PaymentRequestsDetails, controllers.GetPaymentRequestEndpoint)
+		requests.POST(constants.PaymentRequestsPay, controllers.PayPaymentRequestEndpoint)
+		requests.POST(constants.PaymentRequestsDecline, controllers.DeclinePaymentRequestEndpoint)
+	}
+}
+
+// PaymentLinkRoutes are public so anyone holding a link can pay it by card
+func PaymentLinkRoutes(router *gin.RouterGroup) {
+	links := router.Group(constants.PaymentLinksBase)
+	{
+		links.GET(constants.PaymentLinksDetails, controllers.GetPaymentLinkEndpoint)
+		links.POST(constants.PaymentLinksCheckout, controllers.StartPaymentLinkCheckoutEndpoint)
+		links.POST(constants.PaymentLinksConfirm, controllers.ConfirmPaymentLinkCheckoutEndpoint)
+	}
+}
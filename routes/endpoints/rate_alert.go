@@ -0,0 +1,18 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func RateAlertRoutes(router *gin.RouterGroup) {
+	alerts := router.Group(constants.RateAlertsBase)
+	{
+		alerts.GET(constants.RateAlertsAll, controllers.GetRateAlertsEndpoint)
+		alerts.POST(constants.RateAlertsNew, controllers.CreateRateAlertEndpoint)
+		alerts.GET(constants.RateAlertsDetail, controllers.GetRateAlertEndpoint)
+		alerts.PUT(constants.RateAlertsDetail, controllers.UpdateRateAlertEndpoint)
+		alerts.DELETE(constants.RateAlertsDetail, controllers.DeleteRateAlertEndpoint)
+	}
+}
@@ -0,0 +1,16 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ReceiptRoutes are public; the signature in the link authorises the receipt
+func ReceiptRoutes(router *gin.RouterGroup) {
+	receipts := router.Group(constants.ReceiptsBase)
+	{
+		receipts.GET(constants.ReceiptsVerify, controllers.VerifyReceiptEndpoint)
+		receipts.GET(constants.ReceiptsDownload, controllers.DownloadReceiptEndpoint)
+	}
+}
@@ -0,0 +1,18 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func RecipientRoutes(router *gin.RouterGroup) {
+	recipients := router.Group(constants.RecipientsBase)
+	{
+		recipients.GET(constants.RecipientsAll, controllers.GetSavedRecipientsEndpoint)
+		recipients.POST(constants.RecipientsNew, controllers.CreateSavedRecipientEndpoint)
+		recipients.GET(constants.RecipientsDetail, controllers.GetSavedRecipientEndpoint)
+		recipients.PUT(constants.RecipientsDetail, controllers.UpdateSavedRecipientEndpoint)
+		recipients.DELETE(constants.RecipientsDetail, controllers.DeleteSavedRecipientEndpoint)
+	}
+}
@@ -0,0 +1,20 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func ScheduledTransferRoutes(router *gin.RouterGroup) {
+	schedules := router.Group(constants.ScheduledTransfersBase)
+	{
+		schedules.POST(constants.ScheduledTransfersNew, controllers.CreateScheduledTransferEndpoint)
+		schedules.GET(constants.ScheduledTransfersAll, controllers.GetScheduledTransfersEndpoint)
+		schedules.GET(constants.ScheduledTransfersDetail, controllers.GetScheduledTransferEndpoint)
+		schedules.GET(constants.ScheduledTransfersRuns, controllers.GetScheduledTransferRunsEndpoint)
+		schedules.PUT(constants.ScheduledTransfersPause, controllers.PauseScheduledTransferEndpoint)
+		schedules.PUT(constants.ScheduledTransfersResume, controllers.ResumeScheduledTransferEndpoint)
+		schedules.PUT(constants.ScheduledTransfersCancel, controllers.CancelScheduledTransferEndpoint)
+	}
+}
@@ -16,10 +16,16 @@ func SettingsRoutes(router *gin.RouterGroup) {
 		settings.PUT(constants.SettingsPreferences, controllers.UpdatePreferencesEndpoint)
 		settings.PUT(constants.SettingsProfile, controllers.UpdateProfileEndpoint)
 		settings.PUT(constants.SettingsSecurity, controllers.UpdateSecuritySettingsEndpoint)
+		settings.GET(constants.SettingsNotifications, controllers.GetNotificationSettingsEndpoint)
 		settings.PUT(constants.SettingsNotifications, controllers.UpdateNotificationSettingsEndpoint)
 		settings.GET(constants.SettingsTwoFactor, controllers.GenerateTwoFactorQREndpoint)
 		settings.POST(constants.SettingsTwoFactorEnable, controllers.EnableTwoFactorEndpoint)
 		settings.POST(constants.SettingsTwoFactorDisable, controllers.DisableTwoFactorEndpoint)
+		settings.POST(constants.SettingsPin, controllers.SetTransactionPinEndpoint)
+		settings.PUT(constants.SettingsPin, controllers.ChangeTransactionPinEndpoint)
+		settings.POST(constants.SettingsPinResetRequest, controllers.RequestTransactionPinResetEndpoint)
+		settings.POST(constants.SettingsPinReset, controllers.ResetTransactionPinEndpoint)
+		settings.POST(constants.SettingsStepUpCode, controllers.RequestStepUpCodeEndpoint)
 		settings.PUT(constants.SettingsWallet, controllers.SettingsWalletEndpoint)
 	}
 }
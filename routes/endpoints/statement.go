@@ -0,0 +1,24 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+func StatementRoutes(router *gin.RouterGroup) {
+	statements := router.Group(constants.StatementsBase)
+	{
+		statements.POST(constants.StatementsNew, controllers.CreateStatementEndpoint)
+		statements.GET(constants.StatementsAll, controllers.GetStatementsEndpoint)
+		statements.GET(constants.StatementsDetail, controllers.GetStatementEndpoint)
+	}
+}
+
+// StatementFileRoutes are public; the signature in the link authorises the download
+func StatementFileRoutes(router *gin.RouterGroup) {
+	files := router.Group(constants.StatementFilesBase)
+	{
+		files.GET(constants.StatementFilesDownload, controllers.DownloadStatementEndpoint)
+	}
+}
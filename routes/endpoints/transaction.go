@@ -12,8 +12,8 @@ func TransactionRoutes(router *gin.RouterGroup) {
 		transactions.POST(constants.TransactionsNew, controllers.CreateTransactionEndpoint)
 		transactions.GET(constants.TransactionsUserHistory, controllers.GetUserTransactionHistoryEndpoint)
 		transactions.GET(constants.TransactionsDetails, controllers.GetTransactionDetailsEndpoint)
-		transactions.PUT(constants.TransactionsUpdateStatus, controllers.UpdateTransactionStatusEndpoint)
 		transactions.GET(constants.TransactionsStats, controllers.GetTransactionStatsEndpoint)
 		transactions.POST(constants.TransactionsFilter, controllers.FilterTransactionsEndpoint)
+		transactions.DELETE(constants.TransactionsReceiptLinks, controllers.RevokeReceiptLinksEndpoint)
 	}
 }
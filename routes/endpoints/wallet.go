@@ -15,5 +15,11 @@ func WalletRoutes(router *gin.RouterGroup) {
 		wallet.POST(constants.WalletWithdraw, controllers.WithdrawFromWalletEndpoint)
 		wallet.GET(constants.WalletHistory, controllers.GetWalletHistoryEndpoint)
 		wallet.POST(constants.WalletUpdateAfterPayment, controllers.UpdateWalletAfterPaymentEndpoint)
+		wallet.GET(constants.WalletWithdrawMethods, controllers.GetWithdrawMethodsEndpoint)
+		wallet.POST(constants.WalletWithdrawMethodsNew, controllers.CreateWithdrawMethodEndpoint)
+		wallet.POST(constants.WalletWithdrawMethodResolve, controllers.ResolveAccountNameEndpoint)
+		wallet.PUT(constants.WalletWithdrawMethodDetail, controllers.UpdateWithdrawMethodEndpoint)
+		wallet.DELETE(constants.WalletWithdrawMethodDetail, controllers.DeleteWithdrawMethodEndpoint)
+		wallet.PUT(constants.WalletWithdrawMethodDefault, controllers.SetDefaultWithdrawMethodEndpoint)
 	}
 }
@@ -0,0 +1,23 @@
+package endpoints
+
+import (
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// SMSWebhookRoutes are public; the gateway signs each delivery report
+func SMSWebhookRoutes(router *gin.RouterGroup) {
+	webhooks := router.Group(constants.WebhooksBase)
+	{
+		webhooks.POST(constants.WebhooksSMS, controllers.HandleSMSDeliveryReportEndpoint)
+	}
+}
+
+// EmailWebhookRoutes are public; the email provider signs each event it posts
+func EmailWebhookRoutes(router *gin.RouterGroup) {
+	webhooks := router.Group(constants.WebhooksBase)
+	{
+		webhooks.POST(constants.WebhooksEmail, controllers.HandleEmailEventsEndpoint)
+	}
+}
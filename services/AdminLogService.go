@@ -0,0 +1,311 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+// GetAdminLogs returns admin action logs matching the filters
+func GetAdminLogs(params types.GetAdminLogsRequest) (types.GetAdminLogsResponse, error) {
+	var response types.GetAdminLogsResponse
+
+	query, err := adminLogsQuery(params)
+	if err != nil {
+		return response, err
+	}
+
+	page, limit := normalizeLogPage(params.Page, params.Limit)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return response, fmt.Errorf("failed to count admin logs: %w", err)
+	}
+
+	var logs []models.AdminLog
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&logs).Error; err != nil {
+		return response, fmt.Errorf("failed to fetch admin logs: %w", err)
+	}
+
+	response.Logs = types.ToAdminLogsResponse(logs)
+	response.Total = total
+	response.Page = page
+	response.Limit = limit
+	response.TotalPages = totalLogPages(total, limit)
+	return response, nil
+}
+
+// ExportAdminLogsCSV renders admin action logs matching the filters as CSV
+func ExportAdminLogsCSV(params types.GetAdminLogsRequest) ([]byte, error) {
+	query, err := adminLogsQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []models.AdminLog
+	if err := query.Order("created_at DESC").Limit(constants.LogExportMaxRows).Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch admin logs: %w", err)
+	}
+
+	rows := [][]string{{"id", "created_at", "admin_id", "action", "target", "target_id", "details", "ip_address", "user_agent", "request_id", "before", "after"}}
+	for _, log := range logs {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(log.ID), 10),
+			log.CreatedAt.Format(time.RFC3339),
+			strconv.FormatUint(uint64(log.AdminID), 10),
+			log.Action,
+			log.Target,
+			log.TargetID,
+			log.Details,
+			log.IPAddress,
+			log.UserAgent,
+			log.RequestID,
+			log.Before,
+			log.After,
+		})
+	}
+	return writeCSV(rows)
+}
+
+// GetNotificationLogs returns notifications sent to users, matching the filters
+func GetNotificationLogs(params types.GetNotificationLogsRequest) (types.GetNotificationLogsResponse, error) {
+	var response types.GetNotificationLogsResponse
+
+	query, err := notificationLogsQuery(params)
+	if err != nil {
+		return response, err
+	}
+
+	page, limit := normalizeLogPage(params.Page, params.Limit)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return response, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var notifications []models.Notification
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&notifications).Error; err != nil {
+		return response, fmt.Errorf("failed to fetch notifications: %w", err)
+	}
+
+	response.Logs = make([]types.NotificationLogResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		response.Logs = append(response.Logs, toNotificationLogResponse(notification))
+	}
+	response.Total = total
+	response.Page = page
+	response.Limit = limit
+	response.TotalPages = totalLogPages(total, limit)
+	return response, nil
+}
+
+// ExportNotificationLogsCSV renders notifications matching the filters as CSV
+func ExportNotificationLogsCSV(params types.GetNotificationLogsRequest) ([]byte, error) {
+	query, err := notificationLogsQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []models.Notification
+	if err := query.Order("created_at DESC").Limit(constants.LogExportMaxRows).Find(&notifications).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch notifications: %w", err)
+	}
+
+	rows := [][]string{{"id", "created_at", "user_id", "type", "title", "message", "read"}}
+	for _, notification := range notifications {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(notification.ID), 10),
+			notification.CreatedAt.Format(time.RFC3339),
+			strconv.FormatUint(uint64(notification.UserID), 10),
+			string(notification.Type),
+			notification.Title,
+			notification.Message,
+			strconv.FormatBool(notification.Read),
+		})
+	}
+	return writeCSV(rows)
+}
+
+// GetActivityLogs returns user activity across the platform, matching the filters
+func GetActivityLogs(params types.GetActivityLogsRequest) (types.GetActivityLogsResponse, error) {
+	var response types.GetActivityLogsResponse
+
+	query, err := activityLogsQuery(params)
+	if err != nil {
+		return response, err
+	}
+
+	page, limit := normalizeLogPage(params.Page, params.Limit)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return response, fmt.Errorf("failed to count activity logs: %w", err)
+	}
+
+	var activities []models.Activity
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&activities).Error; err != nil {
+		return response, fmt.Errorf("failed to fetch activity logs: %w", err)
+	}
+
+	response.Logs = make([]types.ActivityLogResponse, 0, len(activities))
+	for _, activity := range activities {
+		response.Logs = append(response.Logs, types.ActivityLogResponse{
+			ID:        activity.ID,
+			UserID:    activity.UserID,
+			Activity:  activity.Activity,
+			CreatedAt: activity.CreatedAt,
+		})
+	}
+	response.Total = total
+	response.Page = page
+	response.Limit = limit
+	response.TotalPages = totalLogPages(total, limit)
+	return response, nil
+}
+
+// ExportActivityLogsCSV renders user activity matching the filters as CSV
+func ExportActivityLogsCSV(params types.GetActivityLogsRequest) ([]byte, error) {
+	query, err := activityLogsQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var activities []models.Activity
+	if err := query.Order("created_at DESC").Limit(constants.LogExportMaxRows).Find(&activities).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch activity logs: %w", err)
+	}
+
+	rows := [][]string{{"id", "created_at", "user_id", "activity"}}
+	for _, activity := range activities {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(activity.ID), 10),
+			activity.CreatedAt.Format(time.RFC3339),
+			strconv.FormatUint(uint64(activity.UserID), 10),
+			activity.Activity,
+		})
+	}
+	return writeCSV(rows)
+}
+
+// Helper functions
+
+func adminLogsQuery(params types.GetAdminLogsRequest) (*gorm.DB, error) {
+	query := database.DB.Model(&models.AdminLog{})
+	if params.AdminID != "" {
+		query = query.Where("admin_id = ?", params.AdminID)
+	}
+	if params.Action != "" {
+		query = query.Where("action = ?", params.Action)
+	}
+	if params.Target != "" {
+		query = query.Where("target = ?", params.Target)
+	}
+	if params.TargetID != "" {
+		query = query.Where("target_id = ?", params.TargetID)
+	}
+	if params.RequestID != "" {
+		query = query.Where("request_id = ?", params.RequestID)
+	}
+	if params.IPAddress != "" {
+		query = query.Where("ip_address = ?", params.IPAddress)
+	}
+	return applyLogDateRange(query, params.From, params.To)
+}
+
+func notificationLogsQuery(params types.GetNotificationLogsRequest) (*gorm.DB, error) {
+	query := database.DB.Model(&models.Notification{})
+	if params.UserID != "" {
+		query = query.Where("user_id = ?", params.UserID)
+	}
+	if params.Type != "" {
+		query = query.Where("type = ?", params.Type)
+	}
+	if params.Read != nil {
+		query = query.Where("read = ?", *params.Read)
+	}
+	return applyLogDateRange(query, params.From, params.To)
+}
+
+func activityLogsQuery(params types.GetActivityLogsRequest) (*gorm.DB, error) {
+	query := database.DB.Model(&models.Activity{})
+	if params.UserID != "" {
+		query = query.Where("user_id = ?", params.UserID)
+	}
+	if params.Search != "" {
+		query = query.Where("activity ILIKE ?", "%"+params.Search+"%")
+	}
+	return applyLogDateRange(query, params.From, params.To)
+}
+
+// applyLogDateRange filters by creation date, treating "to" as an inclusive day
+func applyLogDateRange(query *gorm.DB, from, to string) (*gorm.DB, error) {
+	if from != "" {
+		start, err := libs.ParseDate(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: %w", err)
+		}
+		query = query.Where("created_at >= ?", start)
+	}
+	if to != "" {
+		end, err := libs.ParseDate(to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: %w", err)
+		}
+		query = query.Where("created_at < ?", end.AddDate(0, 0, 1))
+	}
+	return query, nil
+}
+
+func normalizeLogPage(page, limit int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return page, limit
+}
+
+func totalLogPages(total int64, limit int) int {
+	return int((total + int64(limit) - 1) / int64(limit))
+}
+
+func toNotificationLogResponse(notification models.Notification) types.NotificationLogResponse {
+	return types.NotificationLogResponse{
+		ID:        notification.ID,
+		UserID:    notification.UserID,
+		Type:      string(notification.Type),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Read:      notification.Read,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+// writeCSV encodes rows as CSV, neutralising cells that spreadsheets would run as formulas
+func writeCSV(rows [][]string) ([]byte, error) {
+	for _, row := range rows {
+		for i, cell := range row {
+			if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+				row[i] = "'" + cell
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,426 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{2,49}$`)
+
+// GetAdminPermissions returns the role name and permission names of an admin
+func GetAdminPermissions(adminID uint) (*types.AdminPermissionsResponse, error) {
+	var user models.User
+	if err := database.DB.Preload("AdminRole.Permissions").First(&user, adminID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.admin_not_found", nil)
+		}
+		return nil, fmt.Errorf("failed to find admin: %w", err)
+	}
+
+	response := &types.AdminPermissionsResponse{Permissions: []string{}}
+	if !user.IsAdmin || user.IsBlocked || user.AdminRole == nil {
+		return response, nil
+	}
+
+	response.Role = user.AdminRole.Name
+	if user.AdminRole.Name == constants.AdminRoleSuperAdmin {
+		for permission := range constants.AdminPermissionDescriptions {
+			response.Permissions = append(response.Permissions, permission)
+		}
+	} else {
+		for _, permission := range user.AdminRole.Permissions {
+			response.Permissions = append(response.Permissions, permission.Name)
+		}
+	}
+	slices.Sort(response.Permissions)
+
+	return response, nil
+}
+
+// AdminHasPermission checks whether an admin's role grants a permission
+func AdminHasPermission(adminID uint, permission string) (bool, error) {
+	permissions, err := GetAdminPermissions(adminID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions.Permissions, permission), nil
+}
+
+// LogPermissionDenied records a rejected admin request in the admin log
+func LogPermissionDenied(actor types.AdminActor, permission, method, path string) {
+	adminLog := actor.NewLog("PERMISSION_DENIED", "route", path, fmt.Sprintf("Admin %d was denied %s %s (requires %s)", actor.ID, method, path, permission))
+	if err := database.DB.Create(&adminLog).Error; err != nil {
+		log.Printf("Failed to log permission denial: %v", err)
+	}
+}
+
+// GetAdminRoles lists every admin role with its permissions
+func GetAdminRoles() ([]types.AdminRoleResponse, error) {
+	var roles []models.AdminRole
+	if err := database.DB.Preload("Permissions").Order("id ASC").Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch admin roles: %w", err)
+	}
+
+	response := make([]types.AdminRoleResponse, 0, len(roles))
+	for _, role := range roles {
+		response = append(response, toAdminRoleResponse(role))
+	}
+	return response, nil
+}
+
+// GetAvailableAdminPermissions lists every permission that can be granted to a role
+func GetAvailableAdminPermissions() ([]models.AdminPermission, error) {
+	var permissions []models.AdminPermission
+	if err := database.DB.Order("name ASC").Find(&permissions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch admin permissions: %w", err)
+	}
+	return permissions, nil
+}
+
+// CreateAdminRole creates a custom admin role
+func CreateAdminRole(req types.CreateAdminRoleRequest, actor types.AdminActor) (types.AdminRoleResponse, error) {
+	name := strings.ToLower(strings.TrimSpace(req.Name))
+	if !roleNamePattern.MatchString(name) {
+		return types.AdminRoleResponse{}, i18n.NewError("error.role_name_invalid", nil)
+	}
+
+	permissions, err := findAdminPermissions(req.Permissions)
+	if err != nil {
+		return types.AdminRoleResponse{}, err
+	}
+	if slices.Contains(req.Permissions, constants.PermRolesManage) {
+		if err := requireSuperAdmin(actor, "error.roles_manage_restricted"); err != nil {
+			return types.AdminRoleResponse{}, err
+		}
+	}
+
+	var count int64
+	database.DB.Model(&models.AdminRole{}).Where("name = ?", name).Count(&count)
+	if count > 0 {
+		return types.AdminRoleResponse{}, i18n.NewError("error.role_exists", nil)
+	}
+
+	role := models.AdminRole{
+		Name:        name,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Permissions: permissions,
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(&role).Error; err != nil {
+		tx.Rollback()
+		return types.AdminRoleResponse{}, fmt.Errorf("failed to create admin role: %w", err)
+	}
+
+	adminLog := actor.NewLog("CREATE_ADMIN_ROLE", "admin_role", fmt.Sprintf("%d", role.ID), fmt.Sprintf("Role %s created with permissions: %s", role.Name, strings.Join(req.Permissions, ", ")))
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return types.AdminRoleResponse{}, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return types.AdminRoleResponse{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return toAdminRoleResponse(role), nil
+}
+
+// UpdateAdminRole updates a role's details and permissions. The super admin role is fixed, admins
+// cannot edit their own role, and only super admins can grant the permission to manage roles.
+func UpdateAdminRole(roleID uint, req types.UpdateAdminRoleRequest, actor types.AdminActor) (types.AdminRoleResponse, error) {
+	var role models.AdminRole
+	if err := database.DB.Preload("Permissions").First(&role, roleID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.AdminRoleResponse{}, i18n.NewError("error.role_not_found", nil)
+		}
+		return types.AdminRoleResponse{}, fmt.Errorf("failed to find role: %w", err)
+	}
+
+	if role.Name == constants.AdminRoleSuperAdmin && req.Permissions != nil {
+		return types.AdminRoleResponse{}, i18n.NewError("error.super_admin_permissions_locked", nil)
+	}
+
+	var actorUser models.User
+	if err := database.DB.Select("id", "admin_role_id").First(&actorUser, actor.ID).Error; err != nil {
+		return types.AdminRoleResponse{}, fmt.Errorf("failed to find admin: %w", err)
+	}
+	if actorUser.AdminRoleID != nil && *actorUser.AdminRoleID == role.ID {
+		return types.AdminRoleResponse{}, i18n.NewError("error.own_role_change", nil)
+	}
+
+	if req.Permissions != nil && slices.Contains(*req.Permissions, constants.PermRolesManage) &&
+		!slices.ContainsFunc(role.Permissions, func(p models.AdminPermission) bool { return p.Name == constants.PermRolesManage }) {
+		if err := requireSuperAdmin(actor, "error.roles_manage_restricted"); err != nil {
+			return types.AdminRoleResponse{}, err
+		}
+	}
+
+	before := toAdminRoleResponse(role)
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	updates := make(map[string]any)
+	if req.DisplayName != "" {
+		updates["display_name"] = req.DisplayName
+	}
+	if req.Description != "" {
+		updates["description"] = req.Description
+	}
+	if len(updates) > 0 {
+		if err := tx.Model(&role).Updates(updates).Error; err != nil {
+			tx.Rollback()
+			return types.AdminRoleResponse{}, fmt.Errorf("failed to update role: %w", err)
+		}
+	}
+
+	if req.Permissions != nil {
+		permissions, err := findAdminPermissions(*req.Permissions)
+		if err != nil {
+			tx.Rollback()
+			return types.AdminRoleResponse{}, err
+		}
+		if err := tx.Model(&role).Association("Permissions").Replace(permissions); err != nil {
+			tx.Rollback()
+			return types.AdminRoleResponse{}, fmt.Errorf("failed to update role permissions: %w", err)
+		}
+		role.Permissions = permissions
+	}
+
+	after := toAdminRoleResponse(role)
+	if displayName, ok := updates["display_name"].(string); ok {
+		after.DisplayName = displayName
+	}
+	if description, ok := updates["description"].(string); ok {
+		after.Description = description
+	}
+	adminLog := actor.NewChangeLog("UPDATE_ADMIN_ROLE", "admin_role", fmt.Sprintf("%d", role.ID), fmt.Sprintf("Role %s updated", role.Name), before, after)
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return types.AdminRoleResponse{}, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return types.AdminRoleResponse{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if err := database.DB.Preload("Permissions").First(&role, roleID).Error; err != nil {
+		return types.AdminRoleResponse{}, fmt.Errorf("failed to fetch updated role: %w", err)
+	}
+	return toAdminRoleResponse(role), nil
+}
+
+// DeleteAdminRole removes a custom role that is not assigned to anyone
+func DeleteAdminRole(roleID uint, actor types.AdminActor) (types.AdminActionResponse, error) {
+	var response types.AdminActionResponse
+
+	var role models.AdminRole
+	if err := database.DB.First(&role, roleID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response, i18n.NewError("error.role_not_found", nil)
+		}
+		return response, fmt.Errorf("failed to find role: %w", err)
+	}
+
+	if role.IsSystem {
+		return response, i18n.NewError("error.built_in_role_delete", nil)
+	}
+
+	var assigned int64
+	database.DB.Model(&models.User{}).Where("admin_role_id = ?", roleID).Count(&assigned)
+	if assigned > 0 {
+		return response, i18n.NewError("error.role_in_use", nil)
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&role).Association("Permissions").Clear(); err != nil {
+		tx.Rollback()
+		return response, fmt.Errorf("failed to clear role permissions: %w", err)
+	}
+	if err := tx.Delete(&role).Error; err != nil {
+		tx.Rollback()
+		return response, fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	adminLog := actor.NewLog("DELETE_ADMIN_ROLE", "admin_role", fmt.Sprintf("%d", role.ID), fmt.Sprintf("Role %s deleted", role.Name))
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return response, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return response, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return types.AdminActionResponse{
+		Success:   true,
+		Message:   "Role deleted successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// AssignAdminRole assigns a role to an admin user. Admins cannot change their own role, and only
+// super admins can grant the super admin role or take it away.
+func AssignAdminRole(userID uint, roleID uint, actor types.AdminActor) (types.AdminActionResponse, error) {
+	var response types.AdminActionResponse
+
+	if userID == actor.ID {
+		return response, i18n.NewError("error.own_role_change", nil)
+	}
+
+	var user models.User
+	if err := database.DB.Preload("AdminRole").First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response, ErrUserNotFound
+		}
+		return response, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.IsAdmin {
+		return response, i18n.NewError("error.role_admins_only", nil)
+	}
+
+	var role models.AdminRole
+	if err := database.DB.First(&role, roleID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response, i18n.NewError("error.role_not_found", nil)
+		}
+		return response, fmt.Errorf("failed to find role: %w", err)
+	}
+
+	previousRole := "none"
+	if user.AdminRole != nil {
+		previousRole = user.AdminRole.Name
+	}
+
+	if role.Name == constants.AdminRoleSuperAdmin || previousRole == constants.AdminRoleSuperAdmin {
+		if err := requireSuperAdmin(actor, "error.super_admin_role_restricted"); err != nil {
+			return response, err
+		}
+	}
+
+	// Never leave the platform without a super admin
+	if previousRole == constants.AdminRoleSuperAdmin && role.Name != constants.AdminRoleSuperAdmin {
+		var superAdmins int64
+		if err := database.DB.Model(&models.User{}).
+			Where("admin_role_id = ? AND is_admin = ? AND is_blocked = ?", user.AdminRoleID, true, false).
+			Count(&superAdmins).Error; err != nil {
+			return response, fmt.Errorf("failed to count super admins: %w", err)
+		}
+		if superAdmins <= 1 {
+			return response, i18n.NewError("error.last_super_admin", nil)
+		}
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&user).Update("admin_role_id", role.ID).Error; err != nil {
+		tx.Rollback()
+		return response, fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	adminLog := actor.NewChangeLog("ASSIGN_ADMIN_ROLE", "user", fmt.Sprintf("%d", user.ID), fmt.Sprintf("Admin role changed from %s to %s", previousRole, role.Name),
+		map[string]string{"admin_role": previousRole}, map[string]string{"admin_role": role.Name})
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return response, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return response, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return types.AdminActionResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Role %s assigned successfully", role.Name),
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// Helper functions
+
+// requireSuperAdmin returns the catalog error messageID unless the actor is a super admin
+func requireSuperAdmin(actor types.AdminActor, messageID string) error {
+	actorPermissions, err := GetAdminPermissions(actor.ID)
+	if err != nil {
+		return err
+	}
+	if actorPermissions.Role != constants.AdminRoleSuperAdmin {
+		return i18n.NewError(messageID, nil)
+	}
+	return nil
+}
+
+func findAdminPermissions(names []string) ([]models.AdminPermission, error) {
+	for _, name := range names {
+		if _, ok := constants.AdminPermissionDescriptions[name]; !ok {
+			return nil, i18n.NewError("error.unknown_permission", i18n.Args{"permission": name})
+		}
+	}
+
+	var permissions []models.AdminPermission
+	if len(names) == 0 {
+		return permissions, nil
+	}
+	if err := database.DB.Where("name IN ?", names).Find(&permissions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch permissions: %w", err)
+	}
+	return permissions, nil
+}
+
+func toAdminRoleResponse(role models.AdminRole) types.AdminRoleResponse {
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, permission.Name)
+	}
+	slices.Sort(permissions)
+
+	var adminCount int64
+	if role.ID != 0 {
+		database.DB.Model(&models.User{}).Where("admin_role_id = ?", role.ID).Count(&adminCount)
+	}
+
+	return types.AdminRoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		DisplayName: role.DisplayName,
+		Description: role.Description,
+		IsSystem:    role.IsSystem,
+		Permissions: permissions,
+		AdminCount:  adminCount,
+	}
+}
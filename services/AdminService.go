@@ -7,11 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Veedsify/JeanPayGoBackend/constants"
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/jobs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
-	"github.com/Veedsify/JeanPayGoBackend/utils"
 	"gorm.io/gorm"
 )
 
@@ -277,7 +278,7 @@ func GetAdminUserDetails(userID uint) (types.UserWithWallet, error) {
 	return response, nil
 }
 
-func UpdateAdminUser(userID uint, data types.UserDetailsEditFields) (types.UserResponse, error) {
+func UpdateAdminUser(userID uint, data types.UserDetailsEditFields, actor types.AdminActor) (types.UserResponse, error) {
 	db := database.DB
 	var user models.User
 
@@ -285,6 +286,7 @@ func UpdateAdminUser(userID uint, data types.UserDetailsEditFields) (types.UserR
 	if err := db.Where("id = ?", userID).First(&user).Error; err != nil {
 		return types.UserResponse{}, fmt.Errorf("user not found: %w", err)
 	}
+	before := adminUserSnapshot(user)
 
 	// Update user fields
 	if strings.Contains(data.Name, " ") {
@@ -327,6 +329,11 @@ func UpdateAdminUser(userID uint, data types.UserDetailsEditFields) (types.UserR
 		}
 	}
 
+	adminLog := actor.NewChangeLog("UPDATE_USER", "user", fmt.Sprintf("%d", user.ID), fmt.Sprintf("User %d details updated", user.ID), before, adminUserSnapshot(user))
+	if err := db.Create(&adminLog).Error; err != nil {
+		fmt.Printf("Failed to create admin log: %v\n", err)
+	}
+
 	return types.UserResponse{
 		ID:             user.ID,
 		UserID:         user.UserID,
@@ -487,8 +494,15 @@ func GetAdminTransactionDetails(transactionID string) (types.AdminTransactionDet
 	}, nil
 }
 
-// ApproveAdminTransaction approves a pending transaction
-func ApproveAdminTransaction(transactionID string, adminID uint) (types.AdminActionResponse, error) {
+// ApproveAdminTransaction approves a pending transaction, or submits it for dual approval
+func ApproveAdminTransaction(transactionID string, actor types.AdminActor) (types.AdminActionResponse, error) {
+	if err := requireDualApproval(models.ChangeApproveTransaction, transactionID, nil, actor); err != nil {
+		return types.AdminActionResponse{}, err
+	}
+	return approveAdminTransaction(transactionID, actor)
+}
+
+func approveAdminTransaction(transactionID string, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 	var transaction models.Transaction
@@ -525,17 +539,12 @@ func ApproveAdminTransaction(transactionID string, adminID uint) (types.AdminAct
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return response, fmt.Errorf("transaction not found or not in pending status")
+		return response, i18n.NewError("error.transaction_not_pending", nil)
 	}
 
 	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "APPROVE_TRANSACTION",
-		Target:   "transaction",
-		TargetID: transactionID,
-		Details:  fmt.Sprintf("Transaction %s approved", transactionID),
-	}
+	adminLog := actor.NewChangeLog("APPROVE_TRANSACTION", "transaction", transactionID, fmt.Sprintf("Transaction %s approved", transactionID),
+		map[string]any{"status": models.TransactionPending}, map[string]any{"status": models.TransactionCompleted})
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return response, err
@@ -551,26 +560,37 @@ func ApproveAdminTransaction(transactionID string, adminID uint) (types.AdminAct
 	}
 
 	tx.Commit()
+	publishTransactionStatus(transaction, models.TransactionCompleted)
+
+	if transaction.TransactionType == models.Transfer {
+		if err := SaveRecipientFromTransaction(transaction.UserID, transaction.PaymentType, transaction.TransactionDetails); err != nil {
+			log.Printf("Failed to save recipient for transaction %s: %v", transactionID, err)
+		}
+	}
 
 	var user models.User
 	if err := db.First(&user, transaction.UserID).Error; err != nil {
 		return response, errors.New("user not found for this transaction")
 	}
 
-	title := "Transaction Successful"
-	message := fmt.Sprintf("Your transfer of %s to %s was successful.", utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency), transaction.TransactionDetails.RecipientName)
-	emailService := jobs.NewEmailJobClient()
-	notificationClient := jobs.NewNotificationJobClient()
-	defer notificationClient.Close()
-	defer emailService.Close()
-	notificationClient.EnqueueCreateNotification(
-		user.ID,
-		models.NotificationType("transfer"),
-		title,
-		message,
-	)
-
-	emailService.EnqueueTransactionApproved(user.Email, user.FirstName, transaction)
+	notifyAndLog(Notice{
+		UserID:   user.ID,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.transaction_successful.title", nil),
+		Message: i18n.Msg("notification.transaction_successful.message", i18n.Args{
+			"amount":    i18n.Amount(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+			"recipient": transaction.TransactionDetails.RecipientName,
+		}),
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueueTransactionApproved(user.Email, user.FirstName, transaction)
+		},
+		PlatformEmail: func(setting models.PlatformSetting) bool {
+			return setting.SendTransactionSuccessEmail
+		},
+		SMSTemplate: constants.SMSTemplateTransactionAlert,
+		SMSData:     transactionAlertSMSData(transaction, models.TransactionCompleted),
+	})
 	response = types.AdminActionResponse{
 		Success:   true,
 		Message:   "Transaction approved successfully",
@@ -581,7 +601,7 @@ func ApproveAdminTransaction(transactionID string, adminID uint) (types.AdminAct
 }
 
 // RejectAdminTransaction rejects a pending transaction
-func RejectAdminTransaction(transactionID string, reason string, adminID uint) (types.AdminActionResponse, error) {
+func RejectAdminTransaction(transactionID string, reason string, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 
@@ -609,17 +629,12 @@ func RejectAdminTransaction(transactionID string, reason string, adminID uint) (
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return response, fmt.Errorf("transaction not found or not in pending status")
+		return response, i18n.NewError("error.transaction_not_pending", nil)
 	}
 
 	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "REJECT_TRANSACTION",
-		Target:   "transaction",
-		TargetID: transactionID,
-		Details:  fmt.Sprintf("Transaction %s rejected: %s", transactionID, reason),
-	}
+	adminLog := actor.NewChangeLog("REJECT_TRANSACTION", "transaction", transactionID, fmt.Sprintf("Transaction %s rejected: %s", transactionID, reason),
+		map[string]any{"status": models.TransactionPending}, map[string]any{"status": models.TransactionFailed, "reason": reason})
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return response, err
@@ -631,28 +646,33 @@ func RejectAdminTransaction(transactionID string, reason string, adminID uint) (
 	if err := db.Preload("TransactionDetails").Where("transaction_id = ?", transactionID).First(&transaction).Error; err != nil {
 		return response, err
 	}
+	publishTransactionStatus(transaction, models.TransactionFailed)
 
 	var user models.User
 	if err := db.First(&user, transaction.UserID).Error; err != nil {
 		return response, errors.New("user not found for this transaction")
 	}
 
-	title := "Transaction Failed"
-	message := fmt.Sprintf("Your transfer of %s to %s was not successful.", utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency), transaction.TransactionDetails.RecipientName)
-	notificationClient := jobs.NewNotificationJobClient()
-	defer notificationClient.Close()
-	notificationClient.EnqueueCreateNotification(
-		user.ID,
-		models.NotificationType("transfer"),
-		title,
-		message,
-	)
-
-	emailClient := jobs.NewEmailJobClient()
-	defer emailClient.Close()
-	err := emailClient.EnqueueTransactionRejected(user.Email, user.FirstName, transaction, reason)
+	err := Notify(Notice{
+		UserID:   user.ID,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.transaction_failed.title", nil),
+		Message: i18n.Msg("notification.transaction_failed.message", i18n.Args{
+			"amount":    i18n.Amount(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+			"recipient": transaction.TransactionDetails.RecipientName,
+		}),
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueueTransactionRejected(user.Email, user.FirstName, transaction, reason)
+		},
+		PlatformEmail: func(setting models.PlatformSetting) bool {
+			return setting.SendTransactionDeclineEmail
+		},
+		SMSTemplate: constants.SMSTemplateTransactionAlert,
+		SMSData:     transactionAlertSMSData(transaction, models.TransactionFailed),
+	})
 	if err != nil {
-		return response, fmt.Errorf("transaction rejected but failed to send notification email: %w", err)
+		return response, fmt.Errorf("transaction rejected but failed to send notification: %w", err)
 	}
 
 	response = types.AdminActionResponse{
@@ -742,8 +762,16 @@ func GetAdminRatesHistory(cursor uint, limit int, searchQuery string) ([]types.R
 	return response, nextCursor, nil
 }
 
-// AddAdminRate adds a new exchange rate
-func AddAdminRate(rateData types.CreateRateRequest, adminID uint) (types.RateResponse, error) {
+// AddAdminRate adds a new exchange rate, or submits it for dual approval
+func AddAdminRate(rateData types.CreateRateRequest, actor types.AdminActor) (types.RateResponse, error) {
+	pair := fmt.Sprintf("%s-%s", rateData.FromCurrency, rateData.ToCurrency)
+	if err := requireDualApproval(models.ChangeAddRate, pair, rateData, actor); err != nil {
+		return types.RateResponse{}, err
+	}
+	return addAdminRate(rateData, actor)
+}
+
+func addAdminRate(rateData types.CreateRateRequest, actor types.AdminActor) (types.RateResponse, error) {
 	db := database.DB
 
 	// Start transaction
@@ -769,13 +797,7 @@ func AddAdminRate(rateData types.CreateRateRequest, adminID uint) (types.RateRes
 	}
 
 	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "ADD_RATE",
-		Target:   "rate",
-		TargetID: fmt.Sprintf("%d", rate.ID),
-		Details:  fmt.Sprintf("Added exchange rate %s to %s: %.4f", rateData.FromCurrency, rateData.ToCurrency, rateData.Rate),
-	}
+	adminLog := actor.NewLog("ADD_RATE", "rate", fmt.Sprintf("%d", rate.ID), fmt.Sprintf("Added exchange rate %s to %s: %.4f", rateData.FromCurrency, rateData.ToCurrency, rateData.Rate))
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return types.RateResponse{}, err
@@ -783,11 +805,15 @@ func AddAdminRate(rateData types.CreateRateRequest, adminID uint) (types.RateRes
 
 	tx.Commit()
 
+	if err := publishAdminRate(rate, actor); err != nil {
+		return types.ToRateResponse(&rate), err
+	}
+
 	return types.ToRateResponse(&rate), nil
 }
 
 // BlockUser blocks a user account
-func BlockUser(userID string, reason string, adminID uint) (types.AdminActionResponse, error) {
+func BlockUser(userID string, reason string, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 
@@ -812,17 +838,12 @@ func BlockUser(userID string, reason string, adminID uint) (types.AdminActionRes
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return response, fmt.Errorf("user not found")
+		return response, ErrUserNotFound
 	}
 
 	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "BLOCK_USER",
-		Target:   "user",
-		TargetID: userID,
-		Details:  fmt.Sprintf("User %s blocked: %s", userID, reason),
-	}
+	adminLog := actor.NewChangeLog("BLOCK_USER", "user", userID, fmt.Sprintf("User %s blocked: %s", userID, reason),
+		map[string]any{"is_blocked": false}, map[string]any{"is_blocked": true})
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return response, err
@@ -840,7 +861,7 @@ func BlockUser(userID string, reason string, adminID uint) (types.AdminActionRes
 }
 
 // UnblockUser unblocks a user account
-func UnblockUser(userID string, adminID uint) (types.AdminActionResponse, error) {
+func UnblockUser(userID string, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 
@@ -864,17 +885,12 @@ func UnblockUser(userID string, adminID uint) (types.AdminActionResponse, error)
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return response, fmt.Errorf("user not found")
+		return response, ErrUserNotFound
 	}
 
 	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "UNBLOCK_USER",
-		Target:   "user",
-		TargetID: userID,
-		Details:  fmt.Sprintf("User %s unblocked", userID),
-	}
+	adminLog := actor.NewChangeLog("UNBLOCK_USER", "user", userID, fmt.Sprintf("User %s unblocked", userID),
+		map[string]any{"is_blocked": true}, map[string]any{"is_blocked": false})
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return response, err
@@ -1016,8 +1032,15 @@ func SearchUsers(query string) ([]types.UserWithWallet, error) {
 	return userWithWallets, nil
 }
 
-// UpdateAdminRate updates an existing exchange rate
-func UpdateAdminRate(rateID uint, rateData types.UpdateRateRequest, adminID uint) (types.RateResponse, error) {
+// UpdateAdminRate updates an existing exchange rate, or submits it for dual approval
+func UpdateAdminRate(rateID uint, rateData types.UpdateRateRequest, actor types.AdminActor) (types.RateResponse, error) {
+	if err := requireDualApproval(models.ChangeUpdateRate, fmt.Sprintf("%d", rateID), rateData, actor); err != nil {
+		return types.RateResponse{}, err
+	}
+	return updateAdminRate(rateID, rateData, actor)
+}
+
+func updateAdminRate(rateID uint, rateData types.UpdateRateRequest, actor types.AdminActor) (types.RateResponse, error) {
 	db := database.DB
 
 	// Start transaction
@@ -1040,6 +1063,12 @@ func UpdateAdminRate(rateID uint, rateData types.UpdateRateRequest, adminID uint
 		updates["active"] = *rateData.Active
 	}
 
+	var before models.Rate
+	if err := tx.Where("id = ?", rateID).First(&before).Error; err != nil {
+		tx.Rollback()
+		return types.RateResponse{}, ErrRateNotFound
+	}
+
 	var rate models.Rate
 	result := tx.Model(&rate).Where("id = ?", rateID).Updates(updates)
 	if result.Error != nil {
@@ -1049,7 +1078,7 @@ func UpdateAdminRate(rateID uint, rateData types.UpdateRateRequest, adminID uint
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return types.RateResponse{}, fmt.Errorf("rate not found")
+		return types.RateResponse{}, ErrRateNotFound
 	}
 
 	// Get updated rate
@@ -1058,14 +1087,16 @@ func UpdateAdminRate(rateID uint, rateData types.UpdateRateRequest, adminID uint
 		return types.RateResponse{}, err
 	}
 
-	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "UPDATE_RATE",
-		Target:   "rate",
-		TargetID: fmt.Sprintf("%d", rateID),
-		Details:  fmt.Sprintf("Updated exchange rate %s to %s", rate.FromCurrency, rate.ToCurrency),
+	if before.Active && !rate.Active {
+		if err := retireAdminExchangeRate(tx, before); err != nil {
+			tx.Rollback()
+			return types.RateResponse{}, err
+		}
 	}
+
+	// Log admin action
+	adminLog := actor.NewChangeLog("UPDATE_RATE", "rate", fmt.Sprintf("%d", rateID), fmt.Sprintf("Updated exchange rate %s to %s", rate.FromCurrency, rate.ToCurrency),
+		types.ToRateResponse(&before), types.ToRateResponse(&rate))
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return types.RateResponse{}, err
@@ -1073,13 +1104,23 @@ func UpdateAdminRate(rateID uint, rateData types.UpdateRateRequest, adminID uint
 
 	tx.Commit()
 
+	if rate.Active && (!before.Active || before.Rate != rate.Rate) {
+		if err := publishAdminRate(rate, actor); err != nil {
+			return types.ToRateResponse(&rate), err
+		}
+	}
+
 	return types.ToRateResponse(&rate), nil
 }
 
-// ToggleRateStatus activates or deactivates a rate
-func ToggleRateStatus(rateID uint, active bool, adminID uint) (types.AdminActionResponse, error) {
-	db := database.DB
+// ToggleRateStatus activates or deactivates a rate, or submits the change for dual approval
+func ToggleRateStatus(rateID uint, active bool, actor types.AdminActor) (types.AdminActionResponse, error) {
 	var response types.AdminActionResponse
+	if err := requireDualApproval(models.ChangeUpdateRate, fmt.Sprintf("%d", rateID), types.UpdateRateRequest{Active: &active}, actor); err != nil {
+		return response, err
+	}
+
+	db := database.DB
 
 	// Start transaction
 	tx := db.Begin()
@@ -1089,6 +1130,12 @@ func ToggleRateStatus(rateID uint, active bool, adminID uint) (types.AdminAction
 		}
 	}()
 
+	var rate models.Rate
+	if err := tx.Where("id = ?", rateID).First(&rate).Error; err != nil {
+		tx.Rollback()
+		return response, ErrRateNotFound
+	}
+
 	result := tx.Model(&models.Rate{}).Where("id = ?", rateID).Update("active", active)
 	if result.Error != nil {
 		tx.Rollback()
@@ -1097,7 +1144,14 @@ func ToggleRateStatus(rateID uint, active bool, adminID uint) (types.AdminAction
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return response, fmt.Errorf("rate not found")
+		return response, ErrRateNotFound
+	}
+
+	if !active && rate.Active {
+		if err := retireAdminExchangeRate(tx, rate); err != nil {
+			tx.Rollback()
+			return response, err
+		}
 	}
 
 	// Log admin action
@@ -1106,13 +1160,8 @@ func ToggleRateStatus(rateID uint, active bool, adminID uint) (types.AdminAction
 		action = "ACTIVATE_RATE"
 	}
 
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   action,
-		Target:   "rate",
-		TargetID: fmt.Sprintf("%d", rateID),
-		Details:  fmt.Sprintf("Rate %s", action),
-	}
+	adminLog := actor.NewChangeLog(action, "rate", fmt.Sprintf("%d", rateID), fmt.Sprintf("Rate %s", action),
+		map[string]any{"active": rate.Active}, map[string]any{"active": active})
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return response, err
@@ -1120,6 +1169,13 @@ func ToggleRateStatus(rateID uint, active bool, adminID uint) (types.AdminAction
 
 	tx.Commit()
 
+	if active && !rate.Active {
+		rate.Active = true
+		if err := publishAdminRate(rate, actor); err != nil {
+			return response, err
+		}
+	}
+
 	response = types.AdminActionResponse{
 		Success:   true,
 		Message:   "Rate status updated successfully",
@@ -1129,8 +1185,33 @@ func ToggleRateStatus(rateID uint, active bool, adminID uint) (types.AdminAction
 	return response, nil
 }
 
-// DeleteRate deletes an exchange rate
-func DeleteRate(rateID uint, adminID uint) (types.AdminActionResponse, error) {
+// publishAdminRate makes an active admin rate the rate conversions are priced at
+func publishAdminRate(rate models.Rate, actor types.AdminActor) error {
+	source := models.ExchangeRateSource(rate.Source)
+	if source != models.API {
+		source = models.Manual
+	}
+	_, err := ActivateExchangeRate(rate.FromCurrency, rate.ToCurrency, rate.Rate, source, fmt.Sprintf("%d", actor.ID), &rate.ID)
+	if errors.Is(err, ErrRateFollowUpNotQueued) {
+		// The rate is live; the follow-up sweep queues the rest
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to publish rate %d (%s-%s): %v", rate.ID, rate.FromCurrency, rate.ToCurrency, err)
+		return err
+	}
+	return nil
+}
+
+// DeleteRate deletes an exchange rate, or submits the deletion for dual approval
+func DeleteRate(rateID uint, actor types.AdminActor) (types.AdminActionResponse, error) {
+	if err := requireDualApproval(models.ChangeDeleteRate, fmt.Sprintf("%d", rateID), nil, actor); err != nil {
+		return types.AdminActionResponse{}, err
+	}
+	return deleteRate(rateID, actor)
+}
+
+func deleteRate(rateID uint, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 
@@ -1158,17 +1239,18 @@ func DeleteRate(rateID uint, adminID uint) (types.AdminActionResponse, error) {
 
 	if result.RowsAffected == 0 {
 		tx.Rollback()
-		return response, fmt.Errorf("rate not found")
+		return response, ErrRateNotFound
 	}
 
-	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "DELETE_RATE",
-		Target:   "rate",
-		TargetID: fmt.Sprintf("%d", rateID),
-		Details:  fmt.Sprintf("Deleted exchange rate %s to %s", rate.FromCurrency, rate.ToCurrency),
+	if rate.Active {
+		if err := retireAdminExchangeRate(tx, rate); err != nil {
+			tx.Rollback()
+			return response, err
+		}
 	}
+
+	// Log admin action
+	adminLog := actor.NewLog("DELETE_RATE", "rate", fmt.Sprintf("%d", rateID), fmt.Sprintf("Deleted exchange rate %s to %s", rate.FromCurrency, rate.ToCurrency))
 	if err := tx.Create(&adminLog).Error; err != nil {
 		tx.Rollback()
 		return response, err
@@ -1226,24 +1308,18 @@ func GetTransactionsByStatus(status models.TransactionStatus, params types.Admin
 }
 
 // AddTransactionNote adds an admin note to a transaction
-func AddTransactionNote(transactionID string, note string, adminID uint) (types.AdminActionResponse, error) {
+func AddTransactionNote(transactionID string, note string, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 
 	// Verify transaction exists
 	var transaction models.Transaction
 	if err := db.Where("transaction_id = ?", transactionID).First(&transaction).Error; err != nil {
-		return response, fmt.Errorf("transaction not found")
+		return response, i18n.NewError("error.transaction_not_found", nil)
 	}
 
 	// Log admin action with note
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "ADD_NOTE",
-		Target:   "transaction",
-		TargetID: transactionID,
-		Details:  fmt.Sprintf("Added note: %s", note),
-	}
+	adminLog := actor.NewLog("ADD_NOTE", "transaction", transactionID, fmt.Sprintf("Added note: %s", note))
 	if err := db.Create(&adminLog).Error; err != nil {
 		return response, err
 	}
@@ -1270,7 +1346,7 @@ func GetUserActivityLogs(userID uint) ([]models.Activity, error) {
 }
 
 // ToggleUserTwoFactor toggles two-factor authentication for a user
-func ToggleUserTwoFactor(userID uint, enabled bool, adminID uint) (types.AdminActionResponse, error) {
+func ToggleUserTwoFactor(userID uint, enabled bool, actor types.AdminActor) (types.AdminActionResponse, error) {
 	db := database.DB
 	var response types.AdminActionResponse
 
@@ -1287,11 +1363,13 @@ func ToggleUserTwoFactor(userID uint, enabled bool, adminID uint) (types.AdminAc
 	if err := tx.Where("id = ?", userID).First(&user).Error; err != nil {
 		tx.Rollback()
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return response, fmt.Errorf("user not found")
+			return response, ErrUserNotFound
 		}
 		return response, fmt.Errorf("failed to find user: %w", err)
 	}
 
+	wasEnabled := user.IsTwoFactorEnabled
+
 	// Update user's two-factor status
 	if err := tx.Model(&user).Update("is_two_factor_enabled", enabled).Error; err != nil {
 		tx.Rollback()
@@ -1306,13 +1384,8 @@ func ToggleUserTwoFactor(userID uint, enabled bool, adminID uint) (types.AdminAc
 		details = fmt.Sprintf("Two-factor authentication enabled for user %d", user.UserID)
 	}
 
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   action,
-		Target:   "user",
-		TargetID: fmt.Sprintf("%d", userID),
-		Details:  details,
-	}
+	adminLog := actor.NewChangeLog(action, "user", fmt.Sprintf("%d", userID), details,
+		map[string]any{"is_two_factor_enabled": wasEnabled}, map[string]any{"is_two_factor_enabled": enabled})
 
 	if err := tx.Create(&adminLog).Error; err != nil {
 		log.Printf("Failed to log admin action: %v", err)
@@ -1334,3 +1407,17 @@ func ToggleUserTwoFactor(userID uint, enabled bool, adminID uint) (types.AdminAc
 
 	return response, nil
 }
+
+// adminUserSnapshot captures the admin-editable fields of a user for audit logs
+func adminUserSnapshot(user models.User) map[string]any {
+	return map[string]any{
+		"first_name":      user.FirstName,
+		"last_name":       user.LastName,
+		"email":           user.Email,
+		"username":        user.Username,
+		"phone_number":    user.PhoneNumber,
+		"profile_picture": user.ProfilePicture,
+		"is_blocked":      user.IsBlocked,
+		"is_verified":     user.IsVerified,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Veedsify/JeanPayGoBackend/constants"
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/jobs"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
@@ -25,8 +26,6 @@ func RegisterUser(user types.RegisterUser) error {
 		return err
 	}
 
-	ngnId, ghsId := libs.GenerateUniqueWalletId()
-
 	createUser := models.User{
 		Email:      user.Email,
 		Password:   hashedPassword,
@@ -37,46 +36,48 @@ func RegisterUser(user types.RegisterUser) error {
 		IsVerified: true,
 		UserID:     uniqUUid,
 		Setting: models.Setting{
-			DefaultCurrency: models.DefaultCurrency(libs.GetDefaultCurrency(string(user.Country))),
-		},
-		Wallet: []models.Wallet{
-			{
-				Currency: "NGN",
-				Balance:  0,
-				WalletID: ngnId,
-			},
-			{
-				Currency: "GHS",
-				Balance:  0,
-				WalletID: ghsId,
-			},
+			DefaultCurrency: models.DefaultCurrency(defaultCurrencyForCountry(string(user.Country))),
 		},
 	}
+	for _, currency := range enabledCurrencies() {
+		createUser.Wallet = append(createUser.Wallet, newWallet(0, currency))
+	}
 
 	if err := database.DB.Create(&createUser).Error; err != nil {
 		return errors.New("sorry this account already exists")
 	}
 
-	emailJob := jobs.NewEmailJobClient()
-	err = emailJob.EnqueueWelcomeEmail(user.Email, user.FirstName, "")
+	err = Notify(Notice{
+		UserID:   createUser.ID,
+		Category: models.CategoryAccount,
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueueWelcomeEmail(user.Email, user.FirstName, "")
+		},
+	})
 	if err != nil {
 		fmt.Printf("Error creating welcome email job: %v\n", err)
 	}
 	return nil
 }
 
-func LoginUser(user types.LoginUser) (*libs.TokenPair, string, error) {
+func LoginUser(user types.LoginUser, ip string) (*libs.TokenPair, string, error) {
+	if err := CheckLoginAllowed(user.Email, ip); err != nil {
+		return &libs.TokenPair{}, "locked", err
+	}
+
 	var dbUser models.User
 	err := database.DB.Where("email = ?", user.Email).First(&dbUser).Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			RecordFailedLogin(user.Email, ip)
 			return &libs.TokenPair{}, "login", errors.New("invalid Email Address Or Password")
 		}
 		return &libs.TokenPair{}, "login", err
 	}
 
 	if err := libs.ComparePassword(dbUser.Password, user.Password); err != nil {
+		RecordFailedLogin(user.Email, ip)
 		return &libs.TokenPair{}, "login", errors.New("invalid Email Address Or Password")
 	}
 
@@ -85,34 +86,39 @@ func LoginUser(user types.LoginUser) (*libs.TokenPair, string, error) {
 	}
 
 	if dbUser.IsBlocked {
-		return &libs.TokenPair{}, "login", errors.New("your account has been disabled, please contact support")
+		return &libs.TokenPair{}, "login", i18n.NewError("error.account_disabled", nil)
 	}
 
 	if dbUser.IsTwoFactorEnabled {
 		enabled := true
 
-		randomVerificationCode := libs.GenerateOTP(6)
-
-		// deterministic hash for Redis key
-		hashedKey := libs.SHA256(randomVerificationCode)
-
-		redisClient := utils.NewRedisClient()
-		cacheKey := fmt.Sprintf("two_factor:%s", hashedKey)
-
-		// store userID, expire in 10 min
-		utils.SetRedisKey(redisClient, cacheKey, dbUser.ID, time.Minute*10)
+		// bind the code to this login attempt, expires in 10 min
+		challengeID, verificationCode, err := CreateLoginChallenge(dbUser.ID)
+		if err != nil {
+			return &libs.TokenPair{}, "login", i18n.NewError("error.two_factor_start_failed", nil)
+		}
 
 		// send raw code to user
-		emailClient := jobs.NewEmailJobClient()
-		emailClient.EnqueueTwoFactorEmail(dbUser.Email, dbUser.FirstName, randomVerificationCode)
+		notifyAndLog(Notice{
+			UserID:   dbUser.ID,
+			Category: models.CategorySecurity,
+			Email: func(client *jobs.EmailJobClient, user models.User) error {
+				return client.EnqueueTwoFactorEmail(user.Email, user.FirstName, verificationCode)
+			},
+			SMSTemplate: constants.SMSTemplateOTP,
+			SMSData:     otpSMSData(verificationCode, constants.OTPChallengeTTL),
+		})
 
 		return &libs.TokenPair{
 			IsTwoFactorEnabled: &enabled,
+			ChallengeID:        challengeID,
 			AccessToken:        "",
 			RefreshToken:       "",
 		}, "login", nil
 	}
 
+	ClearFailedLogins(dbUser.Email)
+
 	loggedInUser := &libs.UserInfo{
 		ID:      dbUser.ID,
 		UserID:  dbUser.UserID,
@@ -160,22 +166,29 @@ func LoginUser(user types.LoginUser) (*libs.TokenPair, string, error) {
 //
 //		return nil
 //	}
-func CreatePasswordReset(email string) (string, error) {
+func CreatePasswordReset(email string, ip string) (string, error) {
+	if err := CheckPasswordResetAllowed(email, ip); err != nil {
+		return "", err
+	}
+
 	user, err := GetUserByEmail(email)
 	if err != nil {
 		return "", errors.New("if your email exists in our system, you will receive a password reset link shortly")
 	}
 
 	if user == nil {
-		return "", errors.New("user not found")
+		return "", ErrUserNotFound
 	}
 
 	resetString := libs.GenerateRandomString(32)
 
-	emailClient := jobs.NewEmailJobClient()
-	defer emailClient.Close()
-
-	emailClient.EnqueuePasswordResetEmail(email, resetString)
+	notifyAndLog(Notice{
+		UserID:   user.ID,
+		Category: models.CategoryAccount,
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueuePasswordResetEmail(user.Email, resetString)
+		},
+	})
 	redisclient := utils.NewRedisClient()
 	cacheKey := fmt.Sprintf("password_reset:%s", resetString)
 	err = utils.SetRedisKey(redisclient, cacheKey, email, time.Duration(15)*time.Minute)
@@ -237,38 +250,46 @@ func ResetPassword(token string, password string) error {
 	return nil
 }
 
-func VerifyOtp(code string) (*libs.TokenPair, string, error) {
-	hashedKey := libs.SHA256(code)
+func VerifyOtp(challengeID string, code string, ip string) (*libs.TokenPair, string, error) {
+	if err := CheckLoginAllowed("", ip); err != nil {
+		return &libs.TokenPair{}, "locked", err
+	}
 
-	redisClient := utils.NewRedisClient()
-	cacheKey := fmt.Sprintf("two_factor:%s", hashedKey)
+	userID, err := VerifyLoginChallenge(challengeID, code)
 
-	// get userID from cache
-	cachedUserId, err := utils.GetRedisValue(redisClient, cacheKey)
+	var dbUser models.User
+	if userID != 0 {
+		if dbErr := database.DB.Where("id = ?", userID).First(&dbUser).Error; dbErr != nil {
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				return &libs.TokenPair{}, "login", ErrInvalidCode
+			}
+			return &libs.TokenPair{}, "login", dbErr
+		}
+	}
 
 	if err != nil {
-		return &libs.TokenPair{}, "login", errors.New("invalid or expired code")
+		RecordFailedLogin(dbUser.Email, ip)
+		if errors.Is(err, ErrTooManyAttempts) {
+			return &libs.TokenPair{}, "login", i18n.NewError("error.too_many_invalid_codes", nil)
+		}
+		return &libs.TokenPair{}, "login", err
 	}
 
-	if cachedUserId == "" {
-		return &libs.TokenPair{}, "login", errors.New("invalid or expired code")
+	if err := CheckLoginAllowed(dbUser.Email, ""); err != nil {
+		return &libs.TokenPair{}, "locked", err
 	}
 
-	// success: delete OTP after use
-	utils.DeleteRedisKey(redisClient, cacheKey)
+	if dbUser.IsBlocked {
+		return &libs.TokenPair{}, "login", i18n.NewError("error.account_disabled", nil)
+	}
+
+	ClearFailedLogins(dbUser.Email)
 
 	jwtService, err := libs.NewJWTServiceFromEnv()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	var dbUser models.User
-	if err := database.DB.Where("id = ?", cachedUserId).First(&dbUser).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &libs.TokenPair{}, "login", errors.New("invalid Email Address Or Password")
-		}
-	}
-
 	loggedInUser := &libs.UserInfo{
 		ID:      dbUser.ID,
 		UserID:  dbUser.UserID,
@@ -308,7 +329,7 @@ func RefreshToken(refreshToken string) (*libs.TokenPair, error) {
 	err = database.DB.Where("id = ?", userInfo.ID).First(&dbUser).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &libs.TokenPair{}, errors.New("user not found")
+			return &libs.TokenPair{}, ErrUserNotFound
 		}
 		return &libs.TokenPair{}, err
 	}
@@ -323,7 +344,7 @@ func RefreshToken(refreshToken string) (*libs.TokenPair, error) {
 	}
 
 	if dbUser.IsBlocked {
-		return &libs.TokenPair{}, errors.New("your account has been disabled, please contact support")
+		return &libs.TokenPair{}, i18n.NewError("error.account_disabled", nil)
 	}
 
 	loggedInUser := &libs.UserInfo{
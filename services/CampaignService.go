@@ -0,0 +1,665 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AdminGetCampaigns lists campaigns, newest first, with their delivery counts
+func AdminGetCampaigns(query types.CampaignQuery) (*types.CampaignsResponse, error) {
+	page, limit := types.ValidatePagination(query.Page, query.Limit)
+
+	db := database.DB.Model(&models.Campaign{})
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count campaigns: %w", err)
+	}
+
+	var campaigns []models.Campaign
+	if err := db.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&campaigns).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch campaigns: %w", err)
+	}
+
+	response := &types.CampaignsResponse{
+		Campaigns:  make([]types.CampaignResponse, 0, len(campaigns)),
+		Pagination: types.NewPaginationResponse(page, limit, total),
+	}
+	for _, campaign := range campaigns {
+		stats, err := campaignStats(campaign)
+		if err != nil {
+			return nil, err
+		}
+		response.Campaigns = append(response.Campaigns, toCampaignResponse(campaign, stats))
+	}
+	return response, nil
+}
+
+// AdminGetCampaign returns one campaign with its delivery counts
+func AdminGetCampaign(campaignID uint) (*types.CampaignResponse, error) {
+	campaign, err := findCampaign(campaignID)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := campaignStats(campaign)
+	if err != nil {
+		return nil, err
+	}
+	response := toCampaignResponse(campaign, stats)
+	return &response, nil
+}
+
+// AdminPreviewCampaignAudience counts the users a segment currently reaches
+func AdminPreviewCampaignAudience(req types.CampaignSegmentRequest) (*types.CampaignAudienceResponse, error) {
+	segment, err := toCampaignSegment(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := campaignAudience(segment).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count audience: %w", err)
+	}
+	return &types.CampaignAudienceResponse{Recipients: count}, nil
+}
+
+// AdminCreateCampaign saves a draft campaign
+func AdminCreateCampaign(req types.CreateCampaignRequest, actor types.AdminActor) (*types.CampaignResponse, error) {
+	segment, err := toCampaignSegment(req.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign := models.Campaign{
+		Title:     strings.TrimSpace(req.Title),
+		Message:   strings.TrimSpace(req.Message),
+		Channels:  joinCampaignChannels(req.Channels),
+		Segment:   segment,
+		Status:    models.CampaignDraft,
+		CreatedBy: actor.ID,
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&campaign).Error; err != nil {
+			return fmt.Errorf("failed to create campaign: %w", err)
+		}
+		adminLog := actor.NewLog("CREATE_CAMPAIGN", "campaign", fmt.Sprint(campaign.ID), fmt.Sprintf("Campaign %q drafted", campaign.Title))
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toCampaignResponse(campaign, types.CampaignStats{})
+	return &response, nil
+}
+
+// AdminUpdateCampaign edits a campaign that has not started sending
+func AdminUpdateCampaign(campaignID uint, req types.UpdateCampaignRequest, actor types.AdminActor) (*types.CampaignResponse, error) {
+	campaign, err := findCampaign(campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status != models.CampaignDraft && campaign.Status != models.CampaignScheduled {
+		return nil, i18n.NewError("error.campaign_not_editable", i18n.Args{"status": campaign.Status})
+	}
+	before := toCampaignResponse(campaign, types.CampaignStats{})
+
+	if req.Title != nil {
+		campaign.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Message != nil {
+		campaign.Message = strings.TrimSpace(*req.Message)
+	}
+	if len(req.Channels) > 0 {
+		campaign.Channels = joinCampaignChannels(req.Channels)
+	}
+	if req.Segment != nil {
+		segment, err := toCampaignSegment(*req.Segment)
+		if err != nil {
+			return nil, err
+		}
+		campaign.Segment = segment
+	}
+	if campaign.Title == "" || campaign.Message == "" {
+		return nil, i18n.NewError("error.campaign_content_required", nil)
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// Select all columns so cleared segment filters are written back as empty
+		result := tx.Model(&campaign).Where("status IN ?", []models.CampaignStatus{models.CampaignDraft, models.CampaignScheduled}).
+			Select("title", "message", "channels", "segment_country", "segment_min_kyc_tier", "segment_max_kyc_tier", "segment_wallet_currency", "segment_min_balance", "segment_max_balance", "segment_active_within_days", "segment_inactive_for_days").
+			Updates(&campaign)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update campaign: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return i18n.NewError("error.campaign_sending", nil)
+		}
+		adminLog := actor.NewChangeLog("UPDATE_CAMPAIGN", "campaign", fmt.Sprint(campaign.ID), fmt.Sprintf("Campaign %q updated", campaign.Title),
+			before, toCampaignResponse(campaign, types.CampaignStats{}))
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toCampaignResponse(campaign, types.CampaignStats{})
+	return &response, nil
+}
+
+// AdminScheduleCampaign queues a draft or scheduled campaign to go out at the given time.
+// Rescheduling replaces the earlier send time.
+func AdminScheduleCampaign(campaignID uint, req types.ScheduleCampaignRequest, actor types.AdminActor) (*types.CampaignResponse, error) {
+	campaign, err := findCampaign(campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status != models.CampaignDraft && campaign.Status != models.CampaignScheduled {
+		return nil, i18n.NewError("error.campaign_not_schedulable", i18n.Args{"status": campaign.Status})
+	}
+
+	sendAt := time.Now()
+	if req.SendAt != "" {
+		sendAt, err = time.Parse(time.RFC3339, req.SendAt)
+		if err != nil {
+			return nil, i18n.NewError("error.campaign_send_at_invalid", nil)
+		}
+		if sendAt.Before(time.Now().Add(-time.Minute)) {
+			return nil, i18n.NewError("error.campaign_send_at_past", nil)
+		}
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Campaign{}).
+			Where("id = ? AND status IN ?", campaign.ID, []models.CampaignStatus{models.CampaignDraft, models.CampaignScheduled}).
+			Updates(map[string]any{"status": models.CampaignScheduled, "scheduled_at": sendAt})
+		if result.Error != nil {
+			return fmt.Errorf("failed to schedule campaign: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return i18n.NewError("error.campaign_sending", nil)
+		}
+		adminLog := actor.NewLog("SCHEDULE_CAMPAIGN", "campaign", fmt.Sprint(campaign.ID),
+			fmt.Sprintf("Campaign %q scheduled for %s", campaign.Title, sendAt.Format(time.RFC3339)))
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	campaignClient := jobs.NewCampaignJobClient()
+	defer campaignClient.Close()
+	if err := campaignClient.EnqueueStart(campaign.ID, sendAt); err != nil {
+		// Put the campaign back so it does not look scheduled with nothing queued
+		database.DB.Model(&models.Campaign{}).Where("id = ?", campaign.ID).
+			Updates(map[string]any{"status": campaign.Status, "scheduled_at": campaign.ScheduledAt})
+		return nil, err
+	}
+
+	campaign.Status = models.CampaignScheduled
+	campaign.ScheduledAt = &sendAt
+	response := toCampaignResponse(campaign, types.CampaignStats{})
+	return &response, nil
+}
+
+// AdminCancelCampaign stops a campaign. Recipients already reached keep their messages;
+// batches that have not run yet are skipped.
+func AdminCancelCampaign(campaignID uint, actor types.AdminActor) (*types.CampaignResponse, error) {
+	campaign, err := findCampaign(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Campaign{}).
+			Where("id = ? AND status IN ?", campaign.ID, []models.CampaignStatus{models.CampaignDraft, models.CampaignScheduled, models.CampaignSending}).
+			Update("status", models.CampaignCancelled)
+		if result.Error != nil {
+			return fmt.Errorf("failed to cancel campaign: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return i18n.NewError("error.campaign_not_cancellable", i18n.Args{"status": campaign.Status})
+		}
+		adminLog := actor.NewLog("CANCEL_CAMPAIGN", "campaign", fmt.Sprint(campaign.ID), fmt.Sprintf("Campaign %q cancelled", campaign.Title))
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	campaign.Status = models.CampaignCancelled
+	stats, err := campaignStats(campaign)
+	if err != nil {
+		return nil, err
+	}
+	response := toCampaignResponse(campaign, stats)
+	return &response, nil
+}
+
+// HandleCampaignStartTask builds the recipient list of a campaign at its send time and queues
+// its batches, spaced out so large audiences are delivered gradually
+func HandleCampaignStartTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.CampaignStartPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal campaign payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	var campaign models.Campaign
+	if err := database.DB.First(&campaign, payload.CampaignID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("campaign %d not found: %w", payload.CampaignID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch campaign: %w", err)
+	}
+
+	// A retried start finds the campaign already sending and only re-queues its batches
+	switch campaign.Status {
+	case models.CampaignScheduled:
+		if campaign.ScheduledAt == nil || campaign.ScheduledAt.Unix() != payload.ScheduledAt {
+			log.Printf("Skipping campaign %d start: rescheduled", campaign.ID)
+			return nil
+		}
+		now := time.Now()
+		claim := database.DB.Model(&models.Campaign{}).
+			Where("id = ? AND status = ?", campaign.ID, models.CampaignScheduled).
+			Updates(map[string]any{"status": models.CampaignSending, "started_at": now})
+		if claim.Error != nil {
+			return fmt.Errorf("failed to start campaign: %w", claim.Error)
+		}
+		if claim.RowsAffected == 0 {
+			return nil
+		}
+	case models.CampaignSending:
+	default:
+		log.Printf("Skipping campaign %d start: campaign is %s", campaign.ID, campaign.Status)
+		return nil
+	}
+
+	total, err := buildCampaignRecipients(campaign)
+	if err != nil {
+		return err
+	}
+	if err := database.DB.Model(&models.Campaign{}).Where("id = ?", campaign.ID).Update("total_recipients", total).Error; err != nil {
+		return fmt.Errorf("failed to update campaign: %w", err)
+	}
+	if total == 0 {
+		return completeCampaign(campaign.ID)
+	}
+
+	campaignClient := jobs.NewCampaignJobClient()
+	defer campaignClient.Close()
+
+	batches := (total + constants.CampaignBatchSize - 1) / constants.CampaignBatchSize
+	for batch := 0; batch < batches; batch++ {
+		if err := campaignClient.EnqueueBatch(campaign.ID, batch, time.Duration(batch)*constants.CampaignBatchInterval); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Campaign %d started: %d recipients in %d batches", campaign.ID, total, batches)
+	return nil
+}
+
+// HandleCampaignBatchTask delivers one batch of a campaign through the notification dispatcher
+func HandleCampaignBatchTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.CampaignBatchPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal campaign batch payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	var campaign models.Campaign
+	if err := database.DB.First(&campaign, payload.CampaignID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("campaign %d not found: %w", payload.CampaignID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch campaign: %w", err)
+	}
+	if campaign.Status != models.CampaignSending {
+		log.Printf("Skipping campaign %d batch %d: campaign is %s", campaign.ID, payload.Batch, campaign.Status)
+		return nil
+	}
+
+	var recipients []models.CampaignRecipient
+	if err := database.DB.Where("campaign_id = ? AND batch = ? AND status = ?", campaign.ID, payload.Batch, models.RecipientPending).
+		Order("id ASC").Find(&recipients).Error; err != nil {
+		return fmt.Errorf("failed to fetch campaign recipients: %w", err)
+	}
+
+	channels := splitCampaignChannels(campaign.Channels)
+	for _, recipient := range recipients {
+		deliverCampaignMessage(campaign, channels, recipient)
+	}
+
+	var pending int64
+	if err := database.DB.Model(&models.CampaignRecipient{}).
+		Where("campaign_id = ? AND status = ?", campaign.ID, models.RecipientPending).
+		Count(&pending).Error; err != nil {
+		return fmt.Errorf("failed to count pending recipients: %w", err)
+	}
+	if pending == 0 {
+		return completeCampaign(campaign.ID)
+	}
+	return nil
+}
+
+// deliverCampaignMessage queues the campaign for one recipient on each allowed channel. The
+// recipient stays queued until a channel reports back through recordCampaignDelivery. Failures
+// to queue are recorded rather than retried so one bad recipient does not resend the whole batch.
+func deliverCampaignMessage(campaign models.Campaign, channels []models.NotificationChannel, recipient models.CampaignRecipient) {
+	notice := Notice{
+		UserID:     recipient.UserID,
+		Category:   models.CategoryPromotional,
+		Type:       models.PromotionType,
+		Title:      i18n.Raw(campaign.Title),
+		Message:    i18n.Raw(campaign.Message),
+		CampaignID: campaign.ID,
+		Channels:   channels,
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueueCampaignEmail(campaign.ID, user.Email, user.FirstName, campaign.Title, campaign.Message)
+		},
+		SMSTemplate: constants.SMSTemplateCampaign,
+		SMSData:     i18n.Args{"title": campaign.Title, "message": campaign.Message},
+	}
+
+	sent, err := dispatch(notice)
+
+	updates := map[string]any{"channels": joinCampaignChannels(channelNames(sent))}
+	switch {
+	case err != nil && len(sent) == 0:
+		updates["status"] = models.RecipientFailed
+		updates["error"] = err.Error()
+	case len(sent) == 0:
+		updates["status"] = models.RecipientSkipped
+	default:
+		updates["status"] = models.RecipientQueued
+		if err != nil {
+			updates["error"] = err.Error()
+		}
+	}
+
+	if err := database.DB.Model(&models.CampaignRecipient{}).Where("id = ?", recipient.ID).Updates(updates).Error; err != nil {
+		log.Printf("Failed to record campaign %d delivery to user %d: %v", campaign.ID, recipient.UserID, err)
+	}
+}
+
+// recordCampaignDelivery applies one channel's result to a queued campaign recipient. An
+// empty failure means the channel delivered, which marks the recipient delivered; otherwise
+// the recipient fails once every channel it was queued on has failed.
+func recordCampaignDelivery(campaignID, userID uint, channel models.NotificationChannel, failure string) {
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// Lock the row so channels reporting at the same time do not overwrite each other's failures
+		var recipient models.CampaignRecipient
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("campaign_id = ? AND user_id = ?", campaignID, userID).First(&recipient).Error; err != nil {
+			return fmt.Errorf("failed to find recipient: %w", err)
+		}
+		// Only a queued recipient moves, so a late report cannot undo a delivery
+		if recipient.Status != models.RecipientQueued {
+			return nil
+		}
+
+		updates := map[string]any{}
+		if failure == "" {
+			updates["status"] = models.RecipientDelivered
+			updates["delivered_at"] = time.Now()
+		} else {
+			failed := joinCampaignChannels(append(channelNames(splitCampaignChannels(recipient.FailedChannels)), string(channel)))
+			updates["failed_channels"] = failed
+			updates["error"] = failure
+			if failed == recipient.Channels {
+				updates["status"] = models.RecipientFailed
+			}
+		}
+		return tx.Model(&recipient).Updates(updates).Error
+	})
+	if err != nil {
+		log.Printf("Failed to record campaign %d %s result for user %d: %v", campaignID, channel, userID, err)
+	}
+}
+
+// buildCampaignRecipients snapshots the campaign's audience into recipient rows, numbering
+// them into batches. Existing rows are kept, so a retried start does not duplicate anyone.
+func buildCampaignRecipients(campaign models.Campaign) (int, error) {
+	var existing int64
+	if err := database.DB.Model(&models.CampaignRecipient{}).Where("campaign_id = ?", campaign.ID).Count(&existing).Error; err != nil {
+		return 0, fmt.Errorf("failed to count campaign recipients: %w", err)
+	}
+	if existing > 0 {
+		return int(existing), nil
+	}
+
+	var userIDs []uint
+	if err := campaignAudience(campaign.Segment).Order("users.id ASC").Pluck("users.id", &userIDs).Error; err != nil {
+		return 0, fmt.Errorf("failed to resolve campaign audience: %w", err)
+	}
+
+	recipients := make([]models.CampaignRecipient, 0, len(userIDs))
+	for i, userID := range userIDs {
+		recipients = append(recipients, models.CampaignRecipient{
+			CampaignID: campaign.ID,
+			UserID:     userID,
+			Batch:      i / constants.CampaignBatchSize,
+			Status:     models.RecipientPending,
+		})
+	}
+	if len(recipients) > 0 {
+		if err := database.DB.Clauses(clause.OnConflict{DoNothing: true}).
+			CreateInBatches(&recipients, constants.CampaignBatchSize).Error; err != nil {
+			return 0, fmt.Errorf("failed to create campaign recipients: %w", err)
+		}
+	}
+	return len(recipients), nil
+}
+
+// campaignAudience selects the unblocked users a segment matches, only verified ones unless
+// the segment sets KYC bounds. Users who turned promotional messages off are left out here as
+// well as by the dispatcher; users without settings get the default and are included.
+func campaignAudience(segment models.CampaignSegment) *gorm.DB {
+	db := database.DB.Model(&models.User{}).
+		Joins("LEFT JOIN settings ON settings.user_id = users.id AND settings.deleted_at IS NULL").
+		Where("users.is_blocked = ? AND users.is_admin = ?", false, false).
+		Where("COALESCE(settings.promotional_notifications, ?) = ?", true, true)
+
+	if segment.Country != "" {
+		db = db.Where("users.country = ?", segment.Country)
+	}
+
+	kycTier := gorm.Expr("CASE WHEN users.is_verified THEN ? ELSE ? END", constants.KycTierVerified, constants.KycTierUnverified)
+	if segment.MinKycTier == nil && segment.MaxKycTier == nil {
+		db = db.Where("users.is_verified = ?", true)
+	}
+	if segment.MinKycTier != nil {
+		db = db.Where("? >= ?", kycTier, *segment.MinKycTier)
+	}
+	if segment.MaxKycTier != nil {
+		db = db.Where("? <= ?", kycTier, *segment.MaxKycTier)
+	}
+
+	if segment.WalletCurrency != "" {
+		wallets := database.DB.Model(&models.Wallet{}).Select("1").
+			Where("wallets.user_id = users.id AND wallets.currency = ?", segment.WalletCurrency)
+		if segment.MinBalance != nil {
+			wallets = wallets.Where("wallets.balance >= ?", *segment.MinBalance)
+		}
+		if segment.MaxBalance != nil {
+			wallets = wallets.Where("wallets.balance <= ?", *segment.MaxBalance)
+		}
+		db = db.Where("EXISTS (?)", wallets)
+	}
+
+	if segment.ActiveWithinDays > 0 {
+		db = db.Where("EXISTS (?)", recentActivity(time.Now().AddDate(0, 0, -segment.ActiveWithinDays)))
+	}
+	if segment.InactiveForDays > 0 {
+		db = db.Where("NOT EXISTS (?)", recentActivity(time.Now().AddDate(0, 0, -segment.InactiveForDays)))
+	}
+	return db
+}
+
+// recentActivity matches users who logged in or moved money since the cutoff
+func recentActivity(since time.Time) *gorm.DB {
+	logins := database.DB.Model(&models.LoginActivity{}).Select("login_activities.user_id").
+		Where("login_activities.user_id = users.id AND login_activities.created_at >= ?", since)
+	transactions := database.DB.Model(&models.Wallet{}).Select("wallets.user_id").
+		Where("wallets.user_id = users.id AND wallets.last_transaction_at >= ?", since)
+	return database.DB.Raw("? UNION ALL ?", logins, transactions)
+}
+
+// campaignStats counts recipients by outcome, plus the campaign notifications that were read
+func campaignStats(campaign models.Campaign) (types.CampaignStats, error) {
+	stats := types.CampaignStats{Recipients: campaign.TotalRecipients}
+
+	var rows []struct {
+		Status models.CampaignRecipientStatus
+		Count  int64
+	}
+	if err := database.DB.Model(&models.CampaignRecipient{}).Select("status, COUNT(*) AS count").
+		Where("campaign_id = ?", campaign.ID).Group("status").Scan(&rows).Error; err != nil {
+		return stats, fmt.Errorf("failed to count campaign recipients: %w", err)
+	}
+	for _, row := range rows {
+		switch row.Status {
+		case models.RecipientPending:
+			stats.Pending = row.Count
+		case models.RecipientQueued:
+			stats.Queued = row.Count
+		case models.RecipientDelivered:
+			stats.Delivered = row.Count
+		case models.RecipientFailed:
+			stats.Failed = row.Count
+		case models.RecipientSkipped:
+			stats.Skipped = row.Count
+		}
+	}
+
+	if err := database.DB.Model(&models.Notification{}).
+		Where("campaign_id = ? AND read = ?", campaign.ID, true).
+		Count(&stats.Read).Error; err != nil {
+		return stats, fmt.Errorf("failed to count read notifications: %w", err)
+	}
+	return stats, nil
+}
+
+func completeCampaign(campaignID uint) error {
+	if err := database.DB.Model(&models.Campaign{}).
+		Where("id = ? AND status = ?", campaignID, models.CampaignSending).
+		Updates(map[string]any{"status": models.CampaignSent, "completed_at": time.Now()}).Error; err != nil {
+		return fmt.Errorf("failed to complete campaign: %w", err)
+	}
+	log.Printf("Campaign %d completed", campaignID)
+	return nil
+}
+
+func findCampaign(campaignID uint) (models.Campaign, error) {
+	var campaign models.Campaign
+	if err := database.DB.First(&campaign, campaignID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return campaign, i18n.NewError("error.campaign_not_found", nil)
+		}
+		return campaign, fmt.Errorf("failed to fetch campaign: %w", err)
+	}
+	return campaign, nil
+}
+
+func toCampaignSegment(req types.CampaignSegmentRequest) (models.CampaignSegment, error) {
+	if (req.MinBalance != nil || req.MaxBalance != nil) && req.WalletCurrency == "" {
+		return models.CampaignSegment{}, i18n.NewError("error.segment_balance_currency", nil)
+	}
+	if req.MinBalance != nil && req.MaxBalance != nil && *req.MinBalance > *req.MaxBalance {
+		return models.CampaignSegment{}, i18n.NewError("error.segment_balance_range", nil)
+	}
+	if req.ActiveWithinDays > 0 && req.InactiveForDays > 0 {
+		return models.CampaignSegment{}, i18n.NewError("error.segment_activity_conflict", nil)
+	}
+	if req.MinKycTier != nil && req.MaxKycTier != nil && *req.MinKycTier > *req.MaxKycTier {
+		return models.CampaignSegment{}, i18n.NewError("error.segment_kyc_range", nil)
+	}
+	return models.CampaignSegment{
+		Country:          strings.ToLower(req.Country),
+		MinKycTier:       req.MinKycTier,
+		MaxKycTier:       req.MaxKycTier,
+		WalletCurrency:   strings.ToUpper(req.WalletCurrency),
+		MinBalance:       req.MinBalance,
+		MaxBalance:       req.MaxBalance,
+		ActiveWithinDays: req.ActiveWithinDays,
+		InactiveForDays:  req.InactiveForDays,
+	}, nil
+}
+
+func toCampaignResponse(campaign models.Campaign, stats types.CampaignStats) types.CampaignResponse {
+	return types.CampaignResponse{
+		ID:       campaign.ID,
+		Title:    campaign.Title,
+		Message:  campaign.Message,
+		Channels: channelNames(splitCampaignChannels(campaign.Channels)),
+		Segment: types.CampaignSegmentRequest{
+			Country:          campaign.Segment.Country,
+			MinKycTier:       campaign.Segment.MinKycTier,
+			MaxKycTier:       campaign.Segment.MaxKycTier,
+			WalletCurrency:   campaign.Segment.WalletCurrency,
+			MinBalance:       campaign.Segment.MinBalance,
+			MaxBalance:       campaign.Segment.MaxBalance,
+			ActiveWithinDays: campaign.Segment.ActiveWithinDays,
+			InactiveForDays:  campaign.Segment.InactiveForDays,
+		},
+		Status:      string(campaign.Status),
+		ScheduledAt: campaign.ScheduledAt,
+		StartedAt:   campaign.StartedAt,
+		CompletedAt: campaign.CompletedAt,
+		CreatedBy:   campaign.CreatedBy,
+		CreatedAt:   campaign.CreatedAt,
+		Stats:       stats,
+	}
+}
+
+// joinCampaignChannels stores channels in the order preferences are shown, without duplicates
+func joinCampaignChannels(channels []string) string {
+	chosen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		chosen[channel] = true
+	}
+	var ordered []string
+	for _, channel := range notificationChannels {
+		if chosen[string(channel)] {
+			ordered = append(ordered, string(channel))
+		}
+	}
+	return strings.Join(ordered, ",")
+}
+
+func splitCampaignChannels(value string) []models.NotificationChannel {
+	var channels []models.NotificationChannel
+	for _, channel := range strings.Split(value, ",") {
+		if channel != "" {
+			channels = append(channels, models.NotificationChannel(channel))
+		}
+	}
+	return channels
+}
+
+func channelNames(channels []models.NotificationChannel) []string {
+	names := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		names = append(names, string(channel))
+	}
+	return names
+}
@@ -16,7 +16,7 @@ import (
 // ConvertCurrency performs actual currency conversion
 func ConvertCurrency(userID uint, req types.ConversionRequest) (*types.ConversionResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Validate request
@@ -33,7 +33,7 @@ func ConvertCurrency(userID uint, req types.ConversionRequest) (*types.Conversio
 	// Check sufficient balance for the source currency
 	currentBalance := getBalanceByCurrency(wallets, req.FromCurrency)
 	if currentBalance < req.Amount {
-		return nil, errors.New("insufficient balance")
+		return nil, ErrInsufficientBalance
 	}
 
 	// Get current exchange rate
@@ -42,10 +42,16 @@ func ConvertCurrency(userID uint, req types.ConversionRequest) (*types.Conversio
 		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
 	}
 
+	return convertCurrency(userID, req, rate, "user_request", nil)
+}
+
+// convertCurrency posts a conversion at the given rate. within, when set, runs inside the same
+// database transaction after the conversion is recorded and before the wallets are updated.
+func convertCurrency(userID uint, req types.ConversionRequest, rate float64, source string, within func(tx *gorm.DB, conversion *models.Conversions) error) (*types.ConversionResponse, error) {
 	// Calculate conversion amounts
 	fee := utils.CalculateFee(req.Amount, 2.0) // 2% fee
 	amountAfterFee := req.Amount - fee
-	convertedAmount := utils.RoundCurrency(amountAfterFee * rate)
+	convertedAmount := utils.RoundToCurrency(amountAfterFee*rate, req.ToCurrency)
 
 	// Start database transaction
 	tx := database.DB.Begin()
@@ -70,7 +76,7 @@ func ConvertCurrency(userID uint, req types.ConversionRequest) (*types.Conversio
 		ConvertedAmount:  convertedAmount,
 		Fee:              utils.RoundCurrency(fee),
 		Rate:             rate,
-		Source:           "user_request",
+		Source:           source,
 		Status:           "pending",
 		EstimatedArrival: "Instant",
 	}
@@ -87,7 +93,7 @@ func ConvertCurrency(userID uint, req types.ConversionRequest) (*types.Conversio
 		Status:          "pending",
 		TransactionType: "conversion",
 		Reference:       conversionID,
-		Direction:       getConversionDirection(req.FromCurrency, req.ToCurrency),
+		Direction:       models.ConversionDirection(req.FromCurrency, req.ToCurrency),
 		Description:     fmt.Sprintf("Convert %s %.2f to %s", req.FromCurrency, req.Amount, req.ToCurrency),
 	}
 
@@ -96,9 +102,16 @@ func ConvertCurrency(userID uint, req types.ConversionRequest) (*types.Conversio
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
 
+	if within != nil {
+		if err := within(tx, &conversion); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	// Update wallet balances
 	// Deduct from source currency
-	err = updateWalletBalanceInTx(tx, userID, req.FromCurrency, -req.Amount, "conversion")
+	err := updateWalletBalanceInTx(tx, userID, req.FromCurrency, -req.Amount, "conversion")
 	if err != nil {
 		tx.Rollback()
 		return nil, fmt.Errorf("failed to deduct from wallet: %w", err)
@@ -148,22 +161,24 @@ func GetExchangeRates() (*types.ExchangeRatesResponse, error) {
 	var lastUpdated time.Time
 	source := "default"
 
-	// Get NGN to GHS rate
-	ngnToGhsRate, err := getCurrentExchangeRate("NGN", "GHS")
-	if err == nil {
-		rates["NGN-GHS"] = ngnToGhsRate
-		source = "database"
-	} else {
-		rates["NGN-GHS"] = 0.0053 // Default rate
+	var corridors []models.Corridor
+	if err := database.DB.Where("is_enabled = ?", true).Find(&corridors).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch corridors: %w", err)
 	}
 
-	// Get GHS to NGN rate
-	ghsToNgnRate, err := getCurrentExchangeRate("GHS", "NGN")
-	if err == nil {
-		rates["GHS_NGN"] = ghsToNgnRate
-		source = "database"
-	} else {
-		rates["GHS_NGN"] = 188.68 // Default rate
+	for _, corridor := range corridors {
+		if !isValidCurrency(corridor.FromCurrency) || !isValidCurrency(corridor.ToCurrency) {
+			continue
+		}
+		var exchangeRate models.ExchangeRate
+		err := database.DB.Where("from_currency = ? AND to_currency = ? AND is_active = ?",
+			corridor.FromCurrency, corridor.ToCurrency, true).First(&exchangeRate).Error
+		if err == nil {
+			rates[string(models.ConversionDirection(corridor.FromCurrency, corridor.ToCurrency))] = exchangeRate.Rate
+			source = "database"
+		} else if corridor.DefaultRate > 0 {
+			rates[string(models.ConversionDirection(corridor.FromCurrency, corridor.ToCurrency))] = corridor.DefaultRate
+		}
 	}
 
 	lastUpdated = time.Now()
@@ -191,7 +206,7 @@ func CalculateConversion(req types.ConversionRequest) (*types.CalculationRespons
 	// Calculate conversion amounts
 	fee := utils.CalculateFee(req.Amount, 2.0) // 2% fee
 	amountAfterFee := req.Amount - fee
-	convertedAmount := utils.RoundCurrency(amountAfterFee * rate)
+	convertedAmount := utils.RoundToCurrency(amountAfterFee*rate, req.ToCurrency)
 
 	return &types.CalculationResponse{
 		FromCurrency:     req.FromCurrency,
@@ -228,7 +243,7 @@ type ConversionHistoryItem struct {
 // GetConversionHistory retrieves conversion history for a user
 func GetConversionHistory(userID uint32, pagination types.PaginationRequest, status, fromCurrency, toCurrency string) ([]ConversionHistoryItem, *types.PaginationResponse, error) {
 	if userID == 0 {
-		return nil, nil, errors.New("user ID is required")
+		return nil, nil, ErrUserIDRequired
 	}
 
 	// Build query
@@ -315,42 +330,28 @@ func getCurrentExchangeRate(fromCurrency, toCurrency string) (float64, error) {
 		return 0, fmt.Errorf("failed to query exchange rate: %w", err)
 	}
 
-	// Return default rates if not in database
-	if fromCurrency == "NGN" && toCurrency == "GHS" {
-		return 0.0053, nil
-	} else if fromCurrency == "GHS" && toCurrency == "NGN" {
-		return 188.68, nil
+	// Fall back to the corridor's configured rate until one is published
+	var corridor models.Corridor
+	if err := database.DB.Where("from_currency = ? AND to_currency = ?", fromCurrency, toCurrency).
+		First(&corridor).Error; err == nil && corridor.DefaultRate > 0 {
+		return corridor.DefaultRate, nil
 	}
 
 	return 0, errors.New("exchange rate not available for the specified currency pair")
 }
 
-// getConversionDirection gets transaction direction for conversion
-func getConversionDirection(fromCurrency, toCurrency string) models.TransactionDirection {
-	if fromCurrency == "NGN" && toCurrency == "GHS" {
-		return "NGN-GHS"
-	} else if fromCurrency == "GHS" && toCurrency == "NGN" {
-		return "GHS-NGN"
-	}
-	return ""
-}
-
 // updateWalletBalanceInTx updates wallet balance within a transaction
 func updateWalletBalanceInTx(tx *gorm.DB, userID uint, currency string, amount float64, txType string) error {
 	var wallet models.Wallet
 
 	// Find wallet with lock
-	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("user_id = ?", userID).First(&wallet).Error; err != nil {
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("user_id = ? AND currency = ?", userID, currency).First(&wallet).Error; err != nil {
 		return fmt.Errorf("failed to find wallet: %w", err)
 	}
 
-	// Update balance based on currency
+	// Update balance
 	roundedAmount := utils.RoundCurrency(amount)
-	if currency == "NGN" && wallet.Currency == "NGN" {
-		wallet.Balance += roundedAmount
-	} else if currency == "GHS" {
-		wallet.Balance += roundedAmount
-	}
+	wallet.Balance += roundedAmount
 
 	// Update totals for conversion
 	if txType == "conversion" {
@@ -386,20 +387,12 @@ func isValidConversionStatus(status string) bool {
 // validateConversionRequest validates conversion request
 func validateConversionRequest(req types.ConversionRequest) error {
 	if req.Amount <= 0 {
-		return errors.New("amount must be greater than zero")
-	}
-
-	if req.FromCurrency != "NGN" && req.FromCurrency != "GHS" {
-		return errors.New("invalid from currency. Must be NGN or GHS")
-	}
-
-	if req.ToCurrency != "NGN" && req.ToCurrency != "GHS" {
-		return errors.New("invalid to currency. Must be NGN or GHS")
+		return ErrAmountNotPositive
 	}
 
 	if req.FromCurrency == req.ToCurrency {
 		return errors.New("cannot convert to the same currency")
 	}
 
-	return nil
+	return validateCorridor(req.FromCurrency, req.ToCurrency, req.Amount)
 }
@@ -0,0 +1,470 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"gorm.io/gorm"
+)
+
+var (
+	currencyRegistryMu       sync.Mutex
+	currencyRegistryLoadedAt time.Time
+)
+
+// LoadCurrencyRegistry reads the currency registry into memory so validation and formatting
+// can use it without a query
+func LoadCurrencyRegistry() error {
+	var currencies []models.Currency
+	if err := database.DB.Order("sort_order ASC, code ASC").Find(&currencies).Error; err != nil {
+		return fmt.Errorf("failed to load currencies: %w", err)
+	}
+
+	registry := make([]utils.CurrencyInfo, 0, len(currencies))
+	for _, currency := range currencies {
+		registry = append(registry, utils.CurrencyInfo{
+			Code:       currency.Code,
+			Symbol:     currency.Symbol,
+			MinorUnits: currency.MinorUnits,
+			SortOrder:  currency.SortOrder,
+			Enabled:    currency.IsEnabled,
+		})
+	}
+	utils.SetCurrencies(registry)
+
+	currencyRegistryMu.Lock()
+	currencyRegistryLoadedAt = time.Now()
+	currencyRegistryMu.Unlock()
+	return nil
+}
+
+// IsSupportedCurrency reports whether users can currently transact in a currency
+func IsSupportedCurrency(currency string) bool {
+	refreshCurrencyRegistry()
+	return utils.IsEnabledCurrency(currency)
+}
+
+// GetCurrencies lists the enabled currencies and the corridors open between them
+func GetCurrencies() (*types.CurrenciesResponse, error) {
+	var currencies []models.Currency
+	if err := database.DB.Where("is_enabled = ?", true).Order("sort_order ASC, code ASC").Find(&currencies).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch currencies: %w", err)
+	}
+
+	response := &types.CurrenciesResponse{
+		Currencies: make([]types.CurrencyResponse, 0, len(currencies)),
+		Corridors:  []types.CorridorResponse{},
+	}
+	enabled := make(map[string]bool, len(currencies))
+	for _, currency := range currencies {
+		enabled[currency.Code] = true
+		response.Currencies = append(response.Currencies, types.CurrencyResponse{
+			Code:       currency.Code,
+			Name:       currency.Name,
+			Symbol:     currency.Symbol,
+			MinorUnits: currency.MinorUnits,
+			Country:    currency.Country,
+		})
+	}
+
+	var corridors []models.Corridor
+	if err := database.DB.Where("is_enabled = ?", true).Order("from_currency ASC, to_currency ASC").Find(&corridors).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch corridors: %w", err)
+	}
+	for _, corridor := range corridors {
+		if !enabled[corridor.FromCurrency] || !enabled[corridor.ToCurrency] {
+			continue
+		}
+		rate, err := getCurrentExchangeRate(corridor.FromCurrency, corridor.ToCurrency)
+		if err != nil {
+			rate = 0
+		}
+		response.Corridors = append(response.Corridors, types.CorridorResponse{
+			FromCurrency: corridor.FromCurrency,
+			ToCurrency:   corridor.ToCurrency,
+			Rate:         rate,
+			MinAmount:    corridor.MinAmount,
+			MaxAmount:    corridor.MaxAmount,
+		})
+	}
+
+	return response, nil
+}
+
+// AdminGetCurrencies returns every currency and corridor, including disabled ones
+func AdminGetCurrencies() (map[string]any, error) {
+	var currencies []models.Currency
+	if err := database.DB.Order("sort_order ASC, code ASC").Find(&currencies).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch currencies: %w", err)
+	}
+	var corridors []models.Corridor
+	if err := database.DB.Order("from_currency ASC, to_currency ASC").Find(&corridors).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch corridors: %w", err)
+	}
+	return map[string]any{
+		"currencies": currencies,
+		"corridors":  corridors,
+	}, nil
+}
+
+// AdminCreateCurrency adds a currency to the registry. Users get a wallet for it the next time
+// their wallets are loaded.
+func AdminCreateCurrency(req types.CreateCurrencyRequest, actor types.AdminActor) (models.Currency, error) {
+	code := strings.ToUpper(req.Code)
+
+	var existing int64
+	if err := database.DB.Model(&models.Currency{}).Where("code = ?", code).Count(&existing).Error; err != nil {
+		return models.Currency{}, fmt.Errorf("failed to check currency: %w", err)
+	}
+	if existing > 0 {
+		return models.Currency{}, i18n.NewError("error.currency_exists", i18n.Args{"currency": code})
+	}
+
+	currency := models.Currency{
+		Code:            code,
+		Name:            req.Name,
+		Symbol:          req.Symbol,
+		MinorUnits:      2,
+		Country:         strings.ToLower(req.Country),
+		SortOrder:       req.SortOrder,
+		IsEnabled:       true,
+		StepUpThreshold: req.StepUpThreshold,
+	}
+	if req.MinorUnits != nil {
+		currency.MinorUnits = *req.MinorUnits
+	}
+	if req.IsEnabled != nil {
+		currency.IsEnabled = *req.IsEnabled
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(&currency).Error; err != nil {
+		tx.Rollback()
+		return models.Currency{}, fmt.Errorf("failed to create currency: %w", err)
+	}
+	// A false IsEnabled is a zero value, so Create would have used the column default
+	if !currency.IsEnabled {
+		if err := tx.Model(&currency).Update("is_enabled", false).Error; err != nil {
+			tx.Rollback()
+			return models.Currency{}, fmt.Errorf("failed to create currency: %w", err)
+		}
+	}
+
+	adminLog := actor.NewLog("CREATE_CURRENCY", "currency", currency.Code, fmt.Sprintf("Currency %s (%s) added", currency.Code, currency.Name))
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return models.Currency{}, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return models.Currency{}, fmt.Errorf("failed to create currency: %w", err)
+	}
+
+	reloadCurrencyRegistry()
+	return currency, nil
+}
+
+// AdminUpdateCurrency edits a registry currency. Disabling a currency stops new activity in it;
+// existing wallets and balances are kept.
+func AdminUpdateCurrency(code string, req types.UpdateCurrencyRequest, actor types.AdminActor) (models.Currency, error) {
+	var currency models.Currency
+	if err := database.DB.Where("code = ?", strings.ToUpper(code)).First(&currency).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return currency, i18n.NewError("error.currency_not_found", nil)
+		}
+		return currency, fmt.Errorf("failed to find currency: %w", err)
+	}
+	before := currency
+
+	updates := make(map[string]any)
+	if req.Name != "" && req.Name != currency.Name {
+		updates["name"] = req.Name
+	}
+	if req.Symbol != "" && req.Symbol != currency.Symbol {
+		updates["symbol"] = req.Symbol
+	}
+	if req.MinorUnits != nil && *req.MinorUnits != currency.MinorUnits {
+		updates["minor_units"] = *req.MinorUnits
+	}
+	if req.Country != nil && strings.ToLower(*req.Country) != currency.Country {
+		updates["country"] = strings.ToLower(*req.Country)
+	}
+	if req.SortOrder != nil && *req.SortOrder != currency.SortOrder {
+		updates["sort_order"] = *req.SortOrder
+	}
+	if req.IsEnabled != nil && *req.IsEnabled != currency.IsEnabled {
+		updates["is_enabled"] = *req.IsEnabled
+	}
+	if req.StepUpThreshold != nil && *req.StepUpThreshold != currency.StepUpThreshold {
+		updates["step_up_threshold"] = *req.StepUpThreshold
+	}
+	if len(updates) == 0 {
+		return currency, nil
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&currency).Updates(updates).Error; err != nil {
+		tx.Rollback()
+		return before, fmt.Errorf("failed to update currency: %w", err)
+	}
+
+	adminLog := actor.NewChangeLog("UPDATE_CURRENCY", "currency", currency.Code, fmt.Sprintf("Currency %s updated", currency.Code), before, currency)
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return before, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return before, fmt.Errorf("failed to update currency: %w", err)
+	}
+
+	reloadCurrencyRegistry()
+	return currency, nil
+}
+
+// AdminCreateCorridor opens a currency pair for conversions and cross-currency transfers
+func AdminCreateCorridor(req types.CreateCorridorRequest, actor types.AdminActor) (models.Corridor, error) {
+	from, to := strings.ToUpper(req.FromCurrency), strings.ToUpper(req.ToCurrency)
+	if from == to {
+		return models.Corridor{}, ErrSameCurrency
+	}
+	if req.MaxAmount > 0 && req.MaxAmount < req.MinAmount {
+		return models.Corridor{}, i18n.NewError("error.corridor_amount_range", nil)
+	}
+
+	var known int64
+	if err := database.DB.Model(&models.Currency{}).Where("code IN ?", []string{from, to}).Count(&known).Error; err != nil {
+		return models.Corridor{}, fmt.Errorf("failed to check currencies: %w", err)
+	}
+	if known != 2 {
+		return models.Corridor{}, i18n.NewError("error.corridor_currencies_missing", nil)
+	}
+
+	var existing int64
+	if err := database.DB.Model(&models.Corridor{}).Where("from_currency = ? AND to_currency = ?", from, to).Count(&existing).Error; err != nil {
+		return models.Corridor{}, fmt.Errorf("failed to check corridor: %w", err)
+	}
+	if existing > 0 {
+		return models.Corridor{}, i18n.NewError("error.corridor_exists", i18n.Args{"from": from, "to": to})
+	}
+
+	corridor := models.Corridor{
+		FromCurrency: from,
+		ToCurrency:   to,
+		DefaultRate:  req.DefaultRate,
+		MinAmount:    req.MinAmount,
+		MaxAmount:    req.MaxAmount,
+		IsEnabled:    true,
+	}
+	if req.IsEnabled != nil {
+		corridor.IsEnabled = *req.IsEnabled
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(&corridor).Error; err != nil {
+		tx.Rollback()
+		return models.Corridor{}, fmt.Errorf("failed to create corridor: %w", err)
+	}
+	if !corridor.IsEnabled {
+		if err := tx.Model(&corridor).Update("is_enabled", false).Error; err != nil {
+			tx.Rollback()
+			return models.Corridor{}, fmt.Errorf("failed to create corridor: %w", err)
+		}
+	}
+
+	adminLog := actor.NewLog("CREATE_CORRIDOR", "corridor", fmt.Sprintf("%d", corridor.ID), fmt.Sprintf("Corridor %s-%s added", from, to))
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return models.Corridor{}, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return models.Corridor{}, fmt.Errorf("failed to create corridor: %w", err)
+	}
+	return corridor, nil
+}
+
+// AdminUpdateCorridor changes a corridor's limits, fallback rate or availability
+func AdminUpdateCorridor(corridorID uint, req types.UpdateCorridorRequest, actor types.AdminActor) (models.Corridor, error) {
+	var corridor models.Corridor
+	if err := database.DB.First(&corridor, corridorID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return corridor, i18n.NewError("error.corridor_not_found", nil)
+		}
+		return corridor, fmt.Errorf("failed to find corridor: %w", err)
+	}
+	before := corridor
+
+	updates := make(map[string]any)
+	if req.DefaultRate != nil && *req.DefaultRate != corridor.DefaultRate {
+		updates["default_rate"] = *req.DefaultRate
+	}
+	if req.MinAmount != nil && *req.MinAmount != corridor.MinAmount {
+		updates["min_amount"] = *req.MinAmount
+	}
+	if req.MaxAmount != nil && *req.MaxAmount != corridor.MaxAmount {
+		updates["max_amount"] = *req.MaxAmount
+	}
+	if req.IsEnabled != nil && *req.IsEnabled != corridor.IsEnabled {
+		updates["is_enabled"] = *req.IsEnabled
+	}
+	if len(updates) == 0 {
+		return corridor, nil
+	}
+
+	minAmount, maxAmount := corridor.MinAmount, corridor.MaxAmount
+	if req.MinAmount != nil {
+		minAmount = *req.MinAmount
+	}
+	if req.MaxAmount != nil {
+		maxAmount = *req.MaxAmount
+	}
+	if maxAmount > 0 && maxAmount < minAmount {
+		return corridor, i18n.NewError("error.corridor_amount_range", nil)
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&corridor).Updates(updates).Error; err != nil {
+		tx.Rollback()
+		return before, fmt.Errorf("failed to update corridor: %w", err)
+	}
+
+	adminLog := actor.NewChangeLog("UPDATE_CORRIDOR", "corridor", fmt.Sprintf("%d", corridor.ID),
+		fmt.Sprintf("Corridor %s-%s updated", corridor.FromCurrency, corridor.ToCurrency), before, corridor)
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return before, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return before, fmt.Errorf("failed to update corridor: %w", err)
+	}
+	return corridor, nil
+}
+
+// Helper functions
+
+// refreshCurrencyRegistry reloads the registry once it is older than CurrencyRegistryTTL, so
+// changes made through another instance are picked up
+func refreshCurrencyRegistry() {
+	currencyRegistryMu.Lock()
+	stale := time.Since(currencyRegistryLoadedAt) > constants.CurrencyRegistryTTL
+	if stale {
+		// Claim the reload so concurrent callers keep using the current snapshot
+		currencyRegistryLoadedAt = time.Now()
+	}
+	currencyRegistryMu.Unlock()
+
+	if stale {
+		reloadCurrencyRegistry()
+	}
+}
+
+func reloadCurrencyRegistry() {
+	if err := LoadCurrencyRegistry(); err != nil {
+		log.Printf("Failed to reload currency registry: %v", err)
+	}
+}
+
+// isValidCurrency checks if currency is enabled in the registry
+func isValidCurrency(currency string) bool {
+	return IsSupportedCurrency(currency)
+}
+
+// enabledCurrencies lists the enabled currency codes in display order
+func enabledCurrencies() []string {
+	refreshCurrencyRegistry()
+	return utils.EnabledCurrencyCodes()
+}
+
+// invalidCurrencyError reports an unknown currency along with the ones that can be used
+func invalidCurrencyError(id string) error {
+	return i18n.NewError(id, i18n.Args{"currencies": strings.Join(enabledCurrencies(), ", ")})
+}
+
+// validateCorridor checks that both currencies are enabled and that the pair is an open
+// corridor. A positive amount is also checked against the corridor's limits.
+func validateCorridor(fromCurrency, toCurrency string, amount float64) error {
+	if !isValidCurrency(fromCurrency) {
+		return invalidCurrencyError("error.invalid_from_currency")
+	}
+	if !isValidCurrency(toCurrency) {
+		return invalidCurrencyError("error.invalid_to_currency")
+	}
+	if fromCurrency == toCurrency {
+		return nil
+	}
+
+	corridor, err := findCorridor(fromCurrency, toCurrency)
+	if err != nil {
+		return err
+	}
+	if amount > 0 && corridor.MinAmount > 0 && amount < corridor.MinAmount {
+		return i18n.NewError("error.corridor_minimum", i18n.Args{"from": fromCurrency, "to": toCurrency, "amount": i18n.Amount(corridor.MinAmount, fromCurrency)})
+	}
+	if amount > 0 && corridor.MaxAmount > 0 && amount > corridor.MaxAmount {
+		return i18n.NewError("error.corridor_maximum", i18n.Args{"from": fromCurrency, "to": toCurrency, "amount": i18n.Amount(corridor.MaxAmount, fromCurrency)})
+	}
+	return nil
+}
+
+// findCorridor returns the enabled corridor for a pair
+func findCorridor(fromCurrency, toCurrency string) (models.Corridor, error) {
+	var corridor models.Corridor
+	if err := database.DB.Where("from_currency = ? AND to_currency = ? AND is_enabled = ?", fromCurrency, toCurrency, true).
+		First(&corridor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return corridor, i18n.NewError("error.corridor_unsupported", i18n.Args{"from": fromCurrency, "to": toCurrency})
+		}
+		return corridor, fmt.Errorf("failed to find corridor: %w", err)
+	}
+	return corridor, nil
+}
+
+// defaultCurrencyForCountry picks the currency registered for a user's country, falling back to
+// the first enabled currency
+func defaultCurrencyForCountry(country string) string {
+	var currency models.Currency
+	if country != "" && database.DB.Where("LOWER(country) = ? AND is_enabled = ?", strings.ToLower(country), true).
+		Order("sort_order ASC").First(&currency).Error == nil {
+		return currency.Code
+	}
+	if codes := enabledCurrencies(); len(codes) > 0 {
+		return codes[0]
+	}
+	return constants.CurrencyNGN
+}
@@ -1,7 +1,6 @@
 package services
 
 import (
-	"errors"
 	"fmt"
 	"time"
 
@@ -14,7 +13,7 @@ import (
 // GetDashboardOverview retrieves dashboard overview for a user
 func GetDashboardOverview(userID uint) (*types.DashboardOverview, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Get wallet information
@@ -106,7 +105,7 @@ func GetDashboardOverview(userID uint) (*types.DashboardOverview, error) {
 // GetDashboardStats retrieves detailed dashboard statistics for a user
 func GetDashboardStats(userID uint) (*types.DashboardStats, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	currentDate := time.Now()
@@ -257,7 +256,7 @@ func getMonthlyVolumeData(userID uint, months int) []types.MonthlyVolData {
 // GetUserTransactionSummary gets transaction summary for a user
 func GetUserTransactionSummary(userID uint32) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var totalDeposits, totalWithdrawals, totalConversions float64
@@ -317,7 +316,7 @@ func GetUserTransactionSummary(userID uint32) (map[string]interface{}, error) {
 // GetDashboardSummary gets dashboard summary for a user
 func GetDashboardSummary(userID uint) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Get wallet balances
@@ -373,7 +372,7 @@ func GetDashboardSummary(userID uint) (map[string]interface{}, error) {
 // GetRecentActivity gets recent activity for a user
 func GetRecentActivity(userID uint) ([]map[string]any, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Get recent transactions
@@ -403,7 +402,7 @@ func GetRecentActivity(userID uint) ([]map[string]any, error) {
 // GetWalletOverview gets wallet overview for a user
 func GetWalletOverview(userID uint) (map[string]any, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	wallets, err := GetWalletBalance(userID)
@@ -491,7 +490,7 @@ func GetWalletOverview(userID uint) (map[string]any, error) {
 // GetConversionStats gets conversion statistics for a user
 func GetConversionStats(userID uint) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	currentDate := time.Now()
@@ -545,7 +544,7 @@ func GetConversionStats(userID uint) (map[string]interface{}, error) {
 // GetMonthlyStats gets monthly statistics for a user
 func GetMonthlyStats(userID uint) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	currentDate := time.Now()
@@ -608,7 +607,7 @@ func GetMonthlyStats(userID uint) (map[string]interface{}, error) {
 // GetTransactionTrends gets transaction trends for a user
 func GetTransactionTrends(userID uint) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Get last 12 months of data
@@ -652,7 +651,7 @@ func GetTransactionTrends(userID uint) (map[string]interface{}, error) {
 // GetDashboardTransactionStats retrieves transaction statistics for a specific period
 func GetDashboardTransactionStats(userID uint, period string) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var startDate time.Time
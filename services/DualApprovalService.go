@@ -0,0 +1,596 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+// ErrCheckerPermissionDenied is returned when the checker's role does not allow the action
+// they are approving
+var ErrCheckerPermissionDenied = i18n.NewError("error.checker_permission_denied", nil)
+
+// changeActionPermissions is the permission each action needs when performed directly. A checker
+// must hold it too, or approving would let them do what their role does not allow.
+var changeActionPermissions = map[models.ChangeActionType]string{
+	models.ChangeApproveTransaction: constants.PermTransactionsApprove,
+	models.ChangeAddRate:            constants.PermRatesManage,
+	models.ChangeUpdateRate:         constants.PermRatesManage,
+	models.ChangeDeleteRate:         constants.PermRatesManage,
+	models.ChangePlatformSettings:   constants.PermSettingsManage,
+}
+
+// PendingApprovalError is returned when an admin action was turned into a change request
+// and will only take effect once a different admin approves it
+type PendingApprovalError struct {
+	Request types.AdminChangeRequestResponse
+}
+
+func (e *PendingApprovalError) Error() string {
+	return "change submitted for approval by another admin"
+}
+
+// GetChangeRequests lists change requests, newest first
+func GetChangeRequests(params types.ChangeRequestQuery) (types.AdminChangeRequestsResponse, error) {
+	var response types.AdminChangeRequestsResponse
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.Limit <= 0 {
+		params.Limit = 20
+	}
+	offset := (params.Page - 1) * params.Limit
+
+	query := database.DB.Model(&models.AdminChangeRequest{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.ActionType != "" {
+		query = query.Where("action_type = ?", params.ActionType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return response, err
+	}
+
+	var requests []models.AdminChangeRequest
+	if err := query.Order("created_at DESC").Offset(offset).Limit(params.Limit).Find(&requests).Error; err != nil {
+		return response, err
+	}
+
+	response.Requests = make([]types.AdminChangeRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		response.Requests = append(response.Requests, toChangeRequestResponse(request))
+	}
+	response.Pagination = types.PaginationInfo{
+		Page:  params.Page,
+		Limit: params.Limit,
+		Total: total,
+		Pages: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
+	}
+
+	return response, nil
+}
+
+// GetChangeRequest returns a single change request
+func GetChangeRequest(requestID uint) (types.AdminChangeRequestResponse, error) {
+	request, err := findChangeRequest(requestID)
+	if err != nil {
+		return types.AdminChangeRequestResponse{}, err
+	}
+	return toChangeRequestResponse(*request), nil
+}
+
+// ApproveChangeRequest lets a second admin approve a pending change, which is then executed
+func ApproveChangeRequest(requestID uint, checker types.AdminActor, note string) (types.AdminChangeRequestResponse, error) {
+	if err := requireCheckerPermission(requestID, checker); err != nil {
+		return types.AdminChangeRequestResponse{}, err
+	}
+
+	request, err := claimChangeRequest(requestID, checker.ID, models.ChangeRequestApproved, note)
+	if err != nil {
+		return types.AdminChangeRequestResponse{}, err
+	}
+
+	if execErr := executeChangeRequest(request, checker); execErr != nil {
+		request.Status = models.ChangeRequestFailed
+		request.ExecutionError = execErr.Error()
+		database.DB.Model(request).Updates(map[string]any{
+			"status":          models.ChangeRequestFailed,
+			"execution_error": execErr.Error(),
+		})
+	}
+
+	logChangeReview(request, checker, "APPROVE_CHANGE_REQUEST")
+
+	if request.Status == models.ChangeRequestFailed {
+		return toChangeRequestResponse(*request), fmt.Errorf("change approved but failed to execute: %s", request.ExecutionError)
+	}
+	return toChangeRequestResponse(*request), nil
+}
+
+// RejectChangeRequest lets a second admin reject a pending change
+func RejectChangeRequest(requestID uint, checker types.AdminActor, note string) (types.AdminChangeRequestResponse, error) {
+	request, err := claimChangeRequest(requestID, checker.ID, models.ChangeRequestRejected, note)
+	if err != nil {
+		return types.AdminChangeRequestResponse{}, err
+	}
+
+	logChangeReview(request, checker, "REJECT_CHANGE_REQUEST")
+	return toChangeRequestResponse(*request), nil
+}
+
+// GetDualApprovalPolicies lists the configured maker-checker policies
+func GetDualApprovalPolicies() ([]models.DualApprovalPolicy, error) {
+	var policies []models.DualApprovalPolicy
+	if err := database.DB.Order("id ASC").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch dual approval policies: %w", err)
+	}
+	return policies, nil
+}
+
+// UpdateDualApprovalPolicy enables, disables or changes the threshold of a policy
+func UpdateDualApprovalPolicy(actionType string, req types.UpdateDualApprovalPolicyRequest, actor types.AdminActor) (models.DualApprovalPolicy, error) {
+	var policy models.DualApprovalPolicy
+	if err := database.DB.Where("action_type = ?", actionType).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return policy, i18n.NewError("error.policy_not_found", nil)
+		}
+		return policy, fmt.Errorf("failed to find policy: %w", err)
+	}
+
+	diff := make(map[string]types.FieldChange)
+	updates := make(map[string]any)
+	if req.Enabled != nil && *req.Enabled != policy.Enabled {
+		diff["enabled"] = types.FieldChange{From: policy.Enabled, To: *req.Enabled}
+		updates["enabled"] = *req.Enabled
+	}
+	if req.Threshold != nil && *req.Threshold != policy.Threshold {
+		if *req.Threshold < 0 {
+			return policy, i18n.NewError("error.threshold_negative", nil)
+		}
+		diff["threshold"] = types.FieldChange{From: policy.Threshold, To: *req.Threshold}
+		updates["threshold"] = *req.Threshold
+	}
+	if len(updates) == 0 {
+		return policy, nil
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&policy).Updates(updates).Error; err != nil {
+		tx.Rollback()
+		return policy, fmt.Errorf("failed to update policy: %w", err)
+	}
+
+	diffJSON, _ := json.Marshal(diff)
+	adminLog := actor.NewLog("UPDATE_DUAL_APPROVAL_POLICY", "dual_approval_policy", actionType, fmt.Sprintf("Dual approval policy %s updated: %s", actionType, diffJSON))
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return policy, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return policy, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return policy, nil
+}
+
+// Helper functions
+
+// requireDualApproval creates a change request and returns a *PendingApprovalError when the
+// action's policy demands a second admin. A nil error means the action may run immediately.
+func requireDualApproval(actionType models.ChangeActionType, targetID string, payload any, maker types.AdminActor) error {
+	var policy models.DualApprovalPolicy
+	if err := database.DB.Where("action_type = ?", actionType).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to load dual approval policy: %w", err)
+	}
+	if !policy.Enabled {
+		return nil
+	}
+
+	plan, err := planChange(actionType, targetID, payload)
+	if err != nil {
+		return err
+	}
+	if len(plan.diff) == 0 || (!plan.always && plan.metric < policy.Threshold) {
+		return nil
+	}
+
+	var pending int64
+	if err := database.DB.Model(&models.AdminChangeRequest{}).
+		Where("action_type = ? AND target_id = ? AND status = ?", actionType, targetID, models.ChangeRequestPending).
+		Count(&pending).Error; err != nil {
+		return fmt.Errorf("failed to check pending change requests: %w", err)
+	}
+	if pending > 0 {
+		return i18n.NewError("error.change_request_pending", nil)
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode change request: %w", err)
+	}
+	diffJSON, err := json.Marshal(plan.diff)
+	if err != nil {
+		return fmt.Errorf("failed to encode change request: %w", err)
+	}
+
+	request := models.AdminChangeRequest{
+		ActionType: actionType,
+		TargetID:   targetID,
+		Payload:    string(payloadJSON),
+		Diff:       string(diffJSON),
+		Metric:     plan.metric,
+		Status:     models.ChangeRequestPending,
+		MakerID:    maker.ID,
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(&request).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create change request: %w", err)
+	}
+
+	adminLog := maker.NewLog("REQUEST_CHANGE_APPROVAL", "change_request", fmt.Sprintf("%d", request.ID), fmt.Sprintf("Maker %d requested %s on %q: %s", maker.ID, actionType, targetID, diffJSON))
+	if err := tx.Create(&adminLog).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &PendingApprovalError{Request: toChangeRequestResponse(request)}
+}
+
+// changePlan is what an action would change, measured against its policy threshold
+type changePlan struct {
+	metric float64
+	diff   map[string]types.FieldChange
+	always bool // needs approval whatever the threshold, e.g. changing which rate is live
+}
+
+// planChange computes the threshold metric and the field diff of an action without applying it
+func planChange(actionType models.ChangeActionType, targetID string, payload any) (changePlan, error) {
+	plan := changePlan{diff: make(map[string]types.FieldChange)}
+
+	switch actionType {
+	case models.ChangeApproveTransaction:
+		var transaction models.Transaction
+		if err := database.DB.Preload("TransactionDetails").
+			Where("transaction_id = ? AND status = ?", targetID, models.TransactionPending).
+			First(&transaction).Error; err != nil {
+			return plan, i18n.NewError("error.transaction_not_pending", nil)
+		}
+		plan.diff["status"] = types.FieldChange{From: transaction.Status, To: models.TransactionCompleted}
+		amount, err := baseCurrencyAmount(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency)
+		if err != nil {
+			log.Printf("Cannot measure transaction %s against the approval threshold, requiring approval: %v", targetID, err)
+			plan.always = true
+		}
+		plan.metric = amount
+		return plan, nil
+
+	case models.ChangeAddRate:
+		req := payload.(types.CreateRateRequest)
+		live, err := liveExchangeRate(req.FromCurrency, req.ToCurrency)
+		if err != nil {
+			return plan, err
+		}
+		var from any
+		plan.metric = 100
+		if live != nil {
+			from = live.Rate
+			plan.metric = percentChange(live.Rate, req.Rate)
+		}
+		plan.diff["pair"] = types.FieldChange{To: fmt.Sprintf("%s/%s", req.FromCurrency, req.ToCurrency)}
+		plan.diff["rate"] = types.FieldChange{From: from, To: req.Rate}
+		// A new rate is published straight away
+		plan.always = true
+		return plan, nil
+
+	case models.ChangeUpdateRate:
+		req := payload.(types.UpdateRateRequest)
+		var rate models.Rate
+		if err := database.DB.Where("id = ?", targetID).First(&rate).Error; err != nil {
+			return plan, ErrRateNotFound
+		}
+		live, err := liveExchangeRate(rate.FromCurrency, rate.ToCurrency)
+		if err != nil {
+			return plan, err
+		}
+		isLive := live != nil && live.RateID != nil && *live.RateID == rate.ID
+
+		newRate, newActive := rate.Rate, rate.Active
+		if req.Rate != 0 && req.Rate != rate.Rate {
+			plan.diff["rate"] = types.FieldChange{From: rate.Rate, To: req.Rate}
+			newRate = req.Rate
+		}
+		if req.Source != "" && req.Source != rate.Source {
+			plan.diff["source"] = types.FieldChange{From: rate.Source, To: req.Source}
+		}
+		if req.Active != nil && *req.Active != rate.Active {
+			plan.diff["active"] = types.FieldChange{From: rate.Active, To: *req.Active}
+			newActive = *req.Active
+		}
+
+		// Measure against what customers are quoted now, not the stored row
+		publishes := newActive && (!rate.Active || newRate != rate.Rate)
+		if publishes {
+			plan.metric = 100
+			if live != nil {
+				plan.metric = percentChange(live.Rate, newRate)
+			}
+		}
+		// Making a rate live or retiring the live one always needs a second admin
+		plan.always = (publishes && !isLive) || (isLive && !newActive)
+		return plan, nil
+
+	case models.ChangeDeleteRate:
+		var rate models.Rate
+		if err := database.DB.Where("id = ?", targetID).First(&rate).Error; err != nil {
+			return plan, ErrRateNotFound
+		}
+		live, err := liveExchangeRate(rate.FromCurrency, rate.ToCurrency)
+		if err != nil {
+			return plan, err
+		}
+		plan.diff["pair"] = types.FieldChange{From: fmt.Sprintf("%s/%s", rate.FromCurrency, rate.ToCurrency)}
+		plan.diff["rate"] = types.FieldChange{From: rate.Rate}
+		plan.always = live != nil && live.RateID != nil && *live.RateID == rate.ID
+		return plan, nil
+
+	case models.ChangePlatformSettings:
+		req := payload.(PlatformSettingsRequest)
+		updates, err := buildPlatformSettingsUpdates(req)
+		if err != nil {
+			return plan, err
+		}
+		current := make(map[string]any)
+		database.DB.Model(&models.PlatformSetting{}).Order("id ASC").Limit(1).Find(&current)
+		for column, value := range updates {
+			if fmt.Sprint(current[column]) != fmt.Sprint(value) {
+				plan.diff[column] = types.FieldChange{From: current[column], To: value}
+			}
+		}
+		plan.metric = float64(len(plan.diff))
+		return plan, nil
+	}
+
+	return plan, fmt.Errorf("unsupported action type: %s", actionType)
+}
+
+// baseCurrencyAmount converts an amount to the platform's default currency, so one threshold
+// applies to every currency
+func baseCurrencyAmount(amount float64, currency string) (float64, error) {
+	settings, err := GetPlatformSettings()
+	if err != nil {
+		return amount, err
+	}
+	base := strings.ToUpper(settings.DefaultCurrency)
+	currency = strings.ToUpper(currency)
+	if currency == "" || base == "" || currency == base {
+		return amount, nil
+	}
+
+	rate, err := getCurrentExchangeRate(base, currency)
+	if err != nil {
+		return amount, err
+	}
+	if rate <= 0 {
+		return amount, fmt.Errorf("invalid %s-%s rate: %v", base, currency, rate)
+	}
+	return amount / rate, nil
+}
+
+// liveExchangeRate returns the rate conversions of a pair are priced at, or nil if none is active
+func liveExchangeRate(fromCurrency, toCurrency string) (*models.ExchangeRate, error) {
+	var live models.ExchangeRate
+	if err := database.DB.Where("from_currency = ? AND to_currency = ? AND is_active = ?", fromCurrency, toCurrency, true).
+		First(&live).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch live exchange rate: %w", err)
+	}
+	return &live, nil
+}
+
+// requireCheckerPermission checks that the checker may perform the action behind a request
+func requireCheckerPermission(requestID uint, checker types.AdminActor) error {
+	request, err := findChangeRequest(requestID)
+	if err != nil {
+		return err
+	}
+	permission, ok := changeActionPermissions[request.ActionType]
+	if !ok {
+		return fmt.Errorf("unsupported action type: %s", request.ActionType)
+	}
+
+	allowed, err := AdminHasPermission(checker.ID, permission)
+	if err != nil {
+		return fmt.Errorf("failed to check checker permissions: %w", err)
+	}
+	if !allowed {
+		LogPermissionDenied(checker, permission, "APPROVE", fmt.Sprintf("change_request/%d", request.ID))
+		return ErrCheckerPermissionDenied
+	}
+	return nil
+}
+
+// claimChangeRequest moves a pending request to its reviewed status exactly once
+func claimChangeRequest(requestID uint, checkerID uint, status models.ChangeRequestStatus, note string) (*models.AdminChangeRequest, error) {
+	request, err := findChangeRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != models.ChangeRequestPending {
+		return nil, i18n.NewError("error.change_request_status", i18n.Args{"status": request.Status})
+	}
+	if request.MakerID == checkerID {
+		return nil, i18n.NewError("error.change_request_self_review", nil)
+	}
+
+	now := time.Now()
+	result := database.DB.Model(&models.AdminChangeRequest{}).
+		Where("id = ? AND status = ?", requestID, models.ChangeRequestPending).
+		Updates(map[string]any{
+			"status":      status,
+			"checker_id":  checkerID,
+			"review_note": note,
+			"reviewed_at": now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update change request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, i18n.NewError("error.change_request_reviewed", nil)
+	}
+
+	request.Status = status
+	request.CheckerID = &checkerID
+	request.ReviewNote = note
+	request.ReviewedAt = &now
+	return request, nil
+}
+
+// executeChangeRequest applies an approved change on behalf of the checker
+func executeChangeRequest(request *models.AdminChangeRequest, checker types.AdminActor) error {
+	switch request.ActionType {
+	case models.ChangeApproveTransaction:
+		_, err := approveAdminTransaction(request.TargetID, checker)
+		return err
+
+	case models.ChangeAddRate:
+		var req types.CreateRateRequest
+		if err := json.Unmarshal([]byte(request.Payload), &req); err != nil {
+			return fmt.Errorf("invalid change request payload: %w", err)
+		}
+		_, err := addAdminRate(req, checker)
+		return err
+
+	case models.ChangeUpdateRate:
+		var req types.UpdateRateRequest
+		if err := json.Unmarshal([]byte(request.Payload), &req); err != nil {
+			return fmt.Errorf("invalid change request payload: %w", err)
+		}
+		var rateID uint
+		if _, err := fmt.Sscan(request.TargetID, &rateID); err != nil {
+			return fmt.Errorf("invalid rate ID: %w", err)
+		}
+		_, err := updateAdminRate(rateID, req, checker)
+		return err
+
+	case models.ChangeDeleteRate:
+		var rateID uint
+		if _, err := fmt.Sscan(request.TargetID, &rateID); err != nil {
+			return fmt.Errorf("invalid rate ID: %w", err)
+		}
+		_, err := deleteRate(rateID, checker)
+		return err
+
+	case models.ChangePlatformSettings:
+		var req PlatformSettingsRequest
+		if err := json.Unmarshal([]byte(request.Payload), &req); err != nil {
+			return fmt.Errorf("invalid change request payload: %w", err)
+		}
+		_, err := updatePlatformSettings(req, checker)
+		return err
+	}
+
+	return fmt.Errorf("unsupported action type: %s", request.ActionType)
+}
+
+// logChangeReview records the maker, checker and diff of a reviewed request
+func logChangeReview(request *models.AdminChangeRequest, checker types.AdminActor, action string) {
+	details := fmt.Sprintf("Change request %d (%s on %q) by maker %d reviewed by checker %d: %s. Diff: %s",
+		request.ID, request.ActionType, request.TargetID, request.MakerID, checker.ID, request.Status, request.Diff)
+	if request.ReviewNote != "" {
+		details += fmt.Sprintf(". Note: %s", request.ReviewNote)
+	}
+	if request.ExecutionError != "" {
+		details += fmt.Sprintf(". Error: %s", request.ExecutionError)
+	}
+
+	before := make(map[string]any)
+	after := make(map[string]any)
+	for field, change := range toChangeRequestResponse(*request).Diff {
+		before[field] = change.From
+		after[field] = change.To
+	}
+
+	adminLog := checker.NewChangeLog(action, "change_request", fmt.Sprintf("%d", request.ID), details, before, after)
+	if err := database.DB.Create(&adminLog).Error; err != nil {
+		log.Printf("Failed to log change request review: %v", err)
+	}
+}
+
+func findChangeRequest(requestID uint) (*models.AdminChangeRequest, error) {
+	var request models.AdminChangeRequest
+	if err := database.DB.First(&request, requestID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.change_request_not_found", nil)
+		}
+		return nil, fmt.Errorf("failed to find change request: %w", err)
+	}
+	return &request, nil
+}
+
+func percentChange(from, to float64) float64 {
+	if from == 0 {
+		return 100
+	}
+	return math.Abs(to-from) / from * 100
+}
+
+func toChangeRequestResponse(request models.AdminChangeRequest) types.AdminChangeRequestResponse {
+	diff := make(map[string]types.FieldChange)
+	if request.Diff != "" {
+		json.Unmarshal([]byte(request.Diff), &diff)
+	}
+
+	return types.AdminChangeRequestResponse{
+		ID:             request.ID,
+		ActionType:     string(request.ActionType),
+		TargetID:       request.TargetID,
+		Diff:           diff,
+		Metric:         request.Metric,
+		Status:         string(request.Status),
+		MakerID:        request.MakerID,
+		CheckerID:      request.CheckerID,
+		ReviewNote:     request.ReviewNote,
+		ReviewedAt:     request.ReviewedAt,
+		ExecutionError: request.ExecutionError,
+		CreatedAt:      request.CreatedAt,
+	}
+}
@@ -0,0 +1,703 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/interfaces"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// securityEmailTemplates carry codes, tokens or links that grant access to an account. Their
+// content is not kept in the delivery log and they cannot be resent from it.
+var securityEmailTemplates = map[string]bool{
+	"welcome":                   true,
+	"email_verification":        true,
+	"password_reset":            true,
+	"two_factor_authentication": true,
+	"two_factor_auth":           true,
+}
+
+// mandatoryEmailTemplates are the security emails plus the account emails a user cannot do
+// without. Like the mandatoryCategories of the notification dispatcher, they ignore the
+// suppression list, so one bounce never locks a user out of login codes and password resets.
+var mandatoryEmailTemplates = func() map[string]bool {
+	templates := map[string]bool{
+		"account_locked":    true,
+		"account_statement": true,
+	}
+	for template := range securityEmailTemplates {
+		templates[template] = true
+	}
+	return templates
+}()
+
+// withoutSuppressedRecipients returns a copy of the message addressed only to recipients that are
+// not on the suppression list, along with the ones that were dropped. Mandatory emails go to every
+// recipient.
+func withoutSuppressedRecipients(message *EmailMessage) (*EmailMessage, []string) {
+	outgoing := *message
+	if mandatoryEmailTemplates[message.Template] {
+		return &outgoing, nil
+	}
+
+	all := append(append(append([]string{}, message.To...), message.CC...), message.BCC...)
+	addresses := make([]string, 0, len(all))
+	for _, address := range all {
+		addresses = append(addresses, normalizeEmail(address))
+	}
+
+	var suppressedEmails []string
+	if err := database.DB.Model(&models.EmailSuppression{}).Where("email IN ?", addresses).
+		Pluck("email", &suppressedEmails).Error; err != nil {
+		// Fails open on purpose: one more bounce costs less than mail silently not sent
+		log.Printf("Failed to check email suppression list, sending to every recipient: %v", err)
+		return &outgoing, nil
+	}
+	if len(suppressedEmails) == 0 {
+		return &outgoing, nil
+	}
+
+	blocked := make(map[string]bool, len(suppressedEmails))
+	for _, email := range suppressedEmails {
+		blocked[email] = true
+	}
+
+	var suppressed []string
+	keep := func(recipients []string) []string {
+		kept := make([]string, 0, len(recipients))
+		for _, recipient := range recipients {
+			if blocked[normalizeEmail(recipient)] {
+				suppressed = append(suppressed, recipient)
+				continue
+			}
+			kept = append(kept, recipient)
+		}
+		return kept
+	}
+	outgoing.To = keep(message.To)
+	outgoing.CC = keep(message.CC)
+	outgoing.BCC = keep(message.BCC)
+	return &outgoing, suppressed
+}
+
+// recordEmailDelivery adds a message to the delivery log before it is sent. A failure to record
+// is logged rather than returned, so the log never stops mail going out.
+func recordEmailDelivery(message *EmailMessage, suppressed []string, provider, messageID string) *models.EmailDelivery {
+	attachments := make([]string, 0, len(message.Attachments))
+	for _, attachment := range message.Attachments {
+		attachments = append(attachments, attachment.Filename)
+	}
+
+	delivery := &models.EmailDelivery{
+		To:                strings.Join(message.To, ","),
+		CC:                strings.Join(message.CC, ","),
+		BCC:               strings.Join(message.BCC, ","),
+		Template:          message.Template,
+		Locale:            message.Locale,
+		Subject:           message.Subject,
+		Attachments:       strings.Join(attachments, ","),
+		Provider:          provider,
+		ProviderMessageID: messageID,
+		Status:            models.EmailQueued,
+		Suppressed:        strings.Join(suppressed, ","),
+		ResentFromID:      message.ResentFromID,
+	}
+	if message.CampaignID != 0 {
+		delivery.CampaignID = &message.CampaignID
+	}
+	if len(message.To) == 0 {
+		delivery.To = delivery.Suppressed
+		delivery.Status = models.EmailSuppressed
+	}
+	if securityEmailTemplates[message.Template] {
+		delivery.Redacted = true
+	} else {
+		delivery.TextBody = message.Body
+		delivery.HTMLBody = message.HTMLBody
+		if message.TemplateData != nil {
+			if data, err := json.Marshal(message.TemplateData); err != nil {
+				log.Printf("Failed to record template data of %s email: %v", message.Template, err)
+			} else {
+				delivery.TemplateData = string(data)
+			}
+		}
+	}
+
+	if err := database.DB.Create(delivery).Error; err != nil {
+		log.Printf("Failed to record email delivery to %s: %v", delivery.To, err)
+		return nil
+	}
+	return delivery
+}
+
+// markEmailDeliverySent records which provider took the message and the ID it gave it
+func markEmailDeliverySent(delivery *models.EmailDelivery, attempts int, provider, providerMessageID string) {
+	if delivery == nil {
+		return
+	}
+	now := time.Now()
+	if err := database.DB.Model(delivery).Updates(map[string]any{
+		"attempts":            attempts,
+		"provider":            provider,
+		"provider_message_id": providerMessageID,
+		"status":              models.EmailSent,
+		"error":               "",
+		"sent_at":             &now,
+	}).Error; err != nil {
+		log.Printf("Failed to record email delivery %d as sent: %v", delivery.ID, err)
+	}
+}
+
+// markEmailDeliveryUncertain records a send whose outcome is unknown as sent by the provider
+// that may have taken it, keeping the error so it can be told apart
+func markEmailDeliveryUncertain(delivery *models.EmailDelivery, attempts int, provider, messageID string, sendErr error) {
+	if delivery == nil {
+		return
+	}
+	now := time.Now()
+	if err := database.DB.Model(delivery).Updates(map[string]any{
+		"attempts":            attempts,
+		"provider":            provider,
+		"provider_message_id": messageID,
+		"status":              models.EmailSent,
+		"error":               sendErr.Error(),
+		"sent_at":             &now,
+	}).Error; err != nil {
+		log.Printf("Failed to record email delivery %d as sent: %v", delivery.ID, err)
+	}
+}
+
+func markEmailDeliveryFailed(delivery *models.EmailDelivery, attempts int, sendErr error) {
+	if delivery == nil {
+		return
+	}
+	if err := database.DB.Model(delivery).Updates(map[string]any{
+		"attempts": attempts,
+		"status":   models.EmailFailed,
+		"error":    sendErr.Error(),
+	}).Error; err != nil {
+		log.Printf("Failed to record email delivery %d as failed: %v", delivery.ID, err)
+	}
+	reportCampaignEmail(delivery, sendErr.Error())
+}
+
+// reportCampaignEmail passes the result of a campaign email on to its campaign recipient. An
+// empty failure means the email was delivered.
+func reportCampaignEmail(delivery *models.EmailDelivery, failure string) {
+	if delivery == nil || delivery.CampaignID == nil {
+		return
+	}
+	var userID uint
+	if err := database.DB.Model(&models.User{}).Where("email = ?", delivery.To).Limit(1).Pluck("id", &userID).Error; err != nil || userID == 0 {
+		log.Printf("Failed to find the user of campaign email %d: %v", delivery.ID, err)
+		return
+	}
+	recordCampaignDelivery(*delivery.CampaignID, userID, models.ChannelEmail, failure)
+}
+
+// EmailEvent is a provider callback about a sent email. Event is delivered, bounce or
+// complaint; BounceType is hard or soft, and only hard bounces suppress the address.
+// MessageID is the Message-ID the email was sent with.
+type EmailEvent struct {
+	Event      string `json:"event"`
+	MessageID  string `json:"message_id"`
+	Email      string `json:"email"`
+	BounceType string `json:"bounce_type"`
+	Reason     string `json:"reason"`
+}
+
+// HandleEmailEvents applies a signed provider callback carrying one event or a list of them
+func HandleEmailEvents(payload []byte, signature string) error {
+	if !verifyEmailSignature(payload, signature) {
+		return errors.New("invalid webhook signature")
+	}
+
+	var events []EmailEvent
+	if trimmed := bytes.TrimSpace(payload); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &events); err != nil {
+			return fmt.Errorf("failed to parse email events: %w", err)
+		}
+	} else {
+		var event EmailEvent
+		if err := json.Unmarshal(trimmed, &event); err != nil {
+			return fmt.Errorf("failed to parse email event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	for _, event := range events {
+		if err := applyEmailEvent(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyEmailEvent(event EmailEvent) error {
+	messageID := strings.Trim(strings.TrimSpace(event.MessageID), "<>")
+	email := normalizeEmail(event.Email)
+	if messageID == "" && email == "" {
+		return errors.New("message_id or email is required")
+	}
+
+	var delivery models.EmailDelivery
+	if messageID != "" {
+		if err := database.DB.Where("provider_message_id = ?", messageID).Order("id DESC").Limit(1).
+			Find(&delivery).Error; err != nil {
+			return fmt.Errorf("failed to fetch email delivery: %w", err)
+		}
+	}
+	var deliveryID *uint
+	if delivery.ID != 0 {
+		deliveryID = &delivery.ID
+		if email == "" && !strings.Contains(delivery.To, ",") {
+			email = normalizeEmail(delivery.To)
+		}
+	}
+
+	reason := strings.TrimSpace(event.Reason)
+	now := time.Now()
+
+	switch strings.ToLower(event.Event) {
+	case "delivered", "delivery":
+		if deliveryID == nil {
+			return nil
+		}
+		reportCampaignEmail(&delivery, "")
+		// A bounce or complaint may arrive first, and is the more useful status to keep
+		return database.DB.Model(&models.EmailDelivery{}).
+			Where("id = ? AND status IN ?", delivery.ID, []models.EmailDeliveryStatus{models.EmailQueued, models.EmailSent, models.EmailFailed}).
+			Updates(map[string]any{"status": models.EmailDelivered, "delivered_at": now}).Error
+
+	case "bounce", "bounced":
+		if reason == "" {
+			reason = "bounced"
+		}
+		if strings.EqualFold(event.BounceType, "soft") {
+			// Soft bounces are temporary, so the address stays usable
+			if deliveryID == nil {
+				return nil
+			}
+			return database.DB.Model(&models.EmailDelivery{}).Where("id = ?", delivery.ID).
+				Update("error", "soft bounce: "+reason).Error
+		}
+		if deliveryID != nil {
+			if err := database.DB.Model(&models.EmailDelivery{}).
+				Where("id = ? AND status <> ?", delivery.ID, models.EmailComplained).
+				Updates(map[string]any{"status": models.EmailBounced, "bounced_at": now, "error": reason}).Error; err != nil {
+				return fmt.Errorf("failed to update email delivery: %w", err)
+			}
+			reportCampaignEmail(&delivery, "bounced: "+reason)
+		}
+		return suppressEmailAddress(email, models.SuppressionBounce, reason, deliveryID)
+
+	case "complaint", "complained", "spam":
+		if reason == "" {
+			reason = "marked as spam"
+		}
+		if deliveryID != nil {
+			if err := database.DB.Model(&models.EmailDelivery{}).Where("id = ?", delivery.ID).
+				Updates(map[string]any{"status": models.EmailComplained, "error": reason}).Error; err != nil {
+				return fmt.Errorf("failed to update email delivery: %w", err)
+			}
+		}
+		return suppressEmailAddress(email, models.SuppressionComplaint, reason, deliveryID)
+
+	default:
+		// Opens, clicks and other tracking events are not recorded
+		return nil
+	}
+}
+
+// suppressEmailAddress adds an address to the suppression list, keeping the original entry
+// when it is already there
+func suppressEmailAddress(email string, reason models.EmailSuppressionReason, detail string, deliveryID *uint) error {
+	if email == "" {
+		return nil
+	}
+	suppression := models.EmailSuppression{Email: email, Reason: reason, Detail: detail, DeliveryID: deliveryID}
+	if err := database.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&suppression).Error; err != nil {
+		return fmt.Errorf("failed to suppress %s: %w", email, err)
+	}
+	log.Printf("Suppressed email %s after %s", email, reason)
+	return nil
+}
+
+// verifyEmailSignature verifies an email provider event signature
+func verifyEmailSignature(payload []byte, signature string) bool {
+	return libs.VerifyHMACSHA512(payload, signature, libs.GetEnvOrDefault("EMAIL_WEBHOOK_SECRET", ""))
+}
+
+// AdminGetEmailDeliveries searches the email delivery log
+func AdminGetEmailDeliveries(params types.GetEmailDeliveriesRequest) (types.GetEmailDeliveriesResponse, error) {
+	var response types.GetEmailDeliveriesResponse
+
+	query := database.DB.Model(&models.EmailDelivery{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Email != "" {
+		pattern := "%" + strings.TrimSpace(params.Email) + "%"
+		query = query.Where("(\"to\" ILIKE ? OR cc ILIKE ? OR bcc ILIKE ?)", pattern, pattern, pattern)
+	}
+	if params.Template != "" {
+		query = query.Where("template = ?", params.Template)
+	}
+	if params.ProviderMessageID != "" {
+		query = query.Where("provider_message_id = ?", strings.Trim(params.ProviderMessageID, "<>"))
+	}
+	query, err := applyLogDateRange(query, params.From, params.To)
+	if err != nil {
+		return response, err
+	}
+
+	page, limit := normalizeLogPage(params.Page, params.Limit)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return response, fmt.Errorf("failed to count email deliveries: %w", err)
+	}
+
+	// The bodies can be large and the list does not show them
+	var deliveries []models.EmailDelivery
+	if err := query.Omit("text_body", "html_body", "template_data").Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return response, fmt.Errorf("failed to fetch email deliveries: %w", err)
+	}
+
+	response.Deliveries = make([]types.EmailDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, toEmailDeliveryResponse(delivery))
+	}
+	response.Total = total
+	response.Page = page
+	response.Limit = limit
+	response.TotalPages = totalLogPages(total, limit)
+	return response, nil
+}
+
+// AdminGetEmailDelivery returns a logged email with its content and any resends of it
+func AdminGetEmailDelivery(id uint) (*types.EmailDeliveryDetailResponse, error) {
+	delivery, err := findEmailDelivery(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var resends []models.EmailDelivery
+	if err := database.DB.Omit("text_body", "html_body", "template_data").Where("resent_from_id = ?", delivery.ID).
+		Order("created_at ASC").Find(&resends).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch resends: %w", err)
+	}
+
+	response := &types.EmailDeliveryDetailResponse{
+		EmailDeliveryResponse: toEmailDeliveryResponse(*delivery),
+		CC:                    splitRecipients(delivery.CC),
+		BCC:                   splitRecipients(delivery.BCC),
+		Resends:               make([]types.EmailDeliveryResponse, 0, len(resends)),
+	}
+	// Security emails logged before their content was redacted still hold it
+	if !response.Redacted {
+		response.TextBody = delivery.TextBody
+		response.HTMLBody = delivery.HTMLBody
+	}
+	for _, resend := range resends {
+		response.Resends = append(response.Resends, toEmailDeliveryResponse(resend))
+	}
+	return response, nil
+}
+
+// AdminResendEmailDelivery queues a logged email to be rendered from its template and sent again
+func AdminResendEmailDelivery(id uint, actor types.AdminActor) (types.AdminActionResponse, error) {
+	var response types.AdminActionResponse
+
+	delivery, err := findEmailDelivery(id)
+	if err != nil {
+		return response, err
+	}
+	if err := checkEmailResendable(delivery); err != nil {
+		return response, err
+	}
+
+	recipients := splitRecipients(delivery.To)
+	addresses := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		addresses = append(addresses, normalizeEmail(recipient))
+	}
+	var suppressed int64
+	if err := database.DB.Model(&models.EmailSuppression{}).Where("email IN ?", addresses).Count(&suppressed).Error; err != nil {
+		return response, fmt.Errorf("failed to check email suppression list: %w", err)
+	}
+	if suppressed >= int64(len(addresses)) {
+		return response, i18n.NewError("error.email_all_suppressed", nil)
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		adminLog := actor.NewLog("RESEND_EMAIL", "email_delivery", fmt.Sprint(delivery.ID),
+			fmt.Sprintf("Email %q to %s queued for resending", delivery.Subject, delivery.To))
+		if err := tx.Create(&adminLog).Error; err != nil {
+			return err
+		}
+
+		emailClient := jobs.NewEmailJobClient()
+		defer emailClient.Close()
+		return emailClient.EnqueueResend(delivery.ID)
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return types.AdminActionResponse{
+		Success:   true,
+		Message:   "Email queued for resending",
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// HandleEmailResendTask renders a logged email from its template and data and sends it again.
+// The resend gets its own entry in the log, pointing back at the original.
+func HandleEmailResendTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.EmailResendPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal email resend payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	var delivery models.EmailDelivery
+	if err := database.DB.First(&delivery, payload.DeliveryID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("email delivery %d not found: %w", payload.DeliveryID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch email delivery: %w", err)
+	}
+
+	if err := checkEmailResendable(&delivery); err != nil {
+		return fmt.Errorf("email %d cannot be resent: %v: %w", delivery.ID, err, asynq.SkipRetry)
+	}
+
+	emailService, ok := interfaces.GetGlobalEmailSender().(*EmailService)
+	if !ok {
+		return fmt.Errorf("email service not initialized")
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal([]byte(delivery.TemplateData), &data); err != nil {
+		return fmt.Errorf("failed to read template data of email %d: %v: %w", delivery.ID, err, asynq.SkipRetry)
+	}
+	if err := emailService.sendTemplatedEmail(splitRecipients(delivery.To), delivery.Template, data, nil, &delivery.ID); err != nil {
+		return fmt.Errorf("failed to resend email %d: %w", delivery.ID, err)
+	}
+
+	log.Printf("Email %d resent to %s", delivery.ID, delivery.To)
+	return nil
+}
+
+// checkEmailResendable refuses emails whose content cannot or must not be produced again
+func checkEmailResendable(delivery *models.EmailDelivery) error {
+	switch {
+	case delivery.Redacted || securityEmailTemplates[delivery.Template]:
+		return i18n.NewError("error.email_resend_security", nil)
+	case delivery.Attachments != "":
+		return i18n.NewError("error.email_resend_attachments", nil)
+	case delivery.Template == "" || delivery.TemplateData == "":
+		return i18n.NewError("error.email_resend_template_only", nil)
+	}
+	return nil
+}
+
+// GetEmailDeliveryStats counts logged emails by status
+func GetEmailDeliveryStats() (*types.EmailDeliveryStatsResponse, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	if err := database.DB.Model(&models.EmailDelivery{}).Select("status, COUNT(*) AS count").
+		Group("status").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count email deliveries: %w", err)
+	}
+
+	stats := &types.EmailDeliveryStatsResponse{ByStatus: make(map[string]int64, len(rows))}
+	for _, row := range rows {
+		stats.ByStatus[row.Status] = row.Count
+		stats.Total += row.Count
+	}
+
+	var lastSent sql.NullTime
+	if err := database.DB.Model(&models.EmailDelivery{}).Select("MAX(sent_at)").Row().Scan(&lastSent); err != nil {
+		return nil, fmt.Errorf("failed to fetch last sent email: %w", err)
+	}
+	if lastSent.Valid {
+		stats.LastSent = &lastSent.Time
+	}
+	return stats, nil
+}
+
+// AdminGetEmailSuppressions lists suppressed addresses
+func AdminGetEmailSuppressions(params types.GetEmailSuppressionsRequest) (types.GetEmailSuppressionsResponse, error) {
+	var response types.GetEmailSuppressionsResponse
+
+	query := database.DB.Model(&models.EmailSuppression{})
+	if params.Email != "" {
+		query = query.Where("email ILIKE ?", "%"+strings.TrimSpace(params.Email)+"%")
+	}
+	if params.Reason != "" {
+		query = query.Where("reason = ?", params.Reason)
+	}
+
+	page, limit := normalizeLogPage(params.Page, params.Limit)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return response, fmt.Errorf("failed to count email suppressions: %w", err)
+	}
+
+	var suppressions []models.EmailSuppression
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&suppressions).Error; err != nil {
+		return response, fmt.Errorf("failed to fetch email suppressions: %w", err)
+	}
+
+	response.Suppressions = make([]types.EmailSuppressionResponse, 0, len(suppressions))
+	for _, suppression := range suppressions {
+		response.Suppressions = append(response.Suppressions, toEmailSuppressionResponse(suppression))
+	}
+	response.Total = total
+	response.Page = page
+	response.Limit = limit
+	response.TotalPages = totalLogPages(total, limit)
+	return response, nil
+}
+
+// AdminAddEmailSuppression stops all email to an address until the suppression is removed
+func AdminAddEmailSuppression(req types.AddEmailSuppressionRequest, actor types.AdminActor) (*types.EmailSuppressionResponse, error) {
+	suppression := models.EmailSuppression{
+		Email:     normalizeEmail(req.Email),
+		Reason:    models.SuppressionManual,
+		Detail:    strings.TrimSpace(req.Detail),
+		CreatedBy: actor.ID,
+	}
+
+	var existing int64
+	if err := database.DB.Model(&models.EmailSuppression{}).Where("email = ?", suppression.Email).Count(&existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to check email suppression list: %w", err)
+	}
+	if existing > 0 {
+		return nil, i18n.NewError("error.email_already_suppressed", nil)
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&suppression).Error; err != nil {
+			return fmt.Errorf("failed to suppress email: %w", err)
+		}
+		adminLog := actor.NewLog("ADD_EMAIL_SUPPRESSION", "email_suppression", fmt.Sprint(suppression.ID),
+			fmt.Sprintf("Email to %s suppressed", suppression.Email))
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toEmailSuppressionResponse(suppression)
+	return &response, nil
+}
+
+// AdminRemoveEmailSuppression lets email go to a suppressed address again
+func AdminRemoveEmailSuppression(id uint, actor types.AdminActor) (types.AdminActionResponse, error) {
+	var response types.AdminActionResponse
+
+	var suppression models.EmailSuppression
+	if err := database.DB.First(&suppression, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response, i18n.NewError("error.email_suppression_not_found", nil)
+		}
+		return response, fmt.Errorf("failed to fetch email suppression: %w", err)
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// Deleted for good, so the address can be suppressed again later
+		if err := tx.Unscoped().Delete(&suppression).Error; err != nil {
+			return fmt.Errorf("failed to remove email suppression: %w", err)
+		}
+		adminLog := actor.NewChangeLog("REMOVE_EMAIL_SUPPRESSION", "email_suppression", fmt.Sprint(suppression.ID),
+			fmt.Sprintf("Email to %s no longer suppressed", suppression.Email), toEmailSuppressionResponse(suppression), nil)
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return types.AdminActionResponse{
+		Success:   true,
+		Message:   "Email suppression removed successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func findEmailDelivery(id uint) (*models.EmailDelivery, error) {
+	var delivery models.EmailDelivery
+	if err := database.DB.First(&delivery, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.email_delivery_not_found", nil)
+		}
+		return nil, fmt.Errorf("failed to fetch email delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+func toEmailDeliveryResponse(delivery models.EmailDelivery) types.EmailDeliveryResponse {
+	return types.EmailDeliveryResponse{
+		ID:                delivery.ID,
+		To:                splitRecipients(delivery.To),
+		Template:          delivery.Template,
+		Locale:            delivery.Locale,
+		Subject:           delivery.Subject,
+		Provider:          delivery.Provider,
+		ProviderMessageID: delivery.ProviderMessageID,
+		Status:            string(delivery.Status),
+		Attempts:          delivery.Attempts,
+		Error:             delivery.Error,
+		Suppressed:        splitRecipients(delivery.Suppressed),
+		Attachments:       splitRecipients(delivery.Attachments),
+		Redacted:          delivery.Redacted || securityEmailTemplates[delivery.Template],
+		ResentFromID:      delivery.ResentFromID,
+		CreatedAt:         delivery.CreatedAt,
+		SentAt:            delivery.SentAt,
+		DeliveredAt:       delivery.DeliveredAt,
+		BouncedAt:         delivery.BouncedAt,
+	}
+}
+
+func toEmailSuppressionResponse(suppression models.EmailSuppression) types.EmailSuppressionResponse {
+	return types.EmailSuppressionResponse{
+		ID:         suppression.ID,
+		Email:      suppression.Email,
+		Reason:     string(suppression.Reason),
+		Detail:     suppression.Detail,
+		DeliveryID: suppression.DeliveryID,
+		CreatedBy:  suppression.CreatedBy,
+		CreatedAt:  suppression.CreatedAt,
+	}
+}
+
+// splitRecipients splits a comma separated list as stored in the delivery log
+func splitRecipients(list string) []string {
+	if list == "" {
+		return []string{}
+	}
+	return strings.Split(list, ",")
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
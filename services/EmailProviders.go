@@ -0,0 +1,476 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// errEmailOutcomeUnknown marks a send that failed after the provider may already have accepted
+// the message, such as a timeout waiting for its response. Retrying it, or failing over to the
+// next provider, could deliver the email twice.
+var errEmailOutcomeUnknown = errors.New("the provider may have accepted the message")
+
+// EmailProvider hands a rendered message to one delivery service. EmailService tries its
+// providers in order, so an HTTP API provider can fail over to SMTP.
+type EmailProvider interface {
+	// Send delivers the message and returns the provider's message ID, which bounce and
+	// complaint events refer back to. An empty ID means the message's own Message-ID is used.
+	Send(message *EmailMessage) (string, error)
+
+	// Name identifies the provider on delivery records
+	Name() string
+}
+
+// EmailProviderConfig holds the settings for an HTTP API email provider
+type EmailProviderConfig struct {
+	Name    string // mailgun, sendgrid or ses
+	BaseURL string // defaults to the provider's public API
+	APIKey  string // the SES secret access key for ses
+	KeyID   string // SES access key ID
+	Domain  string // Mailgun sending domain
+	Region  string // SES region
+	Timeout time.Duration
+}
+
+// Validate checks the settings the named provider needs
+func (c *EmailProviderConfig) Validate() error {
+	if c.APIKey == "" {
+		return fmt.Errorf("EMAIL_API_KEY is required for the %s email provider", c.Name)
+	}
+	switch c.Name {
+	case "mailgun":
+		if c.Domain == "" {
+			return errors.New("EMAIL_API_DOMAIN is required for the mailgun email provider")
+		}
+	case "sendgrid":
+	case "ses":
+		if c.KeyID == "" {
+			return errors.New("EMAIL_API_KEY_ID is required for the ses email provider")
+		}
+		if c.Region == "" {
+			return errors.New("EMAIL_API_REGION is required for the ses email provider")
+		}
+	default:
+		return fmt.Errorf("unknown email provider %q", c.Name)
+	}
+	return nil
+}
+
+// newEmailProvider builds the HTTP API provider named by the config. SES takes the raw MIME
+// message, so it is given the service's message builder.
+func newEmailProvider(config *EmailProviderConfig, es *EmailService) (EmailProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 30 * time.Second
+	}
+	client := &http.Client{Timeout: config.Timeout}
+	from := emailFromAddress(es.config.FromName, es.config.FromEmail)
+
+	switch config.Name {
+	case "mailgun":
+		return &MailgunEmailProvider{config: config, client: client, from: from}, nil
+	case "sendgrid":
+		return &SendGridEmailProvider{config: config, client: client, fromEmail: es.config.FromEmail, fromName: es.config.FromName}, nil
+	default:
+		return &SESEmailProvider{config: config, client: client, from: from, buildMIME: es.buildEmailContent, now: time.Now}, nil
+	}
+}
+
+// SMTPEmailProvider sends through the SMTP server in the service's configuration
+type SMTPEmailProvider struct {
+	service *EmailService
+}
+
+// Send delivers the message over SMTP. Servers do not hand back an ID, so the message's own
+// Message-ID identifies it.
+func (p *SMTPEmailProvider) Send(message *EmailMessage) (string, error) {
+	return "", p.service.sendSMTP(message)
+}
+
+// Name identifies the provider on delivery records
+func (p *SMTPEmailProvider) Name() string {
+	return "smtp"
+}
+
+// MailgunEmailProvider sends through the Mailgun messages API
+type MailgunEmailProvider struct {
+	config *EmailProviderConfig
+	client *http.Client
+	from   string
+}
+
+// Send posts the message as a multipart form and returns Mailgun's message ID
+func (p *MailgunEmailProvider) Send(message *EmailMessage) (string, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+
+	fields := [][2]string{{"from", p.from}, {"subject", message.Subject}}
+	for _, to := range message.To {
+		fields = append(fields, [2]string{"to", to})
+	}
+	for _, cc := range message.CC {
+		fields = append(fields, [2]string{"cc", cc})
+	}
+	for _, bcc := range message.BCC {
+		fields = append(fields, [2]string{"bcc", bcc})
+	}
+	if message.Body != "" {
+		fields = append(fields, [2]string{"text", message.Body})
+	}
+	if message.HTMLBody != "" {
+		fields = append(fields, [2]string{"html", message.HTMLBody})
+	}
+	for key, value := range message.Headers {
+		fields = append(fields, [2]string{"h:" + key, value})
+	}
+	for _, field := range fields {
+		if err := form.WriteField(field[0], field[1]); err != nil {
+			return "", fmt.Errorf("failed to build Mailgun request: %w", err)
+		}
+	}
+
+	for _, attachment := range message.Attachments {
+		fieldName := "attachment"
+		if attachment.Inline {
+			fieldName = "inline"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, fieldName, attachment.Filename))
+		header.Set("Content-Type", attachmentContentType(attachment))
+		part, err := form.CreatePart(header)
+		if err != nil {
+			return "", fmt.Errorf("failed to attach %s: %w", attachment.Filename, err)
+		}
+		if _, err := part.Write(attachment.Data); err != nil {
+			return "", fmt.Errorf("failed to attach %s: %w", attachment.Filename, err)
+		}
+	}
+	if err := form.Close(); err != nil {
+		return "", fmt.Errorf("failed to build Mailgun request: %w", err)
+	}
+
+	url := providerBaseURL(p.config, "https://api.mailgun.net") + "/v3/" + p.config.Domain + "/messages"
+	req, err := http.NewRequest("POST", url, &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create Mailgun request: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.SetBasicAuth("api", p.config.APIKey)
+
+	responseBody, _, err := doEmailProviderRequest(p.client, req, p.Name())
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return "", fmt.Errorf("failed to decode Mailgun response: %v: %w", err, errEmailOutcomeUnknown)
+	}
+	if result.ID == "" {
+		return "", fmt.Errorf("mailgun returned no message ID: %s: %w", result.Message, errEmailOutcomeUnknown)
+	}
+	return strings.Trim(result.ID, "<>"), nil
+}
+
+// Name identifies the provider on delivery records
+func (p *MailgunEmailProvider) Name() string {
+	return "mailgun"
+}
+
+// SendGridEmailProvider sends through the SendGrid v3 mail send API
+type SendGridEmailProvider struct {
+	config    *EmailProviderConfig
+	client    *http.Client
+	fromEmail string
+	fromName  string
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridAttachment struct {
+	Content     string `json:"content"`
+	Type        string `json:"type"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition"`
+	ContentID   string `json:"content_id,omitempty"`
+}
+
+type sendGridPersonalization struct {
+	To  []sendGridAddress `json:"to"`
+	CC  []sendGridAddress `json:"cc,omitempty"`
+	BCC []sendGridAddress `json:"bcc,omitempty"`
+}
+
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Attachments      []sendGridAttachment      `json:"attachments,omitempty"`
+	Headers          map[string]string         `json:"headers,omitempty"`
+}
+
+// Send posts the message as JSON and returns the ID from SendGrid's X-Message-Id header
+func (p *SendGridEmailProvider) Send(message *EmailMessage) (string, error) {
+	payload := sendGridRequest{
+		Personalizations: []sendGridPersonalization{{
+			To:  sendGridAddresses(message.To),
+			CC:  sendGridAddresses(message.CC),
+			BCC: sendGridAddresses(message.BCC),
+		}},
+		From:    sendGridAddress{Email: p.fromEmail, Name: p.fromName},
+		Subject: message.Subject,
+		Headers: message.Headers,
+	}
+	// SendGrid wants the plain text part before the HTML one
+	if message.Body != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/plain", Value: message.Body})
+	}
+	if message.HTMLBody != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/html", Value: message.HTMLBody})
+	}
+	for _, attachment := range message.Attachments {
+		disposition := "attachment"
+		if attachment.Inline {
+			disposition = "inline"
+		}
+		payload.Attachments = append(payload.Attachments, sendGridAttachment{
+			Content:     base64.StdEncoding.EncodeToString(attachment.Data),
+			Type:        attachmentContentType(attachment),
+			Filename:    attachment.Filename,
+			Disposition: disposition,
+			ContentID:   attachment.ContentID,
+		})
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SendGrid request: %w", err)
+	}
+
+	url := providerBaseURL(p.config, "https://api.sendgrid.com") + "/v3/mail/send"
+	req, err := http.NewRequest("POST", url, bytes.NewReader(jsonPayload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create SendGrid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.config.APIKey)
+
+	_, header, err := doEmailProviderRequest(p.client, req, p.Name())
+	if err != nil {
+		return "", err
+	}
+	return header.Get("X-Message-Id"), nil
+}
+
+// Name identifies the provider on delivery records
+func (p *SendGridEmailProvider) Name() string {
+	return "sendgrid"
+}
+
+func sendGridAddresses(emails []string) []sendGridAddress {
+	if len(emails) == 0 {
+		return nil
+	}
+	addresses := make([]sendGridAddress, 0, len(emails))
+	for _, email := range emails {
+		addresses = append(addresses, sendGridAddress{Email: email})
+	}
+	return addresses
+}
+
+// SESEmailProvider sends through the Amazon SES v2 API. The message goes as raw MIME so
+// attachments and custom headers survive, and requests are signed with AWS Signature V4.
+type SESEmailProvider struct {
+	config    *EmailProviderConfig
+	client    *http.Client
+	from      string
+	buildMIME func(*EmailMessage) string
+	now       func() time.Time
+}
+
+type sesDestination struct {
+	ToAddresses  []string `json:"ToAddresses,omitempty"`
+	CcAddresses  []string `json:"CcAddresses,omitempty"`
+	BccAddresses []string `json:"BccAddresses,omitempty"`
+}
+
+type sesRequest struct {
+	FromEmailAddress string         `json:"FromEmailAddress"`
+	Destination      sesDestination `json:"Destination"`
+	Content          struct {
+		Raw struct {
+			Data string `json:"Data"`
+		} `json:"Raw"`
+	} `json:"Content"`
+}
+
+// Send posts the raw message and returns the SES message ID
+func (p *SESEmailProvider) Send(message *EmailMessage) (string, error) {
+	payload := sesRequest{
+		FromEmailAddress: p.from,
+		Destination: sesDestination{
+			ToAddresses:  message.To,
+			CcAddresses:  message.CC,
+			BccAddresses: message.BCC,
+		},
+	}
+	payload.Content.Raw.Data = base64.StdEncoding.EncodeToString([]byte(p.buildMIME(message)))
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SES request: %w", err)
+	}
+
+	url := providerBaseURL(p.config, "https://email."+p.config.Region+".amazonaws.com") + "/v2/email/outbound-emails"
+	req, err := http.NewRequest("POST", url, bytes.NewReader(jsonPayload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create SES request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	signAWSRequest(req, jsonPayload, p.config.KeyID, p.config.APIKey, p.config.Region, "ses", p.now())
+
+	responseBody, _, err := doEmailProviderRequest(p.client, req, p.Name())
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		MessageID string `json:"MessageId"`
+	}
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return "", fmt.Errorf("failed to decode SES response: %v: %w", err, errEmailOutcomeUnknown)
+	}
+	if result.MessageID == "" {
+		return "", fmt.Errorf("ses did not return a message ID: %w", errEmailOutcomeUnknown)
+	}
+	return result.MessageID, nil
+}
+
+// Name identifies the provider on delivery records
+func (p *SESEmailProvider) Name() string {
+	return "ses"
+}
+
+// signAWSRequest adds AWS Signature Version 4 headers to a request without a query string
+func signAWSRequest(req *http.Request, body []byte, keyID, secret, region, service string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "content-type:" + strings.TrimSpace(req.Header.Get("Content-Type")) + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{req.Method, path, req.URL.RawQuery, canonicalHeaders, signedHeaders, payloadHash}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+secret), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		keyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// doEmailProviderRequest sends a provider API request, turning non-2xx responses into errors
+func doEmailProviderRequest(client *http.Client, req *http.Request, provider string) ([]byte, http.Header, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		if isConnectionError(err) {
+			return nil, nil, fmt.Errorf("failed to reach %s: %w", provider, err)
+		}
+		return nil, nil, fmt.Errorf("no response from %s: %v: %w", provider, err, errEmailOutcomeUnknown)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s response: %v: %w", provider, err, errEmailOutcomeUnknown)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, fmt.Errorf("%s returned status %d: %s", provider, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, resp.Header, nil
+}
+
+// isConnectionError reports whether a request failed before a connection was made, so the
+// provider cannot have received the message
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func providerBaseURL(config *EmailProviderConfig, defaultURL string) string {
+	if config.BaseURL == "" {
+		return defaultURL
+	}
+	return strings.TrimRight(config.BaseURL, "/")
+}
+
+func attachmentContentType(attachment EmailAttachment) string {
+	if attachment.ContentType == "" {
+		return "application/octet-stream"
+	}
+	return attachment.ContentType
+}
+
+func emailFromAddress(name, email string) string {
+	if name == "" {
+		return email
+	}
+	return fmt.Sprintf("%s <%s>", name, email)
+}
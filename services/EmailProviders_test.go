@@ -0,0 +1,336 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEmailService(provider *EmailProviderConfig) *EmailService {
+	return NewEmailService(&EmailConfig{
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		Username:     "user",
+		Password:     "secret",
+		FromEmail:    "noreply@jeanpay.africa",
+		FromName:     "JeanPay",
+		MaxRetries:   1,
+		RetryDelay:   time.Millisecond,
+		Provider:     provider,
+		SMTPFailover: true,
+	})
+}
+
+func testEmailMessage() *EmailMessage {
+	return &EmailMessage{
+		To:       []string{"ada@example.com"},
+		BCC:      []string{"audit@jeanpay.africa"},
+		Subject:  "Your receipt",
+		Body:     "Thanks for your payment",
+		HTMLBody: "<p>Thanks for your payment</p>",
+		Headers:  map[string]string{"Message-ID": "<abc@jeanpay.africa>"},
+		Attachments: []EmailAttachment{
+			{Filename: "receipt.pdf", ContentType: "application/pdf", Data: []byte("%PDF-1.4")},
+		},
+	}
+}
+
+// fakeEmailProvider records what it was asked to send and fails when told to
+type fakeEmailProvider struct {
+	name string
+	err  error
+	sent []*EmailMessage
+}
+
+func (p *fakeEmailProvider) Send(message *EmailMessage) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	p.sent = append(p.sent, message)
+	return p.name + "-id", nil
+}
+
+func (p *fakeEmailProvider) Name() string {
+	return p.name
+}
+
+func TestMailgunEmailProviderSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/mg.jeanpay.africa/messages" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if user, key, ok := r.BasicAuth(); !ok || user != "api" || key != "mg-key" {
+			t.Errorf("unexpected basic auth %q %q", user, key)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("failed to parse form: %v", err)
+		}
+
+		want := map[string]string{
+			"from":         "JeanPay <noreply@jeanpay.africa>",
+			"to":           "ada@example.com",
+			"bcc":          "audit@jeanpay.africa",
+			"subject":      "Your receipt",
+			"text":         "Thanks for your payment",
+			"html":         "<p>Thanks for your payment</p>",
+			"h:Message-ID": "<abc@jeanpay.africa>",
+		}
+		for field, value := range want {
+			if got := r.FormValue(field); got != value {
+				t.Errorf("form field %s = %q, want %q", field, got, value)
+			}
+		}
+
+		files := r.MultipartForm.File["attachment"]
+		if len(files) != 1 || files[0].Filename != "receipt.pdf" {
+			t.Fatalf("unexpected attachments %v", files)
+		}
+		file, _ := files[0].Open()
+		data, _ := io.ReadAll(file)
+		if string(data) != "%PDF-1.4" {
+			t.Errorf("attachment data = %q", data)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"<20261018.1@mg.jeanpay.africa>","message":"Queued. Thank you."}`))
+	}))
+	defer server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "mailgun", BaseURL: server.URL, APIKey: "mg-key", Domain: "mg.jeanpay.africa"})
+	id, err := es.providers[0].Send(testEmailMessage())
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if id != "20261018.1@mg.jeanpay.africa" {
+		t.Errorf("message ID = %q", id)
+	}
+}
+
+func TestSendGridEmailProviderSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/mail/send" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer sg-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+
+		var payload sendGridRequest
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if len(payload.Personalizations) != 1 ||
+			!reflect.DeepEqual(payload.Personalizations[0].To, []sendGridAddress{{Email: "ada@example.com"}}) ||
+			!reflect.DeepEqual(payload.Personalizations[0].BCC, []sendGridAddress{{Email: "audit@jeanpay.africa"}}) {
+			t.Errorf("unexpected personalizations %+v", payload.Personalizations)
+		}
+		if payload.From != (sendGridAddress{Email: "noreply@jeanpay.africa", Name: "JeanPay"}) {
+			t.Errorf("from = %+v", payload.From)
+		}
+		if len(payload.Content) != 2 || payload.Content[0].Type != "text/plain" || payload.Content[1].Type != "text/html" {
+			t.Errorf("unexpected content %+v", payload.Content)
+		}
+		if len(payload.Attachments) != 1 || payload.Attachments[0].Content != base64.StdEncoding.EncodeToString([]byte("%PDF-1.4")) {
+			t.Errorf("unexpected attachments %+v", payload.Attachments)
+		}
+
+		w.Header().Set("X-Message-Id", "sg-123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "sendgrid", BaseURL: server.URL + "/", APIKey: "sg-key"})
+	id, err := es.providers[0].Send(testEmailMessage())
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if id != "sg-123" {
+		t.Errorf("message ID = %q", id)
+	}
+}
+
+func TestSESEmailProviderSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/email/outbound-emails" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20261018/eu-west-1/ses/aws4_request, SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature=") {
+			t.Errorf("Authorization = %q", auth)
+		}
+		if got := r.Header.Get("X-Amz-Date"); got != "20261018T093000Z" {
+			t.Errorf("X-Amz-Date = %q", got)
+		}
+
+		var payload sesRequest
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if !reflect.DeepEqual(payload.Destination.BccAddresses, []string{"audit@jeanpay.africa"}) {
+			t.Errorf("destination = %+v", payload.Destination)
+		}
+		raw, err := base64.StdEncoding.DecodeString(payload.Content.Raw.Data)
+		if err != nil {
+			t.Fatalf("raw data is not base64: %v", err)
+		}
+		for _, want := range []string{"Subject: Your receipt", "Message-ID: <abc@jeanpay.africa>", `filename="receipt.pdf"`} {
+			if !strings.Contains(string(raw), want) {
+				t.Errorf("raw message is missing %q", want)
+			}
+		}
+		if strings.Contains(string(raw), "audit@jeanpay.africa") {
+			t.Error("raw message reveals the BCC recipient")
+		}
+
+		w.Write([]byte(`{"MessageId":"ses-456"}`))
+	}))
+	defer server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "ses", BaseURL: server.URL, APIKey: "ses-secret", KeyID: "AKIDEXAMPLE", Region: "eu-west-1"})
+	provider := es.providers[0].(*SESEmailProvider)
+	provider.now = func() time.Time { return time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC) }
+
+	id, err := provider.Send(testEmailMessage())
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if id != "ses-456" {
+		t.Errorf("message ID = %q", id)
+	}
+}
+
+func TestEmailProviderErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errors":[{"message":"The provided authorization grant is invalid"}]}`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "sendgrid", BaseURL: server.URL, APIKey: "bad-key"})
+	_, err := es.providers[0].Send(testEmailMessage())
+	if err == nil || !strings.Contains(err.Error(), "sendgrid returned status 401") {
+		t.Fatalf("expected a 401 error, got %v", err)
+	}
+}
+
+func TestEmailServiceFailsOverToNextProvider(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "mailgun", BaseURL: server.URL, APIKey: "mg-key", Domain: "mg.jeanpay.africa"})
+	fallback := &fakeEmailProvider{name: "smtp"}
+	es.providers = []EmailProvider{es.providers[0], fallback}
+
+	provider, id, err := es.deliver(testEmailMessage())
+	if err != nil {
+		t.Fatalf("deliver returned error: %v", err)
+	}
+	if provider != "smtp" || id != "smtp-id" {
+		t.Errorf("delivered via %q with ID %q, want smtp", provider, id)
+	}
+	if requests != 1 || len(fallback.sent) != 1 {
+		t.Errorf("got %d API requests and %d fallback sends, want 1 of each", requests, len(fallback.sent))
+	}
+}
+
+func TestEmailServiceDoesNotFailOverWhenOutcomeUnknown(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("X-Message-Id", "late")
+	}))
+	defer server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "sendgrid", BaseURL: server.URL, APIKey: "sg-key", Timeout: 10 * time.Millisecond})
+	fallback := &fakeEmailProvider{name: "smtp"}
+	es.providers = []EmailProvider{es.providers[0], fallback}
+
+	provider, _, err := es.deliver(testEmailMessage())
+	if !errors.Is(err, errEmailOutcomeUnknown) {
+		t.Fatalf("expected an unknown outcome, got %v", err)
+	}
+	if provider != "sendgrid" {
+		t.Errorf("provider = %q, want sendgrid", provider)
+	}
+	if requests != 1 || len(fallback.sent) != 0 {
+		t.Errorf("got %d API requests and %d fallback sends, want 1 and 0", requests, len(fallback.sent))
+	}
+}
+
+func TestEmailServiceFailsOverWhenProviderUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	baseURL := server.URL
+	server.Close()
+
+	es := testEmailService(&EmailProviderConfig{Name: "sendgrid", BaseURL: baseURL, APIKey: "sg-key"})
+	fallback := &fakeEmailProvider{name: "smtp"}
+	es.providers = []EmailProvider{es.providers[0], fallback}
+
+	provider, _, err := es.deliver(testEmailMessage())
+	if err != nil {
+		t.Fatalf("deliver returned error: %v", err)
+	}
+	if provider != "smtp" || len(fallback.sent) != 1 {
+		t.Errorf("delivered via %q with %d fallback sends, want smtp and 1", provider, len(fallback.sent))
+	}
+}
+
+func TestEmailServiceReportsEveryProviderFailure(t *testing.T) {
+	es := testEmailService(nil)
+	es.providers = []EmailProvider{
+		&fakeEmailProvider{name: "sendgrid", err: errors.New("timeout")},
+		&fakeEmailProvider{name: "smtp", err: errors.New("connection refused")},
+	}
+
+	_, _, err := es.deliver(testEmailMessage())
+	if err == nil || err.Error() != "sendgrid: timeout; smtp: connection refused" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestEmailServiceProviderSelection(t *testing.T) {
+	sendGrid := &EmailProviderConfig{Name: "sendgrid", APIKey: "sg-key"}
+
+	tests := []struct {
+		name   string
+		config *EmailConfig
+		want   []string
+	}{
+		{"smtp only", &EmailConfig{SMTPHost: "smtp.example.com", Username: "user", Password: "secret", FromEmail: "noreply@jeanpay.africa"}, []string{"smtp"}},
+		{"api with smtp failover", &EmailConfig{SMTPHost: "smtp.example.com", Username: "user", Password: "secret", FromEmail: "noreply@jeanpay.africa", Provider: sendGrid, SMTPFailover: true}, []string{"sendgrid", "smtp"}},
+		{"api without smtp credentials", &EmailConfig{FromEmail: "noreply@jeanpay.africa", Provider: sendGrid, SMTPFailover: true}, []string{"sendgrid"}},
+		{"api with failover off", &EmailConfig{SMTPHost: "smtp.example.com", Username: "user", Password: "secret", FromEmail: "noreply@jeanpay.africa", Provider: sendGrid}, []string{"sendgrid"}},
+		{"misconfigured api", &EmailConfig{SMTPHost: "smtp.example.com", Username: "user", Password: "secret", FromEmail: "noreply@jeanpay.africa", Provider: &EmailProviderConfig{Name: "mailgun", APIKey: "mg-key"}}, []string{"smtp"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewEmailService(tt.config).ProviderNames(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("providers = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailConfigValidateWithProvider(t *testing.T) {
+	config := &EmailConfig{FromEmail: "noreply@jeanpay.africa", Provider: &EmailProviderConfig{Name: "ses", APIKey: "secret", KeyID: "AKIDEXAMPLE"}}
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "EMAIL_API_REGION") {
+		t.Fatalf("expected a missing region error, got %v", err)
+	}
+
+	config.Provider.Region = "eu-west-1"
+	if err := config.Validate(); err != nil {
+		t.Fatalf("expected SMTP settings to be optional with an API provider, got %v", err)
+	}
+}
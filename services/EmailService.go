@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"crypto/tls"
 	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
 
 	"log"
+	"maps"
 	"net/smtp"
+	"net/textproto"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -17,10 +19,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/interfaces"
 	"github.com/Veedsify/JeanPayGoBackend/templates"
-	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/google/uuid"
 )
 
 // EmailConfig holds the SMTP server configuration
@@ -38,6 +42,11 @@ type EmailConfig struct {
 	RetryDelay   time.Duration
 	PoolSize     int
 	EnableLogger bool
+
+	// Provider sends through an HTTP API instead of SMTP when set. With SMTPFailover, SMTP
+	// still takes over when the provider fails, as long as SMTP credentials are configured.
+	Provider     *EmailProviderConfig
+	SMTPFailover bool
 }
 
 // EmailMessage represents an email to be sent
@@ -51,6 +60,13 @@ type EmailMessage struct {
 	Attachments []EmailAttachment
 	Headers     map[string]string
 	Priority    EmailPriority
+
+	// Recorded in the delivery log
+	Template     string
+	TemplateData map[string]any
+	Locale       string
+	ResentFromID *uint
+	CampaignID   uint
 }
 
 // EmailAttachment represents a file attachment
@@ -85,6 +101,7 @@ type EmailService struct {
 	config    *EmailConfig
 	templates map[string]*EmailTemplate
 	logger    *log.Logger
+	providers []EmailProvider // tried in order until one accepts the message
 }
 
 // EmailValidationResult represents the result of email validation
@@ -144,9 +161,39 @@ func NewEmailService(config *EmailConfig) *EmailService {
 	// Load default templates
 	service.loadDefaultTemplates()
 
+	service.providers = service.buildProviders()
+
 	return service
 }
 
+// buildProviders lists the providers messages are sent through: the configured HTTP API
+// provider first, then SMTP when it is the only transport or the failover for the API
+func (es *EmailService) buildProviders() []EmailProvider {
+	var providers []EmailProvider
+	if es.config.Provider != nil {
+		provider, err := newEmailProvider(es.config.Provider, es)
+		if err != nil {
+			log.Printf("Email provider %s not available, sending over SMTP: %v", es.config.Provider.Name, err)
+		} else {
+			providers = append(providers, provider)
+		}
+	}
+
+	if len(providers) == 0 || (es.config.SMTPFailover && es.config.hasSMTP()) {
+		providers = append(providers, &SMTPEmailProvider{service: es})
+	}
+	return providers
+}
+
+// ProviderNames lists the providers messages are sent through, in the order they are tried
+func (es *EmailService) ProviderNames() []string {
+	names := make([]string, 0, len(es.providers))
+	for _, provider := range es.providers {
+		names = append(names, provider.Name())
+	}
+	return names
+}
+
 // NewEmailServiceFromEnv creates email service from environment variables
 func NewEmailServiceFromEnv() (*EmailService, error) {
 	config := &EmailConfig{
@@ -163,6 +210,19 @@ func NewEmailServiceFromEnv() (*EmailService, error) {
 		RetryDelay:   time.Duration(getEnvIntOrDefault("SMTP_RETRY_DELAY", 1)) * time.Second,
 		PoolSize:     getEnvIntOrDefault("SMTP_POOL_SIZE", 10),
 		EnableLogger: getEnvBoolOrDefault("SMTP_ENABLE_LOGGER", true),
+		SMTPFailover: getEnvBoolOrDefault("EMAIL_SMTP_FAILOVER", true),
+	}
+
+	if provider := GetEnvOrDefault("EMAIL_PROVIDER", "smtp"); provider != "smtp" {
+		config.Provider = &EmailProviderConfig{
+			Name:    provider,
+			BaseURL: os.Getenv("EMAIL_API_BASE_URL"),
+			APIKey:  os.Getenv("EMAIL_API_KEY"),
+			KeyID:   os.Getenv("EMAIL_API_KEY_ID"),
+			Domain:  os.Getenv("EMAIL_API_DOMAIN"),
+			Region:  os.Getenv("EMAIL_API_REGION"),
+			Timeout: time.Duration(getEnvIntOrDefault("EMAIL_API_TIMEOUT", 30)) * time.Second,
+		}
 	}
 
 	if err := config.Validate(); err != nil {
@@ -172,20 +232,24 @@ func NewEmailServiceFromEnv() (*EmailService, error) {
 	return NewEmailService(config), nil
 }
 
-// Validate validates the email configuration
+// Validate validates the email configuration. SMTP settings are only required when SMTP is
+// the transport; with an HTTP API provider they are optional and enable failover.
 func (c *EmailConfig) Validate() error {
-	if c.Username == "" {
-		return fmt.Errorf("SMTP_USERNAME is required")
-	}
-	if c.Password == "" {
-		return fmt.Errorf("SMTP_PASSWORD is required")
-	}
 	if c.FromEmail == "" {
 		return fmt.Errorf("FROM_EMAIL is required")
 	}
 	if !IsValidEmail(c.FromEmail) {
 		return fmt.Errorf("FROM_EMAIL is not a valid email address")
 	}
+	if c.Provider != nil {
+		return c.Provider.Validate()
+	}
+	if c.Username == "" {
+		return fmt.Errorf("SMTP_USERNAME is required")
+	}
+	if c.Password == "" {
+		return fmt.Errorf("SMTP_PASSWORD is required")
+	}
 	if c.SMTPHost == "" {
 		return fmt.Errorf("SMTP_HOST is required")
 	}
@@ -195,6 +259,11 @@ func (c *EmailConfig) Validate() error {
 	return nil
 }
 
+// hasSMTP reports whether enough SMTP settings are present to send through it
+func (c *EmailConfig) hasSMTP() bool {
+	return c.SMTPHost != "" && c.Username != "" && c.Password != ""
+}
+
 // loadDefaultTemplates loads the default email templates
 func (es *EmailService) loadDefaultTemplates() {
 
@@ -237,27 +306,91 @@ func (es *EmailService) loadDefaultTemplates() {
 		HTMLContent: templates.TransactionRejectedTemplate(),
 		TextContent: templates.TransactionRejectedPlainTextTemplate(),
 	}
+
+	// --- Account Locked Template ---
+	es.templates["account_locked"] = &EmailTemplate{
+		Name:        "account_locked",
+		Subject:     "🔒 Your JeanPay Account Has Been Temporarily Locked",
+		HTMLContent: templates.AccountLockedTemplate(),
+		TextContent: templates.AccountLockedPlainTextTemplate(),
+	}
+
+	// --- Rate Alert Template ---
+	es.templates["rate_alert"] = &EmailTemplate{
+		Name:        "rate_alert",
+		Subject:     "📈 {{.Pair}} is {{.Direction}} {{.Threshold}} - JeanPay",
+		HTMLContent: templates.RateAlertTemplate(),
+		TextContent: templates.RateAlertPlainTextTemplate(),
+	}
+
+	// --- Account Statement Template ---
+	es.templates["account_statement"] = &EmailTemplate{
+		Name:        "account_statement",
+		Subject:     "📄 Your {{.Currency}} Statement for {{.Period}} - JeanPay",
+		HTMLContent: templates.AccountStatementTemplate(),
+		TextContent: templates.AccountStatementPlainTextTemplate(),
+	}
+
+	// --- Campaign Template ---
+	es.templates["campaign"] = &EmailTemplate{
+		Name:        "campaign",
+		Subject:     "{{.Title}} - JeanPay",
+		HTMLContent: templates.CampaignTemplate(),
+		TextContent: templates.CampaignPlainTextTemplate(),
+	}
 }
 
-// SendEmail sends an email message with retry logic
+// SendEmail sends an email message with retry logic. Every message is recorded in the delivery
+// log; suppressed recipients are dropped, and a message with none left is logged but not sent.
+// Mandatory security and account emails ignore the suppression list.
 func (es *EmailService) SendEmail(message *EmailMessage) error {
 	if err := es.validateMessage(message); err != nil {
 		return fmt.Errorf("invalid email message: %w", err)
 	}
 
+	outgoing, suppressed := withoutSuppressedRecipients(message)
+	messageID := es.newMessageID()
+	delivery := recordEmailDelivery(outgoing, suppressed, es.providers[0].Name(), messageID)
+	if len(outgoing.To) == 0 {
+		reportCampaignEmail(delivery, "every recipient is suppressed")
+		if es.logger != nil {
+			es.logger.Printf("Email to %v skipped: every recipient is suppressed", message.To)
+		}
+		return nil
+	}
+
+	// The Message-ID is what bounce and complaint reports refer back to
+	outgoing.Headers = make(map[string]string, len(message.Headers)+1)
+	for key, value := range message.Headers {
+		outgoing.Headers[key] = value
+	}
+	outgoing.Headers["Message-ID"] = "<" + messageID + ">"
+
 	var lastErr error
 	for attempt := 0; attempt <= es.config.MaxRetries; attempt++ {
 		if attempt > 0 {
 			if es.logger != nil {
-				es.logger.Printf("Retry attempt %d for email to %v", attempt, message.To)
+				es.logger.Printf("Retry attempt %d for email to %v", attempt, outgoing.To)
 			}
 			time.Sleep(es.config.RetryDelay * time.Duration(attempt))
 		}
 
-		err := es.sendEmailAttempt(message)
+		provider, providerMessageID, err := es.deliver(outgoing)
+		if errors.Is(err, errEmailOutcomeUnknown) {
+			// Sending again could deliver the email twice; bounce and delivery events settle it
+			markEmailDeliveryUncertain(delivery, attempt+1, provider, messageID, err)
+			if es.logger != nil {
+				es.logger.Printf("Email to %v via %s not retried, outcome unknown: %v", outgoing.To, provider, err)
+			}
+			return nil
+		}
 		if err == nil {
+			if providerMessageID == "" {
+				providerMessageID = messageID
+			}
+			markEmailDeliverySent(delivery, attempt+1, provider, providerMessageID)
 			if es.logger != nil {
-				es.logger.Printf("Email sent successfully to %v (attempt %d)", message.To, attempt+1)
+				es.logger.Printf("Email sent successfully to %v via %s (attempt %d)", outgoing.To, provider, attempt+1)
 			}
 			return nil
 		}
@@ -268,11 +401,43 @@ func (es *EmailService) SendEmail(message *EmailMessage) error {
 		}
 	}
 
+	markEmailDeliveryFailed(delivery, es.config.MaxRetries+1, lastErr)
 	return fmt.Errorf("failed to send email after %d attempts: %w", es.config.MaxRetries+1, lastErr)
 }
 
-// sendEmailAttempt performs a single email send attempt
-func (es *EmailService) sendEmailAttempt(message *EmailMessage) error {
+// newMessageID generates a globally unique Message-ID in the sender's domain
+func (es *EmailService) newMessageID() string {
+	domain := "jeanpay.africa"
+	if at := strings.LastIndex(es.config.FromEmail, "@"); at >= 0 {
+		domain = es.config.FromEmail[at+1:]
+	}
+	return uuid.NewString() + "@" + domain
+}
+
+// deliver hands a message to each provider in turn until one accepts it, returning the name of
+// that provider and the message ID it gave. It only moves on when a provider could not be
+// reached or rejected the message; when the outcome is unknown it stops there.
+func (es *EmailService) deliver(message *EmailMessage) (string, string, error) {
+	var failures []string
+	for i, provider := range es.providers {
+		providerMessageID, err := provider.Send(message)
+		if err == nil {
+			return provider.Name(), providerMessageID, nil
+		}
+		if errors.Is(err, errEmailOutcomeUnknown) {
+			return provider.Name(), "", fmt.Errorf("%s: %w", provider.Name(), err)
+		}
+
+		failures = append(failures, fmt.Sprintf("%s: %v", provider.Name(), err))
+		if es.logger != nil && i < len(es.providers)-1 {
+			es.logger.Printf("Email provider %s failed, trying %s: %v", provider.Name(), es.providers[i+1].Name(), err)
+		}
+	}
+	return "", "", errors.New(strings.Join(failures, "; "))
+}
+
+// sendSMTP performs a single email send attempt over SMTP
+func (es *EmailService) sendSMTP(message *EmailMessage) error {
 	client, err := es.createSMTPClient()
 	if err != nil {
 		return fmt.Errorf("failed to create SMTP client: %w", err)
@@ -309,8 +474,14 @@ func (es *EmailService) sendEmailAttempt(message *EmailMessage) error {
 		return fmt.Errorf("failed to write email content: %w", err)
 	}
 
+	// The server only takes the message once it answers the end of the data, so a lost
+	// answer leaves the outcome unknown, while a reply code is a rejection
 	if err := writer.Close(); err != nil {
-		return fmt.Errorf("failed to close email data transfer: %w", err)
+		var smtpErr *textproto.Error
+		if errors.As(err, &smtpErr) {
+			return fmt.Errorf("failed to close email data transfer: %w", err)
+		}
+		return fmt.Errorf("failed to close email data transfer: %v: %w", err, errEmailOutcomeUnknown)
 	}
 
 	return nil
@@ -367,6 +538,17 @@ func (es *EmailService) loadLogoAsBase64() (string, error) {
 
 // SendTemplatedEmail sends an email using a template
 func (es *EmailService) SendTemplatedEmail(to []string, templateName string, data map[string]any) error {
+	return es.SendTemplatedEmailWithAttachments(to, templateName, data, nil)
+}
+
+// SendTemplatedEmailWithAttachments sends an email using a template, with files attached
+func (es *EmailService) SendTemplatedEmailWithAttachments(to []string, templateName string, data map[string]any, attachments []EmailAttachment) error {
+	return es.sendTemplatedEmail(to, templateName, data, attachments, nil)
+}
+
+// sendTemplatedEmail renders a template and sends it. resentFromID links a resend to the
+// delivery it repeats.
+func (es *EmailService) sendTemplatedEmail(to []string, templateName string, data map[string]any, attachments []EmailAttachment, resentFromID *uint) error {
 	template, exists := es.templates[templateName]
 	if !exists {
 		return fmt.Errorf("template '%s' not found", templateName)
@@ -376,9 +558,13 @@ func (es *EmailService) SendTemplatedEmail(to []string, templateName string, dat
 	if data == nil {
 		data = make(map[string]any)
 	}
+	// The caller's data is what a resend renders the template with again
+	templateData := maps.Clone(data)
+	locale := emailLocale(data)
+	data["Locale"] = locale.String()
 	data["ServerURL"] = FRONTEND
 	data["Email"] = strings.Join(to, ", ")
-	data["Date"] = time.Now().Format("January 2, 2006 at 3:04 PM")
+	data["Date"] = locale.DateTime(time.Now())
 
 	// Load and add logo as base64
 	logoBase64, err := es.loadLogoAsBase64()
@@ -391,39 +577,33 @@ func (es *EmailService) SendTemplatedEmail(to []string, templateName string, dat
 	}
 	data["LogoBase64"] = logoBase64
 
-	// Render templates
-	subject, err := es.renderTemplate(template.Subject, data)
+	// Render templates, preferring the admin edited revision when one is active
+	subject, htmlBody, textBody, err := es.renderEmailParts(templateName, locale.Language, template, data)
 	if err != nil {
-		return fmt.Errorf("failed to render subject template: %w", err)
-	}
-
-	b, _ := json.MarshalIndent(data, "", "  ")
-	fmt.Println(string(b))
-
-	htmlBody, err := es.renderTemplate(template.HTMLContent, data)
-	if err != nil {
-		return fmt.Errorf("failed to render HTML template: %w", err)
-	}
-
-	textBody, err := es.renderTemplate(template.TextContent, data)
-	if err != nil {
-		return fmt.Errorf("failed to render text template: %w", err)
+		return err
 	}
 
 	message := &EmailMessage{
-		To:       to,
-		Subject:  subject,
-		Body:     textBody,
-		HTMLBody: htmlBody,
-		Priority: PriorityNormal,
+		To:           to,
+		Subject:      subject,
+		Body:         textBody,
+		HTMLBody:     htmlBody,
+		Attachments:  attachments,
+		Priority:     PriorityNormal,
+		Template:     templateName,
+		TemplateData: templateData,
+		Locale:       locale.String(),
+		ResentFromID: resentFromID,
+		CampaignID:   emailCampaignID(data),
 	}
 
 	return es.SendEmail(message)
 }
 
 // SendWelcomeEmail sends a welcome email to new users
-func (es *EmailService) SendWelcomeEmail(to, userName, token string) error {
+func (es *EmailService) SendWelcomeEmail(to, userName, token, locale string) error {
 	data := map[string]any{
+		"Locale":   locale,
 		"UserName": userName,
 		"Token":    token,
 	}
@@ -431,8 +611,9 @@ func (es *EmailService) SendWelcomeEmail(to, userName, token string) error {
 }
 
 // SendEmailVerification sends an email verification email
-func (es *EmailService) SendEmailVerification(to, userName, verificationToken string) error {
+func (es *EmailService) SendEmailVerification(to, userName, verificationToken, locale string) error {
 	data := map[string]any{
+		"Locale":            locale,
 		"UserName":          userName,
 		"VerificationToken": verificationToken,
 	}
@@ -440,35 +621,38 @@ func (es *EmailService) SendEmailVerification(to, userName, verificationToken st
 }
 
 // SendPasswordResetEmail sends a password reset email
-func (es *EmailService) SendPasswordResetEmail(to string, resetToken string) error {
+func (es *EmailService) SendPasswordResetEmail(to, resetToken, locale string) error {
 	data := map[string]any{
+		"Locale":     locale,
 		"ResetToken": resetToken,
 	}
 	return es.SendTemplatedEmail([]string{to}, "password_reset", data)
 }
 
-func (es *EmailService) SendTransactionApprovedEmail(to string, userName string, transaction models.Transaction) error {
+func (es *EmailService) SendTransactionApprovedEmail(to string, userName string, transaction models.Transaction, locale string) error {
 	// Get dynamic data based on transaction type
 	dynamicData := templates.GetApprovedTransactionData(string(transaction.TransactionType), transaction)
 
+	l := i18n.Parse(locale)
 	data := map[string]any{
+		"Locale":          locale,
 		"UserName":        userName,
 		"Email":           to,
 		"TransactionID":   transaction.TransactionID,
 		"TransactionType": transaction.TransactionType,
-		"Amount":          utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
-		"Date":            transaction.CreatedAt.Format("January 2, 2006 at 3:04 PM"),
+		"Amount":          l.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		"Date":            l.DateTime(transaction.CreatedAt),
 		"ServerURL":       FRONTEND,
 		"RecipientName":   transaction.TransactionDetails.RecipientName,
 		"BankName":        transaction.TransactionDetails.BankName,
 		"AccountNumber":   transaction.TransactionDetails.AccountNumber,
 		"PhoneNumber":     transaction.TransactionDetails.PhoneNumber,
 		"Network":         transaction.TransactionDetails.Network,
-		"FromAmount":      utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
-		"ToAmount":        utils.FormatCurrency(transaction.TransactionDetails.ToAmount, transaction.TransactionDetails.ToCurrency),
+		"FromAmount":      l.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		"ToAmount":        l.Currency(transaction.TransactionDetails.ToAmount, transaction.TransactionDetails.ToCurrency),
 		"FromCurrency":    transaction.TransactionDetails.FromCurrency,
 		"ToCurrency":      transaction.TransactionDetails.ToCurrency,
-		"ExchangeRate":    fmt.Sprintf("1 %s = %.4f %s", transaction.TransactionDetails.FromCurrency, transaction.TransactionDetails.ToAmount/transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.ToCurrency),
+		"ExchangeRate":    fmt.Sprintf("1 %s = %s %s", transaction.TransactionDetails.FromCurrency, l.Number(transaction.TransactionDetails.ToAmount/transaction.TransactionDetails.FromAmount, 4), transaction.TransactionDetails.ToCurrency),
 	}
 
 	// Merge dynamic data
@@ -476,23 +660,28 @@ func (es *EmailService) SendTransactionApprovedEmail(to string, userName string,
 		data[key] = value
 	}
 
-	return es.SendTemplatedEmail([]string{to}, "transaction_approved", data)
+	attachments, receiptURL := transactionReceiptAttachment(transaction.TransactionID)
+	data["ReceiptURL"] = receiptURL
+
+	return es.SendTemplatedEmailWithAttachments([]string{to}, "transaction_approved", data, attachments)
 }
 
-func (es *EmailService) SendTransactionRejectedEmail(to string, userName string, transaction models.Transaction, reason string) error {
+func (es *EmailService) SendTransactionRejectedEmail(to string, userName string, transaction models.Transaction, reason, locale string) error {
 	// Get dynamic data based on transaction type
 	dynamicData := templates.GetRejectedTransactionData(string(transaction.TransactionType), transaction)
 
 	// Get user-friendly reason
 	friendlyReason := templates.GetUserFriendlyRejectionReason(reason, string(transaction.TransactionType))
 
+	l := i18n.Parse(locale)
 	data := map[string]any{
+		"Locale":          locale,
 		"UserName":        userName,
 		"Email":           to,
 		"TransactionID":   transaction.TransactionID,
 		"TransactionType": transaction.TransactionType,
-		"Amount":          utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
-		"Date":            transaction.CreatedAt.Format("January 2, 2006 at 3:04 PM"),
+		"Amount":          l.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		"Date":            l.DateTime(transaction.CreatedAt),
 		"Reason":          friendlyReason,
 		"ServerURL":       FRONTEND,
 		"RecipientName":   transaction.TransactionDetails.RecipientName,
@@ -500,11 +689,11 @@ func (es *EmailService) SendTransactionRejectedEmail(to string, userName string,
 		"AccountNumber":   transaction.TransactionDetails.AccountNumber,
 		"PhoneNumber":     transaction.TransactionDetails.PhoneNumber,
 		"Network":         transaction.TransactionDetails.Network,
-		"FromAmount":      utils.FormatCurrency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
-		"ToAmount":        utils.FormatCurrency(transaction.TransactionDetails.ToAmount, transaction.TransactionDetails.ToCurrency),
+		"FromAmount":      l.Currency(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		"ToAmount":        l.Currency(transaction.TransactionDetails.ToAmount, transaction.TransactionDetails.ToCurrency),
 		"FromCurrency":    transaction.TransactionDetails.FromCurrency,
 		"ToCurrency":      transaction.TransactionDetails.ToCurrency,
-		"ExchangeRate":    fmt.Sprintf("1 %s = %.4f %s", transaction.TransactionDetails.FromCurrency, transaction.TransactionDetails.ToAmount/transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.ToCurrency),
+		"ExchangeRate":    fmt.Sprintf("1 %s = %s %s", transaction.TransactionDetails.FromCurrency, l.Number(transaction.TransactionDetails.ToAmount/transaction.TransactionDetails.FromAmount, 4), transaction.TransactionDetails.ToCurrency),
 	}
 
 	// Merge dynamic data
@@ -515,6 +704,61 @@ func (es *EmailService) SendTransactionRejectedEmail(to string, userName string,
 	return es.SendTemplatedEmail([]string{to}, "transaction_rejected", data)
 }
 
+// renderEmailParts renders the active revision of a template in the reader's language, falling
+// back to the built-in template when the revision cannot be rendered with the data it was given
+func (es *EmailService) renderEmailParts(name, language string, builtIn *EmailTemplate, data map[string]any) (string, string, string, error) {
+	if override, ok := activeEmailTemplate(name, language); ok {
+		if missing := missingTemplateVariables(override.variables, data); len(missing) > 0 {
+			log.Printf("Email template %s version %d is missing %s, sending the built-in template", name, override.version, strings.Join(missing, ", "))
+		} else if subject, htmlBody, textBody, err := es.renderTemplateParts(override.template, data); err != nil {
+			log.Printf("Email template %s version %d failed to render, sending the built-in template: %v", name, override.version, err)
+		} else {
+			return subject, htmlBody, textBody, nil
+		}
+	}
+	return es.renderTemplateParts(builtIn, data)
+}
+
+// emailLocale reads the recipient's locale from template data, defaulting when it is absent
+// emailCampaignID reads the broadcast a campaign email belongs to. Like the locale it travels
+// in the template data, where a queued job has turned it into a float.
+func emailCampaignID(data map[string]any) uint {
+	switch id := data["CampaignID"].(type) {
+	case float64:
+		return uint(id)
+	case uint:
+		return id
+	}
+	return 0
+}
+
+func emailLocale(data map[string]any) i18n.Locale {
+	if tag, ok := data["Locale"].(string); ok && tag != "" {
+		return i18n.Parse(tag)
+	}
+	return i18n.Default
+}
+
+// renderTemplateParts renders the subject, HTML and text of a template
+func (es *EmailService) renderTemplateParts(template *EmailTemplate, data map[string]any) (string, string, string, error) {
+	subject, err := es.renderTemplate(template.Subject, data)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to render subject template: %w", err)
+	}
+
+	htmlBody, err := es.renderTemplate(template.HTMLContent, data)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to render HTML template: %w", err)
+	}
+
+	textBody, err := es.renderTemplate(template.TextContent, data)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to render text template: %w", err)
+	}
+
+	return subject, htmlBody, textBody, nil
+}
+
 // renderTemplate renders a template with the given data
 func (es *EmailService) renderTemplate(templateContent string, data map[string]any) (string, error) {
 	tmpl, err := template.New("email").Parse(templateContent)
@@ -531,8 +775,9 @@ func (es *EmailService) renderTemplate(templateContent string, data map[string]a
 }
 
 // Send TwoFactorAuthenticationEmail sends a 2FA email
-func (es *EmailService) SendTwoFactorAuthenticationEmail(to, userName, verificationCode string) error {
+func (es *EmailService) SendTwoFactorAuthenticationEmail(to, userName, verificationCode, locale string) error {
 	data := map[string]any{
+		"Locale":           locale,
 		"UserName":         userName,
 		"VerificationCode": verificationCode,
 	}
@@ -650,6 +895,53 @@ func (es *EmailService) buildEmailContent(message *EmailMessage) string {
 	// Add date header
 	content.WriteString(fmt.Sprintf("Date: %s\r\n", time.Now().Format(time.RFC1123Z)))
 
+	if len(message.Attachments) == 0 {
+		es.writeEmailBody(&content, message)
+		return content.String()
+	}
+
+	// Attachments wrap the body in a multipart/mixed envelope
+	mixedBoundary := fmt.Sprintf("mixed_%d", time.Now().UnixNano())
+	content.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=%s\r\n\r\n", mixedBoundary))
+
+	content.WriteString(fmt.Sprintf("--%s\r\n", mixedBoundary))
+	es.writeEmailBody(&content, message)
+	content.WriteString("\r\n")
+
+	for _, attachment := range message.Attachments {
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		disposition := "attachment"
+		if attachment.Inline {
+			disposition = "inline"
+		}
+
+		content.WriteString(fmt.Sprintf("--%s\r\n", mixedBoundary))
+		content.WriteString(fmt.Sprintf("Content-Type: %s; name=%q\r\n", contentType, attachment.Filename))
+		content.WriteString("Content-Transfer-Encoding: base64\r\n")
+		content.WriteString(fmt.Sprintf("Content-Disposition: %s; filename=%q\r\n", disposition, attachment.Filename))
+		if attachment.ContentID != "" {
+			content.WriteString(fmt.Sprintf("Content-ID: <%s>\r\n", attachment.ContentID))
+		}
+		content.WriteString("\r\n")
+
+		// Base64 lines are limited to 76 characters
+		encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+		for len(encoded) > 76 {
+			content.WriteString(encoded[:76] + "\r\n")
+			encoded = encoded[76:]
+		}
+		content.WriteString(encoded + "\r\n")
+	}
+
+	content.WriteString(fmt.Sprintf("--%s--\r\n", mixedBoundary))
+	return content.String()
+}
+
+// writeEmailBody writes the content type headers and the text and HTML bodies of a message
+func (es *EmailService) writeEmailBody(content *strings.Builder, message *EmailMessage) {
 	// Determine content type
 	if message.HTMLBody != "" && message.Body != "" {
 		// Mixed content (both HTML and plain text)
@@ -682,8 +974,6 @@ func (es *EmailService) buildEmailContent(message *EmailMessage) string {
 		content.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
 		content.WriteString(message.Body)
 	}
-
-	return content.String()
 }
 
 // TestConnection tests the SMTP connection
@@ -771,34 +1061,43 @@ func getEnvBoolOrDefault(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
-// GetEmailStats returns statistics about email operations
+// EmailStats summarises the email delivery log
 type EmailStats struct {
-	TotalSent   int64
-	TotalFailed int64
-	LastSent    time.Time
-	LastError   string
-}
-
-// This is a placeholder for email statistics tracking
-// In a real implementation, you would store these in a database or cache
-var emailStats = &EmailStats{}
-
-func (es *EmailService) GetStats() *EmailStats {
-	return emailStats
-}
-
-// UpdateStats updates email statistics (placeholder implementation)
-// func (es *EmailService) updateStats(success bool, err error) {
-// 	if success {
-// 		emailStats.TotalSent++
-// 		emailStats.LastSent = time.Now()
-// 	} else {
-// 		emailStats.TotalFailed++
-// 		if err != nil {
-// 			emailStats.LastError = err.Error()
-// 		}
-// 	}
-// }
+	TotalSent       int64 // sent or since reported delivered
+	TotalFailed     int64
+	TotalBounced    int64
+	TotalComplained int64
+	TotalSuppressed int64
+	LastSent        time.Time
+	LastError       string
+}
+
+// GetStats summarises the email delivery log
+func (es *EmailService) GetStats() (*EmailStats, error) {
+	summary, err := GetEmailDeliveryStats()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &EmailStats{
+		TotalSent:       summary.ByStatus[string(models.EmailSent)] + summary.ByStatus[string(models.EmailDelivered)],
+		TotalFailed:     summary.ByStatus[string(models.EmailFailed)],
+		TotalBounced:    summary.ByStatus[string(models.EmailBounced)],
+		TotalComplained: summary.ByStatus[string(models.EmailComplained)],
+		TotalSuppressed: summary.ByStatus[string(models.EmailSuppressed)],
+	}
+	if summary.LastSent != nil {
+		stats.LastSent = *summary.LastSent
+	}
+
+	var lastFailed models.EmailDelivery
+	if err := database.DB.Where("status = ?", models.EmailFailed).Order("updated_at DESC").
+		Limit(1).Find(&lastFailed).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch last email error: %w", err)
+	}
+	stats.LastError = lastFailed.Error
+	return stats, nil
+}
 
 // Ensure EmailService implements the EmailSender interface
 var _ interfaces.EmailSender = (*EmailService)(nil)
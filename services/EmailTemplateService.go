@@ -0,0 +1,594 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+// emailTemplateGlobals are added to the data of every email by SendTemplatedEmailWithAttachments
+var emailTemplateGlobals = map[string]bool{
+	"ServerURL":  true,
+	"Email":      true,
+	"Date":       true,
+	"LogoBase64": true,
+	"Locale":     true,
+}
+
+var templateVariableName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// builtInEmailTemplates are the templates compiled into the binary, which are sent whenever no
+// admin edited revision is active
+var builtInEmailTemplates = sync.OnceValue(func() map[string]*EmailTemplate {
+	return NewEmailService(nil).templates
+})
+
+// emailTemplateOverride is the active admin edited revision of a template
+type emailTemplateOverride struct {
+	template  *EmailTemplate
+	version   int
+	variables []models.EmailTemplateVariable
+}
+
+var (
+	emailTemplateCacheMu       sync.Mutex
+	emailTemplateCache         map[string]emailTemplateOverride
+	emailTemplateCacheLoadedAt time.Time
+)
+
+// activeEmailTemplate returns the active revision of a template for the language, or the English
+// one when the language has none. Revisions are reloaded once they are older than
+// EmailTemplateCacheTTL so edits reach every process.
+func activeEmailTemplate(name, language string) (emailTemplateOverride, bool) {
+	if database.DB == nil {
+		return emailTemplateOverride{}, false
+	}
+
+	emailTemplateCacheMu.Lock()
+	defer emailTemplateCacheMu.Unlock()
+
+	if time.Since(emailTemplateCacheLoadedAt) > constants.EmailTemplateCacheTTL {
+		var versions []models.EmailTemplateVersion
+		if err := database.DB.Where("is_active = ?", true).Find(&versions).Error; err != nil {
+			// Keep sending the last known revisions until the database is back
+			log.Printf("Failed to load email templates: %v", err)
+		} else {
+			cache := make(map[string]emailTemplateOverride, len(versions))
+			for _, version := range versions {
+				cache[emailTemplateKey(version.Name, version.Locale)] = toEmailTemplateOverride(version)
+			}
+			emailTemplateCache = cache
+		}
+		emailTemplateCacheLoadedAt = time.Now()
+	}
+
+	if override, ok := emailTemplateCache[emailTemplateKey(name, language)]; ok {
+		return override, true
+	}
+	override, ok := emailTemplateCache[emailTemplateKey(name, i18n.DefaultLanguage)]
+	return override, ok
+}
+
+func emailTemplateKey(name, language string) string {
+	return name + ":" + language
+}
+
+// emailTemplateLanguage validates the language of a template request, defaulting to English
+func emailTemplateLanguage(language string) (string, error) {
+	if language == "" {
+		return i18n.DefaultLanguage, nil
+	}
+	language = strings.ToLower(language)
+	if !i18n.Supported(language) {
+		return "", i18n.NewError("error.unsupported_language", i18n.Args{"language": language, "languages": strings.Join(i18n.Languages(), ", ")})
+	}
+	return language, nil
+}
+
+// invalidateEmailTemplateCache makes this process pick up template changes on the next send
+func invalidateEmailTemplateCache() {
+	emailTemplateCacheMu.Lock()
+	emailTemplateCacheLoadedAt = time.Time{}
+	emailTemplateCacheMu.Unlock()
+}
+
+// AdminGetEmailTemplates lists every template with the revision currently sent
+func AdminGetEmailTemplates() ([]types.EmailTemplateSummary, error) {
+	var versions []models.EmailTemplateVersion
+	if err := database.DB.Order("version ASC").Find(&versions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch email templates: %w", err)
+	}
+
+	builtIn := builtInEmailTemplates()
+	summaries := make(map[string]*types.EmailTemplateSummary, len(builtIn))
+	for name, template := range builtIn {
+		summaries[name] = &types.EmailTemplateSummary{Name: name, Subject: template.Subject, ActiveVersions: map[string]int{}}
+	}
+	for _, version := range versions {
+		summary, ok := summaries[version.Name]
+		if !ok {
+			continue
+		}
+		summary.LatestVersion = version.Version
+		if !version.IsActive {
+			continue
+		}
+		summary.ActiveVersions[version.Locale] = version.Version
+		if updatedAt := version.CreatedAt; summary.UpdatedAt == nil || updatedAt.After(*summary.UpdatedAt) {
+			summary.UpdatedAt = &updatedAt
+		}
+		if version.Locale == i18n.DefaultLanguage {
+			summary.ActiveVersion = version.Version
+			summary.Subject = version.Subject
+		}
+	}
+
+	response := make([]types.EmailTemplateSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		response = append(response, *summary)
+	}
+	sort.Slice(response, func(i, j int) bool { return response[i].Name < response[j].Name })
+	return response, nil
+}
+
+// AdminGetEmailTemplate returns the built-in template and every saved revision, newest first
+func AdminGetEmailTemplate(name string) (*types.EmailTemplateDetailResponse, error) {
+	builtIn, ok := builtInEmailTemplates()[name]
+	if !ok {
+		return nil, i18n.NewError("error.email_template_not_found", nil)
+	}
+
+	var versions []models.EmailTemplateVersion
+	if err := database.DB.Where("name = ?", name).Order("version DESC").Find(&versions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch email template versions: %w", err)
+	}
+
+	response := &types.EmailTemplateDetailResponse{
+		Name:           name,
+		ActiveVersions: map[string]int{},
+		BuiltIn:        builtInVersionResponse(builtIn),
+		Versions:       make([]types.EmailTemplateVersionResponse, 0, len(versions)),
+	}
+	for _, version := range versions {
+		if version.IsActive {
+			response.ActiveVersions[version.Locale] = version.Version
+			if version.Locale == i18n.DefaultLanguage {
+				response.ActiveVersion = version.Version
+			}
+		}
+		response.Versions = append(response.Versions, toEmailTemplateVersionResponse(version))
+	}
+	response.BuiltIn.IsActive = response.ActiveVersion == 0
+	return response, nil
+}
+
+// AdminCreateEmailTemplateVersion saves a new revision of a template, optionally making it the
+// one sent to readers of its language. The content must parse and declare every variable it uses.
+func AdminCreateEmailTemplateVersion(name string, req types.CreateEmailTemplateVersionRequest, actor types.AdminActor) (*types.EmailTemplateVersionResponse, error) {
+	if _, ok := builtInEmailTemplates()[name]; !ok {
+		return nil, i18n.NewError("error.email_template_not_found", nil)
+	}
+	language, err := emailTemplateLanguage(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := toEmailTemplateVariables(req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	template := &EmailTemplate{Name: name, Subject: req.Subject, HTMLContent: req.HTMLContent, TextContent: req.TextContent}
+	if err := validateEmailTemplate(template, variables); err != nil {
+		return nil, err
+	}
+
+	variablesJSON, err := json.Marshal(variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template variables: %w", err)
+	}
+
+	version := models.EmailTemplateVersion{
+		Name:        name,
+		Locale:      language,
+		Subject:     req.Subject,
+		HTMLContent: req.HTMLContent,
+		TextContent: req.TextContent,
+		Variables:   string(variablesJSON),
+		IsActive:    req.Activate,
+		Note:        req.Note,
+		CreatedBy:   actor.ID,
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var latest int
+		if err := tx.Model(&models.EmailTemplateVersion{}).Unscoped().Where("name = ?", name).
+			Select("COALESCE(MAX(version), 0)").Scan(&latest).Error; err != nil {
+			return fmt.Errorf("failed to fetch latest template version: %w", err)
+		}
+		version.Version = latest + 1
+
+		if req.Activate {
+			if err := tx.Model(&models.EmailTemplateVersion{}).Where("name = ? AND locale = ? AND is_active = ?", name, language, true).
+				Update("is_active", false).Error; err != nil {
+				return fmt.Errorf("failed to deactivate template versions: %w", err)
+			}
+		}
+		if err := tx.Create(&version).Error; err != nil {
+			return fmt.Errorf("failed to save template version: %w", err)
+		}
+
+		details := fmt.Sprintf("Email template %s version %d (%s) saved", name, version.Version, language)
+		if req.Activate {
+			details += " and activated"
+		}
+		adminLog := actor.NewLog("CREATE_EMAIL_TEMPLATE_VERSION", "email_template", name, details)
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateEmailTemplateCache()
+	response := toEmailTemplateVersionResponse(version)
+	return &response, nil
+}
+
+// AdminRollbackEmailTemplate makes an earlier revision the one sent to readers of a language.
+// Version 0 goes back to the built-in template, or the English revision for other languages.
+func AdminRollbackEmailTemplate(name string, req types.RollbackEmailTemplateRequest, actor types.AdminActor) (*types.EmailTemplateDetailResponse, error) {
+	if _, ok := builtInEmailTemplates()[name]; !ok {
+		return nil, i18n.NewError("error.email_template_not_found", nil)
+	}
+	language, err := emailTemplateLanguage(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	target := *req.Version
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var previous models.EmailTemplateVersion
+		if err := tx.Where("name = ? AND locale = ? AND is_active = ?", name, language, true).First(&previous).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to fetch active template version: %w", err)
+		}
+		if previous.Version == target {
+			return i18n.NewError("error.template_version_active", nil)
+		}
+
+		if target > 0 {
+			var exists int64
+			if err := tx.Model(&models.EmailTemplateVersion{}).Where("name = ? AND locale = ? AND version = ?", name, language, target).Count(&exists).Error; err != nil {
+				return fmt.Errorf("failed to fetch template version: %w", err)
+			}
+			if exists == 0 {
+				return i18n.NewError("error.template_version_not_found", nil)
+			}
+		}
+
+		if err := tx.Model(&models.EmailTemplateVersion{}).Where("name = ? AND locale = ? AND is_active = ?", name, language, true).
+			Update("is_active", false).Error; err != nil {
+			return fmt.Errorf("failed to deactivate template versions: %w", err)
+		}
+		if target > 0 {
+			if err := tx.Model(&models.EmailTemplateVersion{}).Where("name = ? AND version = ?", name, target).
+				Update("is_active", true).Error; err != nil {
+				return fmt.Errorf("failed to activate template version: %w", err)
+			}
+		}
+
+		details := fmt.Sprintf("Email template %s (%s) rolled back from version %d to version %d", name, language, previous.Version, target)
+		if target == 0 {
+			details = fmt.Sprintf("Email template %s (%s) restored to the built-in template", name, language)
+		}
+		adminLog := actor.NewChangeLog("ROLLBACK_EMAIL_TEMPLATE", "email_template", name, details,
+			map[string]int{"version": previous.Version}, map[string]int{"version": target})
+		return tx.Create(&adminLog).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateEmailTemplateCache()
+	return AdminGetEmailTemplate(name)
+}
+
+// AdminPreviewEmailTemplate renders a template with the declared sample values, overridden by
+// any data in the request. Variables with neither show as [Name].
+func AdminPreviewEmailTemplate(name string, req types.PreviewEmailTemplateRequest) (*types.EmailTemplatePreviewResponse, error) {
+	builtIn, ok := builtInEmailTemplates()[name]
+	if !ok {
+		return nil, i18n.NewError("error.email_template_not_found", nil)
+	}
+	language, err := emailTemplateLanguage(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	locale := i18n.NewLocale(language, "")
+
+	var template *EmailTemplate
+	var variables []models.EmailTemplateVariable
+	switch {
+	case req.HTMLContent != "":
+		template = &EmailTemplate{Name: name, Subject: req.Subject, HTMLContent: req.HTMLContent, TextContent: req.TextContent}
+		if template.Subject == "" {
+			template.Subject = builtIn.Subject
+		}
+	case req.Version == nil:
+		template = builtIn
+		if override, ok := activeEmailTemplate(name, language); ok {
+			template, variables = override.template, override.variables
+		}
+	case *req.Version == 0:
+		template = builtIn
+	default:
+		var version models.EmailTemplateVersion
+		if err := database.DB.Where("name = ? AND version = ?", name, *req.Version).First(&version).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, i18n.NewError("error.template_version_not_found", nil)
+			}
+			return nil, fmt.Errorf("failed to fetch template version: %w", err)
+		}
+		override := toEmailTemplateOverride(version)
+		template, variables = override.template, override.variables
+	}
+
+	fields, err := emailTemplateFields(template)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		if variable.Sample != "" {
+			samples[variable.Name] = variable.Sample
+		}
+	}
+
+	data := map[string]any{
+		"ServerURL":  FRONTEND,
+		"Email":      "preview@jeanpay.africa",
+		"Date":       locale.DateTime(time.Now()),
+		"LogoBase64": "",
+		"Locale":     locale.String(),
+	}
+	response := &types.EmailTemplatePreviewResponse{MissingVariables: []string{}}
+	for _, field := range fields {
+		if emailTemplateGlobals[field] {
+			continue
+		}
+		if value, ok := req.Data[field]; ok {
+			data[field] = value
+		} else if sample, ok := samples[field]; ok {
+			data[field] = sample
+		} else {
+			data[field] = "[" + field + "]"
+			response.MissingVariables = append(response.MissingVariables, field)
+		}
+	}
+
+	es := &EmailService{}
+	if response.Subject, err = es.renderTemplate(template.Subject, data); err != nil {
+		return nil, fmt.Errorf("failed to render subject: %w", err)
+	}
+	if response.HTML, err = es.renderTemplate(template.HTMLContent, data); err != nil {
+		return nil, fmt.Errorf("failed to render HTML: %w", err)
+	}
+	if response.Text, err = es.renderTemplate(template.TextContent, data); err != nil {
+		return nil, fmt.Errorf("failed to render text: %w", err)
+	}
+	return response, nil
+}
+
+// validateEmailTemplate checks that a revision parses and only uses declared variables
+func validateEmailTemplate(template *EmailTemplate, variables []models.EmailTemplateVariable) error {
+	fields, err := emailTemplateFields(template)
+	if err != nil {
+		return err
+	}
+
+	declared := make(map[string]bool, len(variables))
+	for _, variable := range variables {
+		declared[variable.Name] = true
+	}
+	var undeclared []string
+	for _, field := range fields {
+		if !declared[field] && !emailTemplateGlobals[field] {
+			undeclared = append(undeclared, field)
+		}
+	}
+	if len(undeclared) > 0 {
+		return i18n.NewError("error.template_undeclared_variables", i18n.Args{"variables": strings.Join(undeclared, ", ")})
+	}
+	return nil
+}
+
+// emailTemplateFields parses each part of a template and returns the top-level fields it reads
+func emailTemplateFields(template *EmailTemplate) ([]string, error) {
+	fields := make(map[string]bool)
+	parts := []struct{ name, content string }{
+		{"subject", template.Subject},
+		{"HTML", template.HTMLContent},
+		{"text", template.TextContent},
+	}
+	for _, part := range parts {
+		tmpl, err := texttemplate.New(part.name).Parse(part.content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s template: %w", part.name, err)
+		}
+		if tmpl.Tree != nil {
+			collectTemplateFields(tmpl.Tree.Root, fields)
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// collectTemplateFields walks a parse tree for fields of the template's data. Inside range and
+// with blocks the dot is something else, so only their pipelines and else branches are read.
+func collectTemplateFields(node parse.Node, fields map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateFields(child, fields)
+		}
+	case *parse.ActionNode:
+		collectTemplateFields(n.Pipe, fields)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectTemplateFields(cmd, fields)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectTemplateFields(arg, fields)
+		}
+	case *parse.FieldNode:
+		fields[n.Ident[0]] = true
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			fields[n.Ident[1]] = true
+		}
+	case *parse.ChainNode:
+		collectTemplateFields(n.Node, fields)
+	case *parse.IfNode:
+		collectTemplateFields(n.Pipe, fields)
+		collectTemplateFields(n.List, fields)
+		collectTemplateFields(n.ElseList, fields)
+	case *parse.RangeNode:
+		collectTemplateFields(n.Pipe, fields)
+		collectTemplateFields(n.ElseList, fields)
+	case *parse.WithNode:
+		collectTemplateFields(n.Pipe, fields)
+		collectTemplateFields(n.ElseList, fields)
+	case *parse.TemplateNode:
+		collectTemplateFields(n.Pipe, fields)
+	}
+}
+
+// missingTemplateVariables lists required variables the data does not provide
+func missingTemplateVariables(variables []models.EmailTemplateVariable, data map[string]any) []string {
+	var missing []string
+	for _, variable := range variables {
+		if _, ok := data[variable.Name]; variable.Required && !ok {
+			missing = append(missing, variable.Name)
+		}
+	}
+	return missing
+}
+
+func toEmailTemplateVariables(req []types.EmailTemplateVariableRequest) ([]models.EmailTemplateVariable, error) {
+	seen := make(map[string]bool, len(req))
+	variables := make([]models.EmailTemplateVariable, 0, len(req))
+	for _, variable := range req {
+		if !templateVariableName.MatchString(variable.Name) {
+			return nil, i18n.NewError("error.template_variable_invalid", i18n.Args{"name": variable.Name})
+		}
+		if seen[variable.Name] {
+			return nil, i18n.NewError("error.template_variable_duplicate", i18n.Args{"name": variable.Name})
+		}
+		seen[variable.Name] = true
+		variables = append(variables, models.EmailTemplateVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Required:    variable.Required,
+			Sample:      variable.Sample,
+		})
+	}
+	return variables, nil
+}
+
+func toEmailTemplateOverride(version models.EmailTemplateVersion) emailTemplateOverride {
+	var variables []models.EmailTemplateVariable
+	if version.Variables != "" {
+		if err := json.Unmarshal([]byte(version.Variables), &variables); err != nil {
+			log.Printf("Failed to decode variables of email template %s version %d: %v", version.Name, version.Version, err)
+		}
+	}
+	return emailTemplateOverride{
+		template: &EmailTemplate{
+			Name:        version.Name,
+			Subject:     version.Subject,
+			HTMLContent: version.HTMLContent,
+			TextContent: version.TextContent,
+		},
+		version:   version.Version,
+		variables: variables,
+	}
+}
+
+func toEmailTemplateVersionResponse(version models.EmailTemplateVersion) types.EmailTemplateVersionResponse {
+	override := toEmailTemplateOverride(version)
+	return types.EmailTemplateVersionResponse{
+		Version:     version.Version,
+		Locale:      version.Locale,
+		Subject:     version.Subject,
+		HTMLContent: version.HTMLContent,
+		TextContent: version.TextContent,
+		Variables:   toEmailTemplateVariableResponses(override.variables),
+		IsActive:    version.IsActive,
+		Note:        version.Note,
+		CreatedBy:   version.CreatedBy,
+		CreatedAt:   version.CreatedAt,
+	}
+}
+
+// builtInVersionResponse describes a built-in template as version 0, declaring every field it
+// reads so it can be used as the starting point of a new revision
+func builtInVersionResponse(template *EmailTemplate) types.EmailTemplateVersionResponse {
+	response := types.EmailTemplateVersionResponse{
+		Locale:      i18n.DefaultLanguage,
+		Subject:     template.Subject,
+		HTMLContent: template.HTMLContent,
+		TextContent: template.TextContent,
+		Variables:   []types.EmailTemplateVariableResponse{},
+		Note:        "Built-in template",
+	}
+	fields, err := emailTemplateFields(template)
+	if err != nil {
+		log.Printf("Failed to parse built-in email template %s: %v", template.Name, err)
+		return response
+	}
+	for _, field := range fields {
+		if !emailTemplateGlobals[field] {
+			response.Variables = append(response.Variables, types.EmailTemplateVariableResponse{Name: field})
+		}
+	}
+	return response
+}
+
+func toEmailTemplateVariableResponses(variables []models.EmailTemplateVariable) []types.EmailTemplateVariableResponse {
+	response := make([]types.EmailTemplateVariableResponse, 0, len(variables))
+	for _, variable := range variables {
+		response = append(response, types.EmailTemplateVariableResponse{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Required:    variable.Required,
+			Sample:      variable.Sample,
+		})
+	}
+	return response
+}
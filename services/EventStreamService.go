@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/go-redis/redis/v8"
+)
+
+// UserEventStream is an open subscription to one user's real-time events
+type UserEventStream struct {
+	// Backlog holds the events missed since the client's last event ID, oldest first
+	Backlog []utils.UserEvent
+	// UnreadCount is the user's unread notification count when the stream opened
+	UnreadCount int64
+
+	pubsub *redis.PubSub
+	lastID string
+}
+
+// OpenUserEventStream subscribes to the user's events. When lastEventID is set, events
+// published after it are loaded into Backlog so a reconnecting client can catch up.
+func OpenUserEventStream(ctx context.Context, userID uint, lastEventID string) (*UserEventStream, error) {
+	// Subscribe before reading the backlog so nothing published in between is lost
+	pubsub, err := utils.SubscribeUserEvents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &UserEventStream{pubsub: pubsub, lastID: lastEventID}
+	if lastEventID != "" {
+		backlog, err := utils.UserEventsSince(ctx, userID, lastEventID)
+		if err != nil {
+			pubsub.Close()
+			return nil, err
+		}
+		stream.Backlog = backlog
+		if len(backlog) > 0 {
+			stream.lastID = backlog[len(backlog)-1].ID
+		}
+	}
+
+	count, err := GetUnreadNotificationCountService(userID)
+	if err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	stream.UnreadCount = count
+
+	return stream, nil
+}
+
+// Next waits for the next live event. It returns ok false when the heartbeat interval passes
+// without one, and an error once ctx is done or the subscription closes.
+func (s *UserEventStream) Next(ctx context.Context) (event utils.UserEvent, ok bool, err error) {
+	heartbeat := time.NewTimer(constants.EventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return event, false, ctx.Err()
+		case <-heartbeat.C:
+			return event, false, nil
+		case message, open := <-s.pubsub.Channel():
+			if !open {
+				return event, false, errors.New("event subscription closed")
+			}
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				log.Printf("Skipping malformed user event: %v", err)
+				continue
+			}
+			// Events already sent from the backlog can arrive again on the live channel
+			if s.lastID != "" && !utils.EventIDAfter(event.ID, s.lastID) {
+				continue
+			}
+			s.lastID = event.ID
+			return event, true, nil
+		}
+	}
+}
+
+// Close ends the subscription
+func (s *UserEventStream) Close() error {
+	return s.pubsub.Close()
+}
+
+// publishTransactionStatus tells the transaction's owner its status changed
+func publishTransactionStatus(transaction models.Transaction, status models.TransactionStatus) {
+	if err := utils.PublishUserEvent(transaction.UserID, constants.EventTransactionStatus, types.TransactionStatusEvent{
+		TransactionID:   transaction.TransactionID,
+		Reference:       transaction.Reference,
+		TransactionType: string(transaction.TransactionType),
+		Direction:       string(transaction.Direction),
+		Status:          string(status),
+		UpdatedAt:       time.Now(),
+	}); err != nil {
+		log.Printf("Failed to publish status of transaction %s: %v", transaction.TransactionID, err)
+	}
+}
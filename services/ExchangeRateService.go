@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+// ErrRateFollowUpNotQueued means a rate was activated but the job that fills limit orders and
+// fires rate alerts for it could not be queued
+var ErrRateFollowUpNotQueued = i18n.NewError("error.rate_follow_up_not_queued", nil)
+
+// rateSeriesRanges maps each chart range to the interval its candles cover
+var rateSeriesRanges = map[string]struct {
+	span     time.Duration
+	interval time.Duration
+	label    string
+}{
+	"24h": {24 * time.Hour, time.Hour, "1h"},
+	"7d":  {7 * 24 * time.Hour, 4 * time.Hour, "4h"},
+	"30d": {30 * 24 * time.Hour, 24 * time.Hour, "1d"},
+	"1y":  {364 * 24 * time.Hour, 7 * 24 * time.Hour, "1w"},
+}
+
+// ActivateExchangeRate makes rate the one conversions use for its pair, retiring the previous
+// active rate, and queues the work that reacts to rate changes. If that work cannot be queued
+// the rate stays active, ErrRateFollowUpNotQueued is returned and the follow-up sweep queues
+// it later.
+// rateID links the row to the admin rate that published it, if any.
+func ActivateExchangeRate(fromCurrency, toCurrency string, rate float64, source models.ExchangeRateSource, setBy string, rateID *uint) (models.ExchangeRate, error) {
+	now := time.Now()
+	exchangeRate := models.ExchangeRate{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         rate,
+		Source:       source,
+		SetBy:        setBy,
+		RateID:       rateID,
+		IsActive:     true,
+		ValidFrom:    now,
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&models.ExchangeRate{}).
+		Where("from_currency = ? AND to_currency = ? AND is_active = ?", fromCurrency, toCurrency, true).
+		Updates(map[string]any{"is_active": false, "valid_to": now}).Error; err != nil {
+		tx.Rollback()
+		return exchangeRate, fmt.Errorf("failed to retire exchange rate: %w", err)
+	}
+
+	if err := tx.Create(&exchangeRate).Error; err != nil {
+		tx.Rollback()
+		return exchangeRate, fmt.Errorf("failed to create exchange rate: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return exchangeRate, fmt.Errorf("failed to commit exchange rate: %w", err)
+	}
+
+	jobClient := jobs.NewExchangeRateJobClient()
+	defer jobClient.Close()
+	if err := queueRateFollowUp(jobClient, exchangeRate); err != nil {
+		log.Printf("Failed to enqueue activation of exchange rate %d (%s-%s): %v", exchangeRate.ID, fromCurrency, toCurrency, err)
+		return exchangeRate, fmt.Errorf("%w: %v", ErrRateFollowUpNotQueued, err)
+	}
+
+	return exchangeRate, nil
+}
+
+// HandleExchangeRateFollowUpSweepTask runs periodically. It queues the follow-up of every active
+// rate whose activation could not queue its own.
+func HandleExchangeRateFollowUpSweepTask(ctx context.Context, t *asynq.Task) error {
+	var missed []models.ExchangeRate
+	if err := database.DB.
+		Where("is_active = ? AND follow_up_queued_at IS NULL AND valid_from <= ?", true, time.Now().Add(-constants.ExchangeRateFollowUpSweepGrace)).
+		Find(&missed).Error; err != nil {
+		return fmt.Errorf("failed to fetch exchange rates without follow-up: %w", err)
+	}
+	if len(missed) == 0 {
+		return nil
+	}
+
+	jobClient := jobs.NewExchangeRateJobClient()
+	defer jobClient.Close()
+	for _, rate := range missed {
+		if err := queueRateFollowUp(jobClient, rate); err != nil {
+			log.Printf("Failed to enqueue activation of exchange rate %d (%s-%s): %v", rate.ID, rate.FromCurrency, rate.ToCurrency, err)
+		}
+	}
+	return nil
+}
+
+// GetRateAt returns the rate that was in effect for a pair at the given moment
+func GetRateAt(fromCurrency, toCurrency string, at time.Time) (*types.RatePointResponse, error) {
+	rate, err := findRateAt(fromCurrency, toCurrency, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RatePointResponse{
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		Source:       string(rate.Source),
+		At:           at,
+		ValidFrom:    rate.ValidFrom,
+		ValidTo:      rate.ValidTo,
+	}, nil
+}
+
+// GetRateSeries buckets a pair's rate history into open/high/low/close candles for charts.
+// Intervals before the first recorded rate are left out.
+func GetRateSeries(fromCurrency, toCurrency, rangeKey string) (*types.RateSeriesResponse, error) {
+	if rangeKey == "" {
+		rangeKey = "24h"
+	}
+	window, ok := rateSeriesRanges[rangeKey]
+	if !ok {
+		return nil, i18n.NewError("error.invalid_rate_range", nil)
+	}
+
+	now := time.Now()
+	end := now.Truncate(window.interval).Add(window.interval)
+	start := end.Add(-window.span)
+
+	current, hasRate := 0.0, false
+	if opening, err := findRateAt(fromCurrency, toCurrency, start); err == nil {
+		current, hasRate = opening.Rate, true
+	}
+
+	var changes []models.ExchangeRate
+	if err := database.DB.
+		Where("from_currency = ? AND to_currency = ? AND valid_from > ? AND valid_from <= ?", fromCurrency, toCurrency, start, now).
+		Order("valid_from ASC").Find(&changes).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch rate history: %w", err)
+	}
+
+	candles := make([]types.RateCandle, 0, int(window.span/window.interval))
+	next := 0
+	for bucketStart := start; bucketStart.Before(end); bucketStart = bucketStart.Add(window.interval) {
+		bucketEnd := bucketStart.Add(window.interval)
+
+		if !hasRate && (next >= len(changes) || !changes[next].ValidFrom.Before(bucketEnd)) {
+			continue
+		}
+
+		candle := types.RateCandle{Time: bucketStart}
+		if hasRate {
+			candle.Open, candle.High, candle.Low = current, current, current
+		} else {
+			first := changes[next].Rate
+			candle.Open, candle.High, candle.Low = first, first, first
+		}
+
+		for ; next < len(changes) && changes[next].ValidFrom.Before(bucketEnd); next++ {
+			current, hasRate = changes[next].Rate, true
+			candle.High = max(candle.High, current)
+			candle.Low = min(candle.Low, current)
+			candle.Changes++
+		}
+		candle.Close = current
+		candles = append(candles, candle)
+	}
+
+	return &types.RateSeriesResponse{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Range:        rangeKey,
+		Interval:     window.label,
+		ChartStyle:   string(getChartStyle()),
+		Candles:      candles,
+	}, nil
+}
+
+// Worker functions
+
+// HandleExchangeRateActivatedTask fills the limit orders and fires the rate alerts a newly
+// activated rate satisfies
+func HandleExchangeRateActivatedTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.ExchangeRateActivatedPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal exchange rate payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.Rate <= 0 {
+		return fmt.Errorf("rate must be positive: %w", asynq.SkipRetry)
+	}
+
+	// A newer rate has its own task; never fill at a rate that is no longer offered
+	var active int64
+	if err := database.DB.Model(&models.ExchangeRate{}).
+		Where("id = ? AND is_active = ?", payload.ExchangeRateID, true).
+		Count(&active).Error; err != nil {
+		return fmt.Errorf("failed to check exchange rate: %w", err)
+	}
+	if active == 0 {
+		log.Printf("Skipping exchange rate %d: no longer active", payload.ExchangeRateID)
+		return nil
+	}
+
+	filled, err := fillLimitOrders(payload.FromCurrency, payload.ToCurrency, payload.Rate)
+	if err != nil {
+		return err
+	}
+
+	alerted, err := evaluateRateAlerts(payload.FromCurrency, payload.ToCurrency, payload.Rate)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Exchange rate activated: pair=%s-%s rate=%.6f limit_orders_filled=%d rate_alerts_fired=%d", payload.FromCurrency, payload.ToCurrency, payload.Rate, filled, alerted)
+	return nil
+}
+
+// Helper functions
+
+// queueRateFollowUp queues the job reacting to an active rate and records that it was queued
+func queueRateFollowUp(jobClient *jobs.ExchangeRateJobClient, rate models.ExchangeRate) error {
+	if err := jobClient.EnqueueRateActivated(rate); err != nil {
+		return err
+	}
+	if err := database.DB.Model(&models.ExchangeRate{}).Where("id = ?", rate.ID).
+		Update("follow_up_queued_at", time.Now()).Error; err != nil {
+		log.Printf("Failed to mark follow-up of exchange rate %d as queued: %v", rate.ID, err)
+	}
+	return nil
+}
+
+// retireAdminExchangeRate stops conversions using the rate an admin rate published. Until
+// another rate is activated the pair falls back to its corridor's default rate, if it has one.
+func retireAdminExchangeRate(tx *gorm.DB, rate models.Rate) error {
+	if err := tx.Model(&models.ExchangeRate{}).
+		Where("rate_id = ? AND is_active = ?", rate.ID, true).
+		Updates(map[string]any{"is_active": false, "valid_to": time.Now()}).Error; err != nil {
+		return fmt.Errorf("failed to retire exchange rate: %w", err)
+	}
+	return nil
+}
+
+// findRateAt returns the exchange rate row in effect at the given moment
+func findRateAt(fromCurrency, toCurrency string, at time.Time) (models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	if err := database.DB.
+		Where("from_currency = ? AND to_currency = ? AND valid_from <= ?", fromCurrency, toCurrency, at).
+		Where("valid_to IS NULL OR valid_to > ?", at).
+		Order("valid_from DESC").First(&rate).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return rate, i18n.NewError("error.rate_not_recorded", nil)
+		}
+		return rate, fmt.Errorf("failed to fetch rate history: %w", err)
+	}
+	return rate, nil
+}
+
+// getChartStyle returns the platform's configured chart style, defaulting to a line chart
+func getChartStyle() models.ChartStyle {
+	var setting models.PlatformSetting
+	if err := database.DB.Select("chart_style").Order("id ASC").First(&setting).Error; err != nil || setting.ChartStyle == "" {
+		return models.LineChart
+	}
+	return setting.ChartStyle
+}
@@ -0,0 +1,389 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+var errLimitOrderClosed = i18n.NewError("error.limit_order_closed", nil)
+
+// CreateLimitOrder places a conversion that executes once the rate reaches the target.
+// The amount is held out of the wallet until the order fills, expires or is cancelled.
+func CreateLimitOrder(userID uint, req types.CreateLimitOrderRequest) (*types.LimitOrderResponse, error) {
+	if userID == 0 {
+		return nil, ErrUserIDRequired
+	}
+	if err := validateConversionRequest(types.ConversionRequest{
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Amount:       req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+	if req.TargetRate <= 0 {
+		return nil, i18n.NewError("error.target_rate_not_positive", nil)
+	}
+
+	currentRate, err := getCurrentExchangeRate(req.FromCurrency, req.ToCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	if currentRate >= req.TargetRate {
+		return nil, i18n.NewError("error.target_rate_met", i18n.Args{"rate": i18n.Rate(currentRate)})
+	}
+
+	expiry := constants.LimitOrderDefaultExpiry
+	if req.ExpiresInHours > 0 {
+		expiry = min(time.Duration(req.ExpiresInHours)*time.Hour, constants.LimitOrderMaxExpiry)
+	}
+
+	if _, err := findOrCreateWallet(userID); err != nil {
+		return nil, fmt.Errorf("failed to access wallets: %w", err)
+	}
+
+	amount := utils.RoundCurrency(req.Amount)
+	order := models.LimitOrder{
+		UserID:       userID,
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Amount:       amount,
+		TargetRate:   req.TargetRate,
+		Status:       models.LimitOrderOpen,
+		ExpiresAt:    time.Now().Add(expiry),
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Hold the funds only if the balance covers them
+	hold := tx.Model(&models.Wallet{}).
+		Where("user_id = ? AND currency = ? AND balance >= ?", userID, req.FromCurrency, amount).
+		Update("balance", gorm.Expr("balance - ?", amount))
+	if hold.Error != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to hold funds: %w", hold.Error)
+	}
+	if hold.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, ErrInsufficientBalance
+	}
+
+	if err := tx.Create(&order).Error; err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create limit order: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("failed to commit limit order: %w", err)
+	}
+
+	jobClient := jobs.NewLimitOrderJobClient()
+	defer jobClient.Close()
+	if err := jobClient.ScheduleExpiry(order.ID, order.ExpiresAt); err != nil {
+		// The limit order sweep still expires the order and releases its hold
+		log.Printf("Failed to schedule expiry of limit order %d: %v", order.ID, err)
+	}
+
+	activityClient := jobs.NewActivityJobClient()
+	defer activityClient.Close()
+	activityClient.EnqueueNewActivity(userID, fmt.Sprintf("Placed a limit order to convert %s to %s at %.6f", utils.FormatCurrency(amount, order.FromCurrency), order.ToCurrency, order.TargetRate))
+
+	response := toLimitOrderResponse(order)
+	return &response, nil
+}
+
+// GetLimitOrders lists the user's limit orders, optionally filtered by status
+func GetLimitOrders(userID uint, query types.LimitOrderQuery) (*types.LimitOrdersResponse, error) {
+	page, limit := types.ValidatePagination(query.Page, query.Limit)
+
+	db := database.DB.Model(&models.LimitOrder{}).Where("user_id = ?", userID)
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count limit orders: %w", err)
+	}
+
+	var orders []models.LimitOrder
+	if err := db.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch limit orders: %w", err)
+	}
+
+	response := &types.LimitOrdersResponse{
+		Orders:     make([]types.LimitOrderResponse, 0, len(orders)),
+		Pagination: types.NewPaginationResponse(page, limit, total),
+	}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, toLimitOrderResponse(order))
+	}
+	return response, nil
+}
+
+// GetLimitOrder returns one of the user's limit orders
+func GetLimitOrder(userID, orderID uint) (*types.LimitOrderResponse, error) {
+	order, err := findLimitOrder(userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	response := toLimitOrderResponse(order)
+	return &response, nil
+}
+
+// CancelLimitOrder cancels an open order and returns its held funds to the wallet
+func CancelLimitOrder(userID, orderID uint) (*types.LimitOrderResponse, error) {
+	order, err := findLimitOrder(userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != models.LimitOrderOpen {
+		return nil, i18n.NewError("error.limit_order_status", i18n.Args{"status": order.Status})
+	}
+
+	if err := closeLimitOrder(&order, models.LimitOrderCancelled); err != nil {
+		return nil, err
+	}
+
+	response := toLimitOrderResponse(order)
+	return &response, nil
+}
+
+// Worker functions
+
+// HandleLimitOrderExpireTask expires an order that did not fill in time and releases its funds
+func HandleLimitOrderExpireTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.LimitOrderJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal limit order payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.OrderID == 0 {
+		return fmt.Errorf("order_id is required: %w", asynq.SkipRetry)
+	}
+
+	var order models.LimitOrder
+	if err := database.DB.First(&order, payload.OrderID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("limit order %d not found: %w", payload.OrderID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch limit order: %w", err)
+	}
+
+	if order.Status != models.LimitOrderOpen || time.Now().Before(order.ExpiresAt) {
+		return nil
+	}
+
+	if err := closeLimitOrder(&order, models.LimitOrderExpired); err != nil {
+		if errors.Is(err, errLimitOrderClosed) {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("Limit order expired: id=%d user_id=%d", order.ID, order.UserID)
+	return nil
+}
+
+// HandleLimitOrderSweepTask runs periodically. It expires open orders past their deadline whose
+// expiry job never ran, so their funds are not held indefinitely.
+func HandleLimitOrderSweepTask(ctx context.Context, t *asynq.Task) error {
+	var overdue []models.LimitOrder
+	if err := database.DB.Where("status = ? AND expires_at <= ?", models.LimitOrderOpen, time.Now()).
+		Order("expires_at ASC").Find(&overdue).Error; err != nil {
+		return fmt.Errorf("failed to fetch overdue limit orders: %w", err)
+	}
+
+	for i := range overdue {
+		if err := closeLimitOrder(&overdue[i], models.LimitOrderExpired); err != nil {
+			if !errors.Is(err, errLimitOrderClosed) {
+				log.Printf("Failed to expire limit order %d: %v", overdue[i].ID, err)
+			}
+			continue
+		}
+		log.Printf("Limit order expired: id=%d user_id=%d", overdue[i].ID, overdue[i].UserID)
+	}
+	return nil
+}
+
+// Helper functions
+
+// fillLimitOrders converts every open, unexpired order for the pair whose target the rate meets,
+// oldest first, and returns how many were filled
+func fillLimitOrders(fromCurrency, toCurrency string, rate float64) (int, error) {
+	var orders []models.LimitOrder
+	if err := database.DB.
+		Where("from_currency = ? AND to_currency = ? AND status = ? AND target_rate <= ? AND expires_at > ?",
+			fromCurrency, toCurrency, models.LimitOrderOpen, rate, time.Now()).
+		Order("created_at ASC").Find(&orders).Error; err != nil {
+		return 0, fmt.Errorf("failed to fetch limit orders: %w", err)
+	}
+
+	filled := 0
+	for i := range orders {
+		if err := fillLimitOrder(&orders[i], rate); err != nil {
+			if !errors.Is(err, errLimitOrderClosed) {
+				log.Printf("Failed to fill limit order %d: %v", orders[i].ID, err)
+			}
+			continue
+		}
+		filled++
+	}
+	return filled, nil
+}
+
+// fillLimitOrder releases the order's hold and converts it through the regular conversion flow,
+// all in one database transaction
+func fillLimitOrder(order *models.LimitOrder, rate float64) error {
+	now := time.Now()
+	conversion, err := convertCurrency(order.UserID, types.ConversionRequest{
+		FromCurrency: order.FromCurrency,
+		ToCurrency:   order.ToCurrency,
+		Amount:       order.Amount,
+	}, rate, "limit_order", func(tx *gorm.DB, conversion *models.Conversions) error {
+		claim := tx.Model(&models.LimitOrder{}).
+			Where("id = ? AND status = ?", order.ID, models.LimitOrderOpen).
+			Updates(map[string]any{
+				"status":           models.LimitOrderFilled,
+				"filled_at":        now,
+				"filled_rate":      rate,
+				"converted_amount": conversion.ConvertedAmount,
+				"conversion_id":    conversion.ConversionID,
+			})
+		if claim.Error != nil {
+			return fmt.Errorf("failed to update limit order: %w", claim.Error)
+		}
+		if claim.RowsAffected == 0 {
+			return errLimitOrderClosed
+		}
+
+		// Return the held funds so the conversion debits them like any other
+		if err := tx.Model(&models.Wallet{}).
+			Where("user_id = ? AND currency = ?", order.UserID, order.FromCurrency).
+			Update("balance", gorm.Expr("balance + ?", order.Amount)).Error; err != nil {
+			return fmt.Errorf("failed to release held funds: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	order.Status = models.LimitOrderFilled
+	order.FilledAt = &now
+	order.FilledRate = rate
+	order.ConvertedAmount = conversion.ConvertedAmount
+	order.ConversionID = conversion.ConversionID
+
+	notifyLimitOrder(order.UserID, "notification.limit_order_filled", i18n.Args{
+		"amount":    i18n.Amount(order.Amount, order.FromCurrency),
+		"converted": i18n.Amount(conversion.ConvertedAmount, order.ToCurrency),
+		"rate":      i18n.Rate(rate),
+	})
+	return nil
+}
+
+// closeLimitOrder expires or cancels an open order and returns the held funds
+func closeLimitOrder(order *models.LimitOrder, status models.LimitOrderStatus) error {
+	now := time.Now()
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Model(&models.LimitOrder{}).
+		Where("id = ? AND status = ?", order.ID, models.LimitOrderOpen).
+		Updates(map[string]any{"status": status, "closed_at": now})
+	if result.Error != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update limit order: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return errLimitOrderClosed
+	}
+
+	if err := tx.Model(&models.Wallet{}).
+		Where("user_id = ? AND currency = ?", order.UserID, order.FromCurrency).
+		Update("balance", gorm.Expr("balance + ?", order.Amount)).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to release held funds: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit limit order: %w", err)
+	}
+
+	order.Status = status
+	order.ClosedAt = &now
+
+	key := "notification.limit_order_cancelled"
+	if status == models.LimitOrderExpired {
+		key = "notification.limit_order_expired"
+	}
+	notifyLimitOrder(order.UserID, key, i18n.Args{
+		"amount":     i18n.Amount(order.Amount, order.FromCurrency),
+		"toCurrency": order.ToCurrency,
+	})
+	return nil
+}
+
+func findLimitOrder(userID, orderID uint) (models.LimitOrder, error) {
+	var order models.LimitOrder
+	if err := database.DB.Where("id = ? AND user_id = ?", orderID, userID).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return order, i18n.NewError("error.limit_order_not_found", nil)
+		}
+		return order, fmt.Errorf("failed to fetch limit order: %w", err)
+	}
+	return order, nil
+}
+
+// notifyLimitOrder sends the notice whose title and message live under the catalog key
+func notifyLimitOrder(userID uint, key string, args i18n.Args) {
+	notifyAndLog(Notice{
+		UserID:   userID,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg(key+".title", nil),
+		Message:  i18n.Msg(key+".message", args),
+	})
+}
+
+func toLimitOrderResponse(order models.LimitOrder) types.LimitOrderResponse {
+	return types.LimitOrderResponse{
+		ID:              order.ID,
+		FromCurrency:    order.FromCurrency,
+		ToCurrency:      order.ToCurrency,
+		Amount:          order.Amount,
+		TargetRate:      order.TargetRate,
+		Status:          string(order.Status),
+		ExpiresAt:       order.ExpiresAt,
+		FilledAt:        order.FilledAt,
+		FilledRate:      order.FilledRate,
+		ConvertedAmount: order.ConvertedAmount,
+		ConversionID:    order.ConversionID,
+		ClosedAt:        order.ClosedAt,
+		CreatedAt:       order.CreatedAt,
+	}
+}
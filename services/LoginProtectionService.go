@@ -0,0 +1,265 @@
+package services
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/go-redis/redis/v8"
+)
+
+// Lockout scopes
+const (
+	lockScopeAccount = "account"
+	lockScopeIP      = "ip"
+)
+
+// ErrTooManyAttempts is returned while an account or IP address is locked out
+var ErrTooManyAttempts = i18n.NewError("error.too_many_attempts", nil)
+
+// loginChallenge is the Redis payload of a pending two-factor login
+type loginChallenge struct {
+	UserID   uint   `json:"user_id"`
+	CodeHash string `json:"code_hash"`
+}
+
+// CheckLoginAllowed returns an error if the account or IP address is currently locked out
+func CheckLoginAllowed(email, ip string) error {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	if remaining := lockoutRemaining(redisClient, lockScopeAccount, normalizeLoginEmail(email)); remaining > 0 {
+		return lockoutError(remaining)
+	}
+	if remaining := lockoutRemaining(redisClient, lockScopeIP, ip); remaining > 0 {
+		return lockoutError(remaining)
+	}
+	return nil
+}
+
+// RecordFailedLogin counts a failed sign-in attempt against the account and IP address
+// and locks them out with exponential backoff once the limits are reached
+func RecordFailedLogin(email, ip string) {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	email = normalizeLoginEmail(email)
+
+	if email != "" {
+		attempts := incrementAttempts(redisClient, attemptKey("login", lockScopeAccount, email), constants.LoginAttemptWindow)
+		if attempts >= constants.LoginMaxAccountAttempts {
+			duration := applyLockout(redisClient, lockScopeAccount, email)
+			redisClient.Del(redisClient.Context(), attemptKey("login", lockScopeAccount, email))
+			notifyAccountLocked(email, ip, duration)
+		}
+	}
+
+	if ip != "" {
+		attempts := incrementAttempts(redisClient, attemptKey("login", lockScopeIP, ip), constants.LoginAttemptWindow)
+		if attempts >= constants.LoginMaxIPAttempts {
+			duration := applyLockout(redisClient, lockScopeIP, ip)
+			redisClient.Del(redisClient.Context(), attemptKey("login", lockScopeIP, ip))
+			log.Printf("Locked out IP %s for %s after repeated failed sign-in attempts", ip, duration)
+		}
+	}
+}
+
+// ClearFailedLogins resets the failed attempt counter of an account after a successful sign-in
+func ClearFailedLogins(email string) {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	redisClient.Del(redisClient.Context(), attemptKey("login", lockScopeAccount, normalizeLoginEmail(email)))
+}
+
+// CheckPasswordResetAllowed rate limits password reset requests per account and IP address
+func CheckPasswordResetAllowed(email, ip string) error {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	if ip != "" {
+		attempts := incrementAttempts(redisClient, attemptKey("password_reset", lockScopeIP, ip), constants.PasswordResetWindow)
+		if attempts > constants.PasswordResetMaxIPAttempts {
+			return ErrTooManyAttempts
+		}
+	}
+
+	email = normalizeLoginEmail(email)
+	if email != "" {
+		attempts := incrementAttempts(redisClient, attemptKey("password_reset", lockScopeAccount, email), constants.PasswordResetWindow)
+		if attempts > constants.PasswordResetMaxAttempts {
+			return ErrTooManyAttempts
+		}
+	}
+
+	return nil
+}
+
+// CreateLoginChallenge stores a hashed two-factor code bound to a single login attempt
+// and returns the challenge ID together with the raw code to deliver to the user
+func CreateLoginChallenge(userID uint) (string, string, error) {
+	challengeID, err := libs.GenerateSecureToken(32)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create login challenge: %w", err)
+	}
+
+	code := libs.GenerateOTP(6)
+	payload, err := json.Marshal(loginChallenge{
+		UserID:   userID,
+		CodeHash: libs.SHA256(challengeID + code),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create login challenge: %w", err)
+	}
+
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	if err := utils.SetRedisKey(redisClient, challengeKey(challengeID), payload, constants.OTPChallengeTTL); err != nil {
+		return "", "", fmt.Errorf("failed to store login challenge: %w", err)
+	}
+
+	return challengeID, code, nil
+}
+
+// VerifyLoginChallenge checks a two-factor code against its login challenge and returns
+// the user it belongs to. The challenge is discarded after success or too many guesses.
+func VerifyLoginChallenge(challengeID, code string) (uint, error) {
+	if challengeID == "" || code == "" {
+		return 0, ErrInvalidCode
+	}
+
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	value, err := utils.GetRedisValue(redisClient, challengeKey(challengeID))
+	if err != nil || value == "" {
+		return 0, ErrInvalidCode
+	}
+
+	var challenge loginChallenge
+	if err := json.Unmarshal([]byte(value), &challenge); err != nil {
+		return 0, ErrInvalidCode
+	}
+
+	attempts := incrementAttempts(redisClient, challengeAttemptKey(challengeID), constants.OTPChallengeTTL)
+	if attempts > constants.OTPMaxAttempts {
+		redisClient.Del(redisClient.Context(), challengeKey(challengeID), challengeAttemptKey(challengeID))
+		return challenge.UserID, ErrTooManyAttempts
+	}
+
+	expected := libs.SHA256(challengeID + code)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(challenge.CodeHash)) != 1 {
+		return challenge.UserID, ErrInvalidCode
+	}
+
+	redisClient.Del(redisClient.Context(), challengeKey(challengeID), challengeAttemptKey(challengeID))
+	return challenge.UserID, nil
+}
+
+// Helper functions
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func attemptKey(action, scope, id string) string {
+	return fmt.Sprintf("%s_attempts:%s:%s", action, scope, id)
+}
+
+func lockoutKey(scope, id string) string {
+	return fmt.Sprintf("login_lockout:%s:%s", scope, id)
+}
+
+func lockoutCountKey(scope, id string) string {
+	return fmt.Sprintf("login_lockout_count:%s:%s", scope, id)
+}
+
+func challengeKey(challengeID string) string {
+	return fmt.Sprintf("two_factor:%s", challengeID)
+}
+
+func challengeAttemptKey(challengeID string) string {
+	return fmt.Sprintf("two_factor_attempts:%s", challengeID)
+}
+
+// incrementAttempts increments a counter, starting its expiry window on the first hit
+func incrementAttempts(client *redis.Client, key string, window time.Duration) int64 {
+	count, err := client.Incr(client.Context(), key).Result()
+	if err != nil {
+		log.Printf("Failed to increment attempt counter %s: %v", key, err)
+		return 0
+	}
+	if count == 1 {
+		client.Expire(client.Context(), key, window)
+	}
+	return count
+}
+
+// lockoutRemaining returns how long a scope is still locked out for
+func lockoutRemaining(client *redis.Client, scope, id string) time.Duration {
+	if id == "" {
+		return 0
+	}
+	ttl, err := client.TTL(client.Context(), lockoutKey(scope, id)).Result()
+	if err != nil || ttl <= 0 {
+		return 0
+	}
+	return ttl
+}
+
+// applyLockout locks a scope, doubling the duration for every lockout in the last day
+func applyLockout(client *redis.Client, scope, id string) time.Duration {
+	count := incrementAttempts(client, lockoutCountKey(scope, id), constants.LoginLockoutMemory)
+
+	duration := constants.LoginBaseLockoutDuration
+	for i := int64(1); i < count && duration < constants.LoginMaxLockoutDuration; i++ {
+		duration *= 2
+	}
+	if duration > constants.LoginMaxLockoutDuration {
+		duration = constants.LoginMaxLockoutDuration
+	}
+
+	if err := utils.SetRedisKey(client, lockoutKey(scope, id), count, duration); err != nil {
+		log.Printf("Failed to apply %s lockout for %s: %v", scope, id, err)
+	}
+	return duration
+}
+
+// notifyAccountLocked tells the account owner that sign-in has been locked
+func notifyAccountLocked(email, ip string, duration time.Duration) {
+	var user models.User
+	if err := database.DB.Where("LOWER(email) = ?", email).First(&user).Error; err != nil {
+		return
+	}
+
+	jobs.NewActivityJobClient().EnqueueNewActivity(user.ID, fmt.Sprintf(constants.AccountLockedActivity, libs.FormatDate(time.Now())))
+
+	err := Notify(Notice{
+		UserID:   user.ID,
+		Category: models.CategorySecurity,
+		Type:     models.SecurityType,
+		Title:    i18n.Msg("notification.account_locked.title", nil),
+		Message:  i18n.Msg("notification.account_locked.message", i18n.Args{"duration": duration}),
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueueAccountLockedEmail(user.Email, user.FirstName, client.Locale().Duration(duration), ip)
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to send account locked notice: %v", err)
+	}
+}
+
+func lockoutError(remaining time.Duration) error {
+	return i18n.NewError("error.too_many_attempts_wait", i18n.Args{"duration": remaining})
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+)
+
+// NameEnquiryProvider resolves the holder's name for a bank account or mobile money wallet.
+// For bank accounts institutionCode is the bank code; for MoMo it is the network.
+type NameEnquiryProvider interface {
+	ResolveAccountName(method, accountNumber, institutionCode string) (string, error)
+}
+
+var (
+	nameEnquiryProvider NameEnquiryProvider
+	nameEnquiryOnce     sync.Once
+)
+
+// SetNameEnquiryProvider replaces the provider used to verify withdrawal accounts
+func SetNameEnquiryProvider(provider NameEnquiryProvider) {
+	nameEnquiryOnce.Do(func() {})
+	nameEnquiryProvider = provider
+}
+
+// getNameEnquiryProvider picks the provider from NAME_ENQUIRY_PROVIDER, defaulting to Paystack
+func getNameEnquiryProvider() (NameEnquiryProvider, error) {
+	nameEnquiryOnce.Do(func() {
+		if strings.EqualFold(libs.GetEnvOrDefault("NAME_ENQUIRY_PROVIDER", "paystack"), "mock") {
+			nameEnquiryProvider = &MockNameEnquiryProvider{}
+			return
+		}
+		if paystack, err := NewPaystackConfigFromEnv(); err == nil {
+			nameEnquiryProvider = &PaystackNameEnquiryProvider{paystack: paystack}
+		}
+	})
+	if nameEnquiryProvider == nil {
+		return nil, i18n.NewError("error.name_enquiry_unavailable", nil)
+	}
+	return nameEnquiryProvider, nil
+}
+
+// ResolveAccountName returns the verified account holder name
+func ResolveAccountName(method, accountNumber, institutionCode string) (string, error) {
+	if accountNumber == "" || institutionCode == "" {
+		return "", i18n.NewError("error.account_details_required", nil)
+	}
+
+	provider, err := getNameEnquiryProvider()
+	if err != nil {
+		return "", err
+	}
+
+	name, err := provider.ResolveAccountName(method, accountNumber, institutionCode)
+	if err != nil {
+		return "", err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", i18n.NewError("error.account_name_unresolved", nil)
+	}
+	return name, nil
+}
+
+// PaystackNameEnquiryProvider resolves names through Paystack's account resolution API,
+// which accepts MoMo networks as bank codes
+type PaystackNameEnquiryProvider struct {
+	paystack *PaystackService
+}
+
+func (p *PaystackNameEnquiryProvider) ResolveAccountName(method, accountNumber, institutionCode string) (string, error) {
+	response, err := p.paystack.ResolveAccountNumber(accountNumber, institutionCode)
+	if err != nil {
+		return "", err
+	}
+	return response.Data.AccountName, nil
+}
+
+// MockNameEnquiryProvider resolves names from a fixed table, for development and testing.
+// Accounts missing from Names resolve to DefaultName; with neither set, every account
+// resolves to a placeholder name.
+type MockNameEnquiryProvider struct {
+	Names       map[string]string
+	DefaultName string
+}
+
+func (p *MockNameEnquiryProvider) ResolveAccountName(method, accountNumber, institutionCode string) (string, error) {
+	if name, ok := p.Names[accountNumber]; ok {
+		return name, nil
+	}
+	if p.DefaultName != "" {
+		return p.DefaultName, nil
+	}
+	if len(p.Names) > 0 {
+		return "", i18n.NewError("error.account_not_found", nil)
+	}
+	return "JEANPAY TEST ACCOUNT", nil
+}
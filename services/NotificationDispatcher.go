@@ -0,0 +1,397 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notificationCategories lists every category in the order preferences are shown
+var notificationCategories = []models.NotificationCategory{
+	models.CategorySecurity,
+	models.CategoryAccount,
+	models.CategoryTransactions,
+	models.CategoryPaymentRequests,
+	models.CategoryRateAlerts,
+	models.CategoryPromotional,
+}
+
+// notificationChannels lists every channel in the order preferences are shown
+var notificationChannels = []models.NotificationChannel{
+	models.ChannelInApp,
+	models.ChannelEmail,
+	models.ChannelSMS,
+	models.ChannelPush,
+}
+
+// mandatoryCategories go out on every channel the platform allows, whatever the user chose
+var mandatoryCategories = map[models.NotificationCategory]bool{
+	models.CategorySecurity: true,
+	models.CategoryAccount:  true,
+}
+
+// Notice is one event to tell a user about, with the content for each channel it can go out
+// on. Channels without content are skipped. Text is rendered in the user's language when the
+// notice is dispatched.
+type Notice struct {
+	UserID   uint
+	Category models.NotificationCategory
+
+	// In-app notification, which is also the content of the push
+	Type    models.NotificationType
+	Title   i18n.Text
+	Message i18n.Text
+
+	// Email queues the email for this event
+	Email func(client *jobs.EmailJobClient, user models.User) error
+	// PlatformEmail is the admin switch for this particular email, if it has one
+	PlatformEmail func(setting models.PlatformSetting) bool
+
+	SMSTemplate string
+	SMSData     i18n.Args
+
+	// CampaignID links the notice to the broadcast it came from, so each channel can report
+	// its outcome back to the campaign
+	CampaignID uint
+	// Channels limits the notice to these channels when set
+	Channels []models.NotificationChannel
+}
+
+// Notify resolves the user's and the platform's preferences for the notice's category and
+// fans the notice out to every channel they allow
+func Notify(notice Notice) error {
+	_, err := dispatch(notice)
+	return err
+}
+
+// dispatch does the work of Notify and reports the channels the notice was queued on
+func dispatch(notice Notice) ([]models.NotificationChannel, error) {
+	var user models.User
+	if err := database.DB.First(&user, notice.UserID).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	setting, err := userSetting(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	locale := userLocale(setting, user)
+
+	platform := currentPlatformSetting()
+	channels, err := resolveChannels(user.ID, notice.Category, platform)
+	if err != nil {
+		return nil, err
+	}
+	if len(notice.Channels) > 0 {
+		limited := make(map[models.NotificationChannel]bool, len(notice.Channels))
+		for _, channel := range notice.Channels {
+			limited[channel] = channels[channel]
+		}
+		channels = limited
+	}
+
+	var sent []models.NotificationChannel
+	var errs []error
+	record := func(channel models.NotificationChannel, err error) {
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		sent = append(sent, channel)
+	}
+
+	if !notice.Message.IsZero() && (channels[models.ChannelInApp] || channels[models.ChannelPush]) {
+		notificationClient := jobs.NewNotificationJobClient()
+		defer notificationClient.Close()
+
+		title, message := locale.Render(notice.Title), locale.Render(notice.Message)
+
+		if channels[models.ChannelInApp] {
+			payload := jobs.NotificationJobPayload{
+				UserID:  user.ID,
+				Type:    notice.Type,
+				Title:   title,
+				Message: message,
+			}
+			if notice.CampaignID != 0 {
+				payload.CampaignID = &notice.CampaignID
+			}
+			record(models.ChannelInApp, notificationClient.EnqueueNotification(payload))
+		}
+		if channels[models.ChannelPush] {
+			payload := jobs.PushJobPayload{
+				UserID:   user.ID,
+				Type:     notice.Type,
+				Title:    title,
+				Message:  message,
+				Category: notice.Category,
+			}
+			if notice.CampaignID != 0 {
+				payload.CampaignID = &notice.CampaignID
+			}
+			record(models.ChannelPush, notificationClient.EnqueuePush(payload))
+		}
+	}
+
+	if notice.Email != nil && channels[models.ChannelEmail] && (notice.PlatformEmail == nil || notice.PlatformEmail(platform)) {
+		emailClient := jobs.NewEmailJobClient()
+		defer emailClient.Close()
+		emailClient.SetLocale(locale)
+		record(models.ChannelEmail, notice.Email(emailClient, user))
+	}
+
+	if notice.SMSTemplate != "" && channels[models.ChannelSMS] && user.PhoneNumber != "" {
+		record(models.ChannelSMS, QueueSMS(user.ID, user.PhoneNumber, notice.SMSTemplate, notice.SMSData, locale, notice.CampaignID))
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// notifyAndLog sends a notice for callers that carry on regardless of delivery
+func notifyAndLog(notice Notice) {
+	if err := Notify(notice); err != nil {
+		log.Printf("Failed to notify user %d (%s): %v", notice.UserID, notice.Category, err)
+	}
+}
+
+// GetNotificationPreferences returns the user's full category by channel matrix
+func GetNotificationPreferences(userID uint) ([]types.NotificationCategoryPreferences, error) {
+	setting, err := userSetting(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []models.NotificationPreference
+	if err := database.DB.Where("user_id = ?", userID).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch notification preferences: %w", err)
+	}
+	chosen := make(map[models.NotificationCategory]map[models.NotificationChannel]bool)
+	for _, row := range rows {
+		if chosen[row.Category] == nil {
+			chosen[row.Category] = make(map[models.NotificationChannel]bool)
+		}
+		chosen[row.Category][row.Channel] = row.Enabled
+	}
+
+	platform := currentPlatformSetting()
+	matrix := make([]types.NotificationCategoryPreferences, 0, len(notificationCategories))
+	for _, category := range notificationCategories {
+		entry := types.NotificationCategoryPreferences{
+			Category:  string(category),
+			Mandatory: mandatoryCategories[category],
+			Channels:  make([]types.NotificationChannelPreference, 0, len(notificationChannels)),
+		}
+		for _, channel := range notificationChannels {
+			entry.Channels = append(entry.Channels, types.NotificationChannelPreference{
+				Channel:   string(channel),
+				Enabled:   userWantsChannel(setting, chosen[category], category, channel),
+				Locked:    mandatoryCategories[category],
+				Available: platformAllowsChannel(platform, category, channel),
+			})
+		}
+		matrix = append(matrix, entry)
+	}
+	return matrix, nil
+}
+
+// UpdateNotificationPreferences records the user's choices and returns the resulting matrix
+func UpdateNotificationPreferences(userID uint, updates []types.NotificationPreferenceUpdate) ([]types.NotificationCategoryPreferences, error) {
+	if userID == 0 {
+		return nil, ErrUserIDRequired
+	}
+
+	// Keyed so a category and channel sent twice keeps only the last choice
+	type preferenceKey struct {
+		category models.NotificationCategory
+		channel  models.NotificationChannel
+	}
+	choices := make(map[preferenceKey]bool, len(updates))
+	for _, update := range updates {
+		category := models.NotificationCategory(update.Category)
+		if !isNotificationCategory(category) {
+			return nil, i18n.NewError("error.notification_category_unknown", i18n.Args{"category": update.Category})
+		}
+		if mandatoryCategories[category] {
+			if !*update.Enabled {
+				return nil, i18n.NewError("error.notification_category_required", i18n.Args{"category": category})
+			}
+			continue
+		}
+		choices[preferenceKey{category, models.NotificationChannel(update.Channel)}] = *update.Enabled
+	}
+
+	rows := make([]models.NotificationPreference, 0, len(choices))
+	for key, enabled := range choices {
+		rows = append(rows, models.NotificationPreference{
+			UserID:   userID,
+			Category: key.category,
+			Channel:  key.channel,
+			Enabled:  enabled,
+		})
+	}
+
+	if len(rows) > 0 {
+		if err := database.DB.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "category"}, {Name: "channel"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at", "deleted_at"}),
+		}).Create(&rows).Error; err != nil {
+			return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+		}
+	}
+
+	return GetNotificationPreferences(userID)
+}
+
+// Helper functions
+
+// resolveChannels returns the channels a notice in the category may go out on for the user
+func resolveChannels(userID uint, category models.NotificationCategory, platform models.PlatformSetting) (map[models.NotificationChannel]bool, error) {
+	setting, err := userSetting(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []models.NotificationPreference
+	if err := database.DB.Where("user_id = ? AND category = ?", userID, category).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch notification preferences: %w", err)
+	}
+	chosen := make(map[models.NotificationChannel]bool, len(rows))
+	for _, row := range rows {
+		chosen[row.Channel] = row.Enabled
+	}
+
+	channels := make(map[models.NotificationChannel]bool, len(notificationChannels))
+	for _, channel := range notificationChannels {
+		channels[channel] = userWantsChannel(setting, chosen, category, channel) &&
+			platformAllowsChannel(platform, category, channel)
+	}
+	return channels, nil
+}
+
+// channelEnabled reports whether a single channel is open for the user and category
+func channelEnabled(userID uint, category models.NotificationCategory, channel models.NotificationChannel) bool {
+	channels, err := resolveChannels(userID, category, currentPlatformSetting())
+	if err != nil {
+		log.Printf("Failed to resolve notification channels for user %d: %v", userID, err)
+		return false
+	}
+	return channels[channel]
+}
+
+// userWantsChannel applies an explicit choice if the user made one, and otherwise falls back to
+// the coarse switches on the user's settings
+func userWantsChannel(setting models.Setting, chosen map[models.NotificationChannel]bool, category models.NotificationCategory, channel models.NotificationChannel) bool {
+	if mandatoryCategories[category] {
+		return true
+	}
+	// Promotional messages are opt-in as a whole, so the settings switch wins over any choice
+	if category == models.CategoryPromotional && !setting.PromotionalNotifications {
+		return false
+	}
+	if enabled, ok := chosen[channel]; ok {
+		return enabled
+	}
+
+	var enabled bool
+	switch channel {
+	case models.ChannelInApp:
+		enabled = true
+	case models.ChannelEmail:
+		enabled = setting.EmailNotifications
+	case models.ChannelPush:
+		enabled = setting.PushNotifications
+	case models.ChannelSMS:
+		// Texts cost money per message, so only transaction alerts are on by default
+		enabled = category == models.CategoryTransactions
+	}
+	return enabled
+}
+
+// platformAllowsChannel applies the platform-wide channel switches. Mandatory email still goes
+// out when email is switched off, since it carries login codes and password resets.
+func platformAllowsChannel(platform models.PlatformSetting, category models.NotificationCategory, channel models.NotificationChannel) bool {
+	switch channel {
+	case models.ChannelEmail:
+		return platform.EmailNotifications || mandatoryCategories[category]
+	case models.ChannelSMS:
+		return platform.SMSNotifications
+	case models.ChannelPush:
+		return platform.PushNotifications
+	default:
+		return true
+	}
+}
+
+// userSetting loads the user's settings, with registration defaults for users who have none
+func userSetting(userID uint) (models.Setting, error) {
+	var setting models.Setting
+	err := database.DB.Where("user_id = ?", userID).First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.Setting{
+			Language:                 i18n.DefaultLanguage,
+			EmailNotifications:       true,
+			PushNotifications:        true,
+			PromotionalNotifications: true,
+		}, nil
+	}
+	if err != nil {
+		return setting, fmt.Errorf("failed to fetch user settings: %w", err)
+	}
+	return setting, nil
+}
+
+// userLocale is the language the user reads notifications in, with their country's time zone
+func userLocale(setting models.Setting, user models.User) i18n.Locale {
+	return i18n.NewLocale(setting.Language, string(user.Country))
+}
+
+// currentPlatformSetting loads the platform settings, with the defaults a new platform starts with
+// if they have not been saved yet
+func currentPlatformSetting() models.PlatformSetting {
+	var setting models.PlatformSetting
+	if err := database.DB.Order("id ASC").First(&setting).Error; err != nil {
+		return models.PlatformSetting{
+			EmailNotifications:           true,
+			PushNotifications:            true,
+			TransactionConfirmationEmail: true,
+			SendTransactionSuccessEmail:  true,
+			SendTransactionDeclineEmail:  true,
+			SendTransactionPendingEmail:  true,
+			SendTransactionRefundEmail:   true,
+			AccountLimitsNotification:    true,
+		}
+	}
+	return setting
+}
+
+func isNotificationCategory(category models.NotificationCategory) bool {
+	for _, known := range notificationCategories {
+		if known == category {
+			return true
+		}
+	}
+	return false
+}
+
+// categoryForType maps an in-app notification type to its preference category, for sends that
+// did not name one
+func categoryForType(notificationType models.NotificationType) models.NotificationCategory {
+	switch notificationType {
+	case models.SecurityType:
+		return models.CategorySecurity
+	case models.StatementType:
+		return models.CategoryAccount
+	case models.PromotionType:
+		return models.CategoryPromotional
+	default:
+		return models.CategoryTransactions
+	}
+}
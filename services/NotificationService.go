@@ -8,6 +8,7 @@ import (
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
 )
 
@@ -32,7 +33,7 @@ type NotificationSummary struct {
 // GetAllNotifications retrieves all notifications for a user
 func GetAllNotifications(userID uint32, pagination types.PaginationRequest) (*NotificationSummary, *types.PaginationResponse, error) {
 	if userID == 0 {
-		return nil, nil, errors.New("user ID is required")
+		return nil, nil, ErrUserIDRequired
 	}
 
 	// Build query
@@ -87,7 +88,7 @@ func GetAllNotifications(userID uint32, pagination types.PaginationRequest) (*No
 // MarkNotificationRead marks a specific notification as read
 func MarkNotificationRead(userID uint32, notificationID uint) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if notificationID == 0 {
@@ -113,7 +114,7 @@ func MarkNotificationRead(userID uint32, notificationID uint) error {
 // MarkAllNotificationsRead marks all notifications as read for a user
 func MarkAllNotificationsRead(userID uint32) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	// Update all unread notifications for the user
@@ -129,7 +130,7 @@ func MarkAllNotificationsRead(userID uint32) error {
 // CreateNotification creates a new notification for a user
 func CreateNotification(userID uint, notificationType, message string) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if notificationType == "" {
@@ -183,7 +184,7 @@ func CreateSystemNotification(userID uint, message string) error {
 // DeleteNotification deletes a notification
 func DeleteNotification(userID uint, notificationID uint) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if notificationID == 0 {
@@ -207,7 +208,7 @@ func DeleteNotification(userID uint, notificationID uint) error {
 // DeleteAllNotifications deletes all notifications for a user
 func DeleteAllNotifications(userID uint) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if err := database.DB.Where("user_id = ?", userID).Delete(&models.Notification{}).Error; err != nil {
@@ -220,7 +221,7 @@ func DeleteAllNotifications(userID uint) error {
 // GetUnreadNotificationCount returns the count of unread notifications for a user
 func GetUnreadNotificationCount(userID uint) (int64, error) {
 	if userID == 0 {
-		return 0, errors.New("user ID is required")
+		return 0, ErrUserIDRequired
 	}
 
 	var count int64
@@ -236,7 +237,7 @@ func GetUnreadNotificationCount(userID uint) (int64, error) {
 // GetNotificationsByType retrieves notifications by type for a user
 func GetNotificationsByType(userID uint, notificationType string, pagination types.PaginationRequest) ([]NotificationData, *types.PaginationResponse, error) {
 	if userID == 0 {
-		return nil, nil, errors.New("user ID is required")
+		return nil, nil, ErrUserIDRequired
 	}
 
 	if notificationType == "" {
@@ -297,37 +298,6 @@ func BulkCreateNotifications(notifications []models.Notification) error {
 	return nil
 }
 
-// SendNotificationToAllUsers creates a notification for all active users
-func SendNotificationToAllUsers(notificationType, message string) error {
-	if notificationType == "" {
-		return errors.New("notification type is required")
-	}
-
-	if message == "" {
-		return errors.New("message is required")
-	}
-
-	// Get all active users
-	var users []models.User
-	if err := database.DB.Select("user_id").Where("is_verified = ? AND is_blocked = ?", true, false).Find(&users).Error; err != nil {
-		return fmt.Errorf("failed to get users: %w", err)
-	}
-
-	// Prepare notifications
-	var notifications []models.Notification
-	for _, user := range users {
-		notifications = append(notifications, models.Notification{
-			UserID:  user.ID,
-			Type:    models.NotificationType(notificationType),
-			Message: message,
-			Read:    false,
-		})
-	}
-
-	// Bulk create notifications
-	return BulkCreateNotifications(notifications)
-}
-
 // Helper functions
 
 // getNotificationTitle returns a user-friendly title for notification types
@@ -429,6 +399,9 @@ func MarkNotificationReadService(notificationID string, userID string) error {
 		return errors.New("notification not found or already read")
 	}
 
+	if id, err := strconv.ParseUint(userID, 10, 32); err == nil {
+		jobs.PublishUnreadCount(uint(id))
+	}
 	return nil
 }
 
@@ -442,6 +415,9 @@ func MarkAllNotificationsReadService(userID string) (int64, error) {
 		return 0, fmt.Errorf("failed to mark all notifications as read: %w", result.Error)
 	}
 
+	if id, err := strconv.ParseUint(userID, 10, 32); err == nil && result.RowsAffected > 0 {
+		jobs.PublishUnreadCount(uint(id))
+	}
 	return result.RowsAffected, nil
 }
 
@@ -472,6 +448,7 @@ func CreateNotificationService(request types.CreateNotificationRequest, adminID
 	if err := database.DB.Create(&notification).Error; err != nil {
 		return nil, fmt.Errorf("failed to create notification: %w", err)
 	}
+	jobs.PublishNotificationCreated(notification)
 
 	response := &types.CreateNotificationResponse{
 		ID:        uint32(notification.ID),
@@ -541,7 +518,7 @@ func GetNotificationDetailsService(notificationID string, userID string, isAdmin
 // GetRecentNotifications retrieves recent notifications for a user
 func GetRecentNotifications(userID uint32, limit int) ([]types.NotificationResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	if limit <= 0 || limit > 50 {
@@ -576,7 +553,7 @@ func GetRecentNotifications(userID uint32, limit int) ([]types.NotificationRespo
 
 func NotificationMarkReadBulkService(userID uint, notificationIDs []uint) (int64, error) {
 	if userID == 0 {
-		return 0, errors.New("user ID is required")
+		return 0, ErrUserIDRequired
 	}
 
 	if len(notificationIDs) == 0 {
@@ -591,12 +568,15 @@ func NotificationMarkReadBulkService(userID uint, notificationIDs []uint) (int64
 		return 0, fmt.Errorf("failed to mark notifications as read: %w", result.Error)
 	}
 
+	if result.RowsAffected > 0 {
+		jobs.PublishUnreadCount(userID)
+	}
 	return result.RowsAffected, nil
 }
 
 func NotificationDeleteBulkService(userID uint, notificationIDs []uint) (int64, error) {
 	if userID == 0 {
-		return 0, errors.New("user ID is required")
+		return 0, ErrUserIDRequired
 	}
 
 	if len(notificationIDs) == 0 {
@@ -611,5 +591,8 @@ func NotificationDeleteBulkService(userID uint, notificationIDs []uint) (int64,
 		return 0, fmt.Errorf("failed to delete notifications: %w", result.Error)
 	}
 
+	if result.RowsAffected > 0 {
+		jobs.PublishUnreadCount(userID)
+	}
 	return result.RowsAffected, nil
 }
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FindP2PRecipient looks up the JeanPay user a transfer would be sent to
+func FindP2PRecipient(senderID uint, identifier string) (types.P2PRecipientResponse, error) {
+	recipient, err := findP2PRecipientUser(senderID, identifier)
+	if err != nil {
+		return types.P2PRecipientResponse{}, err
+	}
+	return toP2PRecipientResponse(recipient), nil
+}
+
+// SendP2PTransfer moves funds from the sender's wallet to another JeanPay user's wallet,
+// converting at the current rate when the currencies differ
+func SendP2PTransfer(senderID uint, req types.P2PTransferRequest) (*types.P2PTransferResponse, error) {
+	if senderID == 0 {
+		return nil, ErrUserIDRequired
+	}
+	if req.Amount <= 0 {
+		return nil, ErrAmountNotPositive
+	}
+	if req.ToCurrency == "" {
+		req.ToCurrency = req.FromCurrency
+	}
+	if err := validateCorridor(req.FromCurrency, req.ToCurrency, req.Amount); err != nil {
+		return nil, err
+	}
+
+	recipient, err := findP2PRecipientUser(senderID, req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyStepUp(senderID, req.StepUpCredentials, req.Amount, req.FromCurrency); err != nil {
+		return nil, err
+	}
+
+	rate := 1.0
+	if req.FromCurrency != req.ToCurrency {
+		rate, err = getCurrentExchangeRate(req.FromCurrency, req.ToCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+		}
+	}
+	fromAmount := utils.RoundToCurrency(req.Amount, req.FromCurrency)
+	toAmount := utils.RoundToCurrency(fromAmount*rate, req.ToCurrency)
+	if toAmount <= 0 {
+		return nil, i18n.NewError("error.transfer_amount_too_small", nil)
+	}
+
+	var sender models.User
+	if err := database.DB.First(&sender, senderID).Error; err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	transfer, err := executeP2PTransfer(sender, recipient, p2pPosting{
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		FromAmount:   fromAmount,
+		ToAmount:     toAmount,
+		Rate:         rate,
+		Note:         req.Note,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyP2PTransfer(sender, recipient, transfer)
+
+	return &types.P2PTransferResponse{
+		TransferID:    transfer.TransferID,
+		TransactionID: transfer.SenderTransactionID,
+		Recipient:     toP2PRecipientResponse(recipient),
+		FromCurrency:  req.FromCurrency,
+		ToCurrency:    req.ToCurrency,
+		FromAmount:    fromAmount,
+		ToAmount:      toAmount,
+		Rate:          rate,
+		Note:          req.Note,
+		Status:        string(models.TransactionCompleted),
+		CreatedAt:     transfer.CreatedAt,
+	}, nil
+}
+
+// Helper functions
+
+// p2pPosting is a priced transfer ready to be posted to both wallets
+type p2pPosting struct {
+	FromCurrency string
+	ToCurrency   string
+	FromAmount   float64
+	ToAmount     float64
+	Rate         float64
+	Note         string
+}
+
+// executeP2PTransfer debits the sender, credits the recipient and records both sides in one
+// database transaction. within, when set, runs inside the same transaction before commit.
+func executeP2PTransfer(sender, recipient models.User, posting p2pPosting, within func(tx *gorm.DB, transfer *models.P2PTransfer) error) (models.P2PTransfer, error) {
+	var transfer models.P2PTransfer
+
+	// Make sure the recipient has a wallet in the currency being credited
+	if _, err := findOrCreateWallet(recipient.ID); err != nil {
+		return transfer, fmt.Errorf("failed to access recipient wallets: %w", err)
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+
+	// Debit only if the balance covers the amount, so concurrent sends cannot overdraw
+	debit := tx.Model(&models.Wallet{}).
+		Where("user_id = ? AND currency = ? AND balance >= ?", sender.ID, posting.FromCurrency, posting.FromAmount).
+		Updates(map[string]any{
+			"balance":             gorm.Expr("balance - ?", posting.FromAmount),
+			"last_transaction_at": now,
+		})
+	if debit.Error != nil {
+		tx.Rollback()
+		return transfer, fmt.Errorf("failed to debit wallet: %w", debit.Error)
+	}
+	if debit.RowsAffected == 0 {
+		tx.Rollback()
+		return transfer, ErrInsufficientBalance
+	}
+
+	credit := tx.Model(&models.Wallet{}).
+		Where("user_id = ? AND currency = ?", recipient.ID, posting.ToCurrency).
+		Updates(map[string]any{
+			"balance":             gorm.Expr("balance + ?", posting.ToAmount),
+			"last_transaction_at": now,
+		})
+	if credit.Error != nil {
+		tx.Rollback()
+		return transfer, fmt.Errorf("failed to credit recipient wallet: %w", credit.Error)
+	}
+	if credit.RowsAffected == 0 {
+		tx.Rollback()
+		return transfer, i18n.NewError("error.recipient_wallet_missing", i18n.Args{"currency": posting.ToCurrency})
+	}
+
+	direction := getP2PDirection(posting.FromCurrency, posting.ToCurrency)
+	details := models.TransactionDetails{
+		RecipientName:   strings.TrimSpace(recipient.FirstName + " " + recipient.LastName),
+		AccountNumber:   recipient.Username,
+		FromCurrency:    posting.FromCurrency,
+		ToCurrency:      posting.ToCurrency,
+		FromAmount:      posting.FromAmount,
+		ToAmount:        posting.ToAmount,
+		MethodOfPayment: string(models.PaymentTypeWallet),
+	}
+
+	senderTransaction := models.Transaction{
+		UserID:             sender.ID,
+		TransactionID:      uuid.New().String(),
+		PaymentType:        models.PaymentTypeWallet,
+		Status:             models.TransactionCompleted,
+		TransactionType:    models.P2P,
+		Reference:          generateTransactionReference("P2P"),
+		Direction:          direction,
+		Description:        fmt.Sprintf("Sent %s to @%s", utils.FormatCurrency(posting.FromAmount, posting.FromCurrency), recipient.Username),
+		Reason:             posting.Note,
+		TransactionDetails: details,
+	}
+	if err := tx.Create(&senderTransaction).Error; err != nil {
+		tx.Rollback()
+		return transfer, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	recipientTransaction := models.Transaction{
+		UserID:             recipient.ID,
+		TransactionID:      uuid.New().String(),
+		PaymentType:        models.PaymentTypeWallet,
+		Status:             models.TransactionCompleted,
+		TransactionType:    models.P2P,
+		Reference:          generateTransactionReference("P2P"),
+		Direction:          direction,
+		Description:        fmt.Sprintf("Received %s from @%s", utils.FormatCurrency(posting.ToAmount, posting.ToCurrency), sender.Username),
+		Reason:             posting.Note,
+		TransactionDetails: details,
+	}
+	if err := tx.Create(&recipientTransaction).Error; err != nil {
+		tx.Rollback()
+		return transfer, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	transfer = models.P2PTransfer{
+		TransferID:             uuid.New().String(),
+		SenderID:               sender.ID,
+		RecipientID:            recipient.ID,
+		FromCurrency:           posting.FromCurrency,
+		ToCurrency:             posting.ToCurrency,
+		FromAmount:             posting.FromAmount,
+		ToAmount:               posting.ToAmount,
+		Rate:                   posting.Rate,
+		Note:                   posting.Note,
+		SenderTransactionID:    senderTransaction.TransactionID,
+		RecipientTransactionID: recipientTransaction.TransactionID,
+		Status:                 models.TransactionCompleted,
+	}
+	if err := tx.Create(&transfer).Error; err != nil {
+		tx.Rollback()
+		return transfer, fmt.Errorf("failed to record transfer: %w", err)
+	}
+
+	if within != nil {
+		if err := within(tx, &transfer); err != nil {
+			tx.Rollback()
+			return transfer, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return transfer, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return transfer, nil
+}
+
+// findP2PRecipientUser matches a username (with or without @), email or phone number
+func findP2PRecipientUser(senderID uint, identifier string) (models.User, error) {
+	identifier = strings.TrimPrefix(strings.TrimSpace(identifier), "@")
+	if identifier == "" {
+		return models.User{}, i18n.NewError("error.recipient_required", nil)
+	}
+
+	var users []models.User
+	if err := database.DB.
+		Where("LOWER(username) = LOWER(?) OR LOWER(email) = LOWER(?) OR phone_number = ?", identifier, identifier, identifier).
+		Limit(2).Find(&users).Error; err != nil {
+		return models.User{}, fmt.Errorf("failed to find recipient: %w", err)
+	}
+
+	if len(users) == 0 {
+		return models.User{}, i18n.NewError("error.recipient_not_found", nil)
+	}
+	if len(users) > 1 {
+		return models.User{}, i18n.NewError("error.recipient_ambiguous", nil)
+	}
+
+	recipient := users[0]
+	if recipient.ID == senderID {
+		return models.User{}, i18n.NewError("error.transfer_to_self", nil)
+	}
+	if recipient.IsBlocked {
+		return models.User{}, i18n.NewError("error.recipient_unavailable", nil)
+	}
+	return recipient, nil
+}
+
+func getP2PDirection(fromCurrency, toCurrency string) models.TransactionDirection {
+	if fromCurrency != toCurrency {
+		return models.ConversionDirection(fromCurrency, toCurrency)
+	}
+	return models.P2PDirection(fromCurrency)
+}
+
+// notifyP2PTransfer tells both parties about a completed transfer
+func notifyP2PTransfer(sender, recipient models.User, transfer models.P2PTransfer) {
+	activityClient := jobs.NewActivityJobClient()
+	defer activityClient.Close()
+
+	sent := utils.FormatCurrency(transfer.FromAmount, transfer.FromCurrency)
+	received := utils.FormatCurrency(transfer.ToAmount, transfer.ToCurrency)
+
+	notifyAndLog(Notice{
+		UserID:   sender.ID,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.money_sent.title", nil),
+		Message: i18n.Msg("notification.money_sent.message", i18n.Args{
+			"amount":   i18n.Amount(transfer.FromAmount, transfer.FromCurrency),
+			"username": recipient.Username,
+		}),
+	})
+	notifyAndLog(Notice{
+		UserID:   recipient.ID,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.money_received.title", nil),
+		Message: i18n.Msg("notification.money_received.message", i18n.Args{
+			"amount":   i18n.Amount(transfer.ToAmount, transfer.ToCurrency),
+			"username": sender.Username,
+		}),
+	})
+
+	activityClient.EnqueueNewActivity(sender.ID, fmt.Sprintf("Sent %s to @%s", sent, recipient.Username))
+	activityClient.EnqueueNewActivity(recipient.ID, fmt.Sprintf("Received %s from @%s", received, sender.Username))
+}
+
+func toP2PRecipientResponse(user models.User) types.P2PRecipientResponse {
+	return types.P2PRecipientResponse{
+		UserID:         user.ID,
+		Username:       user.Username,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		ProfilePicture: user.ProfilePicture,
+	}
+}
@@ -0,0 +1,613 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+// CreatePaymentRequest asks a JeanPay user for money, or creates an open link when no payer is given
+func CreatePaymentRequest(requesterID uint, req types.CreatePaymentRequestRequest) (*types.PaymentRequestResponse, error) {
+	if requesterID == 0 {
+		return nil, ErrUserIDRequired
+	}
+	if req.Amount <= 0 {
+		return nil, ErrAmountNotPositive
+	}
+	if !isValidCurrency(req.Currency) {
+		return nil, invalidCurrencyError("error.invalid_currency")
+	}
+
+	var payer *models.User
+	if strings.TrimSpace(req.Payer) != "" {
+		user, err := findP2PRecipientUser(requesterID, req.Payer)
+		if err != nil {
+			return nil, err
+		}
+		payer = &user
+	}
+
+	expiry := constants.PaymentRequestDefaultExpiry
+	if req.ExpiresInHours > 0 {
+		expiry = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+	now := time.Now()
+
+	request := models.PaymentRequest{
+		Code:        libs.GenerateRandomString(12),
+		RequesterID: requesterID,
+		Amount:      utils.RoundCurrency(req.Amount),
+		Currency:    req.Currency,
+		Note:        strings.TrimSpace(req.Note),
+		Status:      models.PaymentRequestPending,
+		ExpiresAt:   now.Add(expiry),
+	}
+	if payer != nil {
+		request.PayerID = &payer.ID
+	}
+
+	if err := database.DB.Create(&request).Error; err != nil {
+		return nil, fmt.Errorf("failed to create payment request: %w", err)
+	}
+
+	request, err := findPaymentRequest(request.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	jobClient := jobs.NewPaymentRequestJobClient()
+	defer jobClient.Close()
+	if err := jobClient.ScheduleExpiry(request.ID, request.ExpiresAt); err != nil {
+		log.Printf("Failed to schedule payment request expiry: %v", err)
+	}
+	if payer != nil {
+		// Remind the payer halfway to the deadline
+		if err := jobClient.ScheduleReminder(request.ID, now.Add(expiry/2)); err != nil {
+			log.Printf("Failed to schedule payment request reminder: %v", err)
+		}
+
+		notifyAndLog(Notice{
+			UserID:   payer.ID,
+			Category: models.CategoryPaymentRequests,
+			Type:     models.TransferType,
+			Title:    i18n.Msg("notification.payment_request_received.title", nil),
+			Message: i18n.Msg("notification.payment_request_received.message", i18n.Args{
+				"username": request.Requester.Username,
+				"amount":   i18n.Amount(request.Amount, request.Currency),
+			}),
+		})
+	}
+
+	response := toPaymentRequestResponse(request)
+	return &response, nil
+}
+
+// GetPaymentRequests lists requests the user sent, or with role "received", the ones addressed to them
+func GetPaymentRequests(userID uint, query types.PaymentRequestQuery) (*types.PaymentRequestsResponse, error) {
+	page, limit := types.ValidatePagination(query.Page, query.Limit)
+
+	owner := "requester_id = ?"
+	if query.Role == "received" {
+		owner = "payer_id = ?"
+	}
+
+	// Expire overdue requests now rather than waiting for their scheduled job
+	if err := database.DB.Model(&models.PaymentRequest{}).
+		Where(owner, userID).
+		Where("status = ? AND expires_at <= ?", models.PaymentRequestPending, time.Now()).
+		Update("status", models.PaymentRequestExpired).Error; err != nil {
+		return nil, fmt.Errorf("failed to expire payment requests: %w", err)
+	}
+
+	db := database.DB.Model(&models.PaymentRequest{}).Where(owner, userID)
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count payment requests: %w", err)
+	}
+
+	var requests []models.PaymentRequest
+	if err := db.Preload("Requester").Preload("Payer").
+		Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).
+		Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch payment requests: %w", err)
+	}
+
+	response := &types.PaymentRequestsResponse{
+		Requests:   make([]types.PaymentRequestResponse, 0, len(requests)),
+		Pagination: types.NewPaginationResponse(page, limit, total),
+	}
+	for _, request := range requests {
+		response.Requests = append(response.Requests, toPaymentRequestResponse(request))
+	}
+	return response, nil
+}
+
+// GetPaymentRequest returns a request to its requester, its addressed payer, or anyone if it is an open link
+func GetPaymentRequest(userID uint, code string) (*types.PaymentRequestResponse, error) {
+	request, err := findPaymentRequest(code)
+	if err != nil {
+		return nil, err
+	}
+	if request.RequesterID != userID && request.PayerID != nil && *request.PayerID != userID {
+		return nil, i18n.NewError("error.payment_request_not_found", nil)
+	}
+
+	response := toPaymentRequestResponse(request)
+	return &response, nil
+}
+
+// GetPaymentLink returns the public view of a payment link, without the payer's details
+func GetPaymentLink(code string) (*types.PaymentRequestResponse, error) {
+	request, err := findPaymentRequest(code)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toPaymentRequestResponse(request)
+	response.Payer = nil
+	return &response, nil
+}
+
+// PayPaymentRequest settles a request from the payer's wallet, converting when paying from another currency
+func PayPaymentRequest(payerID uint, code string, req types.PayPaymentRequestRequest) (*types.PaymentRequestResponse, error) {
+	request, err := findPaymentRequest(code)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePaymentRequestPayable(request); err != nil {
+		return nil, err
+	}
+	if request.RequesterID == payerID {
+		return nil, i18n.NewError("error.payment_request_own", nil)
+	}
+	if request.PayerID != nil && *request.PayerID != payerID {
+		return nil, i18n.NewError("error.payment_request_other_payer", nil)
+	}
+	if request.Requester.IsBlocked {
+		return nil, i18n.NewError("error.payment_requester_unavailable", nil)
+	}
+
+	fromCurrency := req.FromCurrency
+	if fromCurrency == "" {
+		fromCurrency = request.Currency
+	}
+
+	rate := 1.0
+	fromAmount := request.Amount
+	if fromCurrency != request.Currency {
+		if err := validateCorridor(fromCurrency, request.Currency, 0); err != nil {
+			return nil, err
+		}
+		rate, err = getCurrentExchangeRate(fromCurrency, request.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+		}
+		fromAmount = utils.RoundToCurrency(request.Amount/rate, fromCurrency)
+	}
+
+	if err := VerifyStepUp(payerID, req.StepUpCredentials, fromAmount, fromCurrency); err != nil {
+		return nil, err
+	}
+
+	var payer models.User
+	if err := database.DB.First(&payer, payerID).Error; err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	note := request.Note
+	if note == "" {
+		note = "Payment request " + request.Code
+	}
+
+	transfer, err := executeP2PTransfer(payer, request.Requester, p2pPosting{
+		FromCurrency: fromCurrency,
+		ToCurrency:   request.Currency,
+		FromAmount:   fromAmount,
+		ToAmount:     request.Amount,
+		Rate:         rate,
+		Note:         note,
+	}, func(tx *gorm.DB, transfer *models.P2PTransfer) error {
+		result := tx.Model(&models.PaymentRequest{}).
+			Where("id = ? AND status = ?", request.ID, models.PaymentRequestPending).
+			Updates(map[string]any{
+				"status":         models.PaymentRequestPaid,
+				"paid_at":        time.Now(),
+				"paid_by_id":     payerID,
+				"payer_id":       payerID,
+				"transaction_id": transfer.RecipientTransactionID,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update payment request: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return i18n.NewError("error.payment_request_not_pending", nil)
+		}
+
+		return tx.Model(&models.Transaction{}).
+			Where("transaction_id IN ?", []string{transfer.SenderTransactionID, transfer.RecipientTransactionID}).
+			Update("payment_request_id", request.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyP2PTransfer(payer, request.Requester, transfer)
+
+	request, err = findPaymentRequest(code)
+	if err != nil {
+		return nil, err
+	}
+	response := toPaymentRequestResponse(request)
+	return &response, nil
+}
+
+// DeclinePaymentRequest lets the addressed payer turn a request down
+func DeclinePaymentRequest(userID uint, code string) (*types.PaymentRequestResponse, error) {
+	request, err := findPaymentRequest(code)
+	if err != nil {
+		return nil, err
+	}
+	if request.PayerID == nil || *request.PayerID != userID {
+		return nil, i18n.NewError("error.payment_request_decline_payer_only", nil)
+	}
+	if err := ensurePaymentRequestPayable(request); err != nil {
+		return nil, err
+	}
+
+	result := database.DB.Model(&models.PaymentRequest{}).
+		Where("id = ? AND status = ?", request.ID, models.PaymentRequestPending).
+		Update("status", models.PaymentRequestDeclined)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to decline payment request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, i18n.NewError("error.payment_request_not_pending", nil)
+	}
+	request.Status = models.PaymentRequestDeclined
+
+	notifyAndLog(Notice{
+		UserID:   request.RequesterID,
+		Category: models.CategoryPaymentRequests,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.payment_request_declined.title", nil),
+		Message: i18n.Msg("notification.payment_request_declined.message", i18n.Args{
+			"username": request.Payer.Username,
+			"amount":   i18n.Amount(request.Amount, request.Currency),
+		}),
+	})
+
+	response := toPaymentRequestResponse(request)
+	return &response, nil
+}
+
+// StartPaymentRequestCheckout opens a Paystack checkout that pays the request into the requester's wallet.
+// Only link requests can be paid by card; a request addressed to a user is paid from their wallet.
+func StartPaymentRequestCheckout(code string, req types.PaymentRequestCheckoutRequest) (*types.PaymentRequestCheckoutResponse, error) {
+	request, err := findPaymentRequest(code)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePaymentRequestPayable(request); err != nil {
+		return nil, err
+	}
+	if request.PayerID != nil {
+		return nil, i18n.NewError("error.payment_request_wallet_only", nil)
+	}
+
+	paystack, err := NewPaystackConfigFromEnv()
+	if err != nil {
+		return nil, i18n.NewError("error.card_payments_unavailable", nil)
+	}
+
+	transactionIdx, err := libs.SecureRandomNumber(12)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate transaction ID: %w", err)
+	}
+	reference := generateTransactionReference("PAYREQ")
+
+	transaction := models.Transaction{
+		UserID:           request.RequesterID,
+		TransactionID:    fmt.Sprintf("PRQ%d", transactionIdx),
+		PaymentType:      models.PaymentTypeCheckout,
+		Status:           models.TransactionPending,
+		TransactionType:  models.Deposit,
+		Reference:        reference,
+		Direction:        models.DepositDirection(request.Currency),
+		Description:      fmt.Sprintf("Payment request %s paid by %s", request.Code, req.Email),
+		PaymentRequestID: &request.ID,
+		TransactionDetails: models.TransactionDetails{
+			RecipientName:   strings.TrimSpace(request.Requester.FirstName + " " + request.Requester.LastName),
+			FromCurrency:    request.Currency,
+			ToCurrency:      request.Currency,
+			FromAmount:      request.Amount,
+			ToAmount:        request.Amount,
+			MethodOfPayment: "checkout",
+		},
+	}
+	if err := database.DB.Create(&transaction).Error; err != nil {
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	// Paystack takes whole units, so round up rather than short the requester
+	checkout, err := paystack.InitializeNewTransaction(InitializeNewTransactionPayload{
+		TransactionId: reference,
+		Email:         req.Email,
+		Amount:        int(math.Ceil(request.Amount)),
+		Currency:      request.Currency,
+	})
+	if err == nil && !checkout.Status {
+		err = errors.New(checkout.Message)
+	}
+	if err != nil {
+		database.DB.Model(&transaction).Update("status", models.TransactionFailed)
+		publishTransactionStatus(transaction, models.TransactionFailed)
+		return nil, fmt.Errorf("failed to start checkout: %w", err)
+	}
+
+	return &types.PaymentRequestCheckoutResponse{
+		AuthorizationURL: checkout.Data.AuthorizationUrl,
+		Reference:        reference,
+	}, nil
+}
+
+// ConfirmPaymentRequestCheckout verifies a checkout with Paystack and settles the request once paid
+func ConfirmPaymentRequestCheckout(reference string) error {
+	paystack, err := NewPaystackConfigFromEnv()
+	if err != nil {
+		return i18n.NewError("error.card_payments_unavailable", nil)
+	}
+
+	paid, err := paystack.GetTransactionDetails(reference)
+	if err != nil {
+		return err
+	}
+	if !paid {
+		return i18n.NewError("error.payment_incomplete", nil)
+	}
+
+	handled, err := completePaymentRequestCheckout(reference)
+	if err != nil {
+		return err
+	}
+	if !handled {
+		return i18n.NewError("error.payment_not_found", nil)
+	}
+	return nil
+}
+
+// HandlePaymentRequestReminderTask reminds the payer about a request that is still pending
+func HandlePaymentRequestReminderTask(ctx context.Context, t *asynq.Task) error {
+	request, err := loadPaymentRequest(t)
+	if err != nil {
+		return err
+	}
+
+	if request.Status != models.PaymentRequestPending || request.PayerID == nil || time.Now().After(request.ExpiresAt) {
+		log.Printf("Skipping reminder for payment request %d: no longer pending", request.ID)
+		return nil
+	}
+
+	err = Notify(Notice{
+		UserID:   *request.PayerID,
+		Category: models.CategoryPaymentRequests,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.payment_request_reminder.title", nil),
+		Message: i18n.Msg("notification.payment_request_reminder.message", i18n.Args{
+			"username":  request.Requester.Username,
+			"amount":    i18n.Amount(request.Amount, request.Currency),
+			"expiresAt": request.ExpiresAt,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send reminder notification: %w", err)
+	}
+
+	if err := database.DB.Model(&request).Update("reminders_sent", gorm.Expr("reminders_sent + 1")).Error; err != nil {
+		return fmt.Errorf("failed to update payment request: %w", err)
+	}
+
+	log.Printf("Payment request reminder sent: id=%d payer_id=%d", request.ID, *request.PayerID)
+	return nil
+}
+
+// HandlePaymentRequestExpireTask expires a request that was not settled in time
+func HandlePaymentRequestExpireTask(ctx context.Context, t *asynq.Task) error {
+	request, err := loadPaymentRequest(t)
+	if err != nil {
+		return err
+	}
+
+	result := database.DB.Model(&models.PaymentRequest{}).
+		Where("id = ? AND status = ? AND expires_at <= ?", request.ID, models.PaymentRequestPending, time.Now()).
+		Update("status", models.PaymentRequestExpired)
+	if result.Error != nil {
+		return fmt.Errorf("failed to expire payment request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	// The request is already expired, so a retry would find nothing to do
+	notifyAndLog(Notice{
+		UserID:   request.RequesterID,
+		Category: models.CategoryPaymentRequests,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.payment_request_expired.title", nil),
+		Message:  i18n.Msg("notification.payment_request_expired.message", i18n.Args{"amount": i18n.Amount(request.Amount, request.Currency)}),
+	})
+
+	log.Printf("Payment request expired: id=%d", request.ID)
+	return nil
+}
+
+func loadPaymentRequest(t *asynq.Task) (models.PaymentRequest, error) {
+	var payload jobs.PaymentRequestJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return models.PaymentRequest{}, fmt.Errorf("failed to unmarshal payment request payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.RequestID == 0 {
+		return models.PaymentRequest{}, fmt.Errorf("request_id is required: %w", asynq.SkipRetry)
+	}
+
+	var request models.PaymentRequest
+	if err := database.DB.Preload("Requester").First(&request, payload.RequestID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return request, fmt.Errorf("payment request %d not found: %w", payload.RequestID, asynq.SkipRetry)
+		}
+		return request, fmt.Errorf("failed to fetch payment request: %w", err)
+	}
+	return request, nil
+}
+
+// Helper functions
+
+// completePaymentRequestCheckout credits the requester for a paid checkout and marks the request paid.
+// Only the checkout that settles the request is credited; any other paid checkout for it is marked
+// refund due. It reports false when the reference does not belong to a payment request.
+func completePaymentRequestCheckout(reference string) (bool, error) {
+	var transaction models.Transaction
+	err := database.DB.Preload("TransactionDetails").
+		Where("reference = ? AND payment_request_id IS NOT NULL", reference).
+		First(&transaction).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// A checkout started before expiry still settles the request, even if it was paid late
+	settled := tx.Model(&models.PaymentRequest{}).
+		Where("id = ? AND status IN ?", *transaction.PaymentRequestID, []models.PaymentRequestStatus{models.PaymentRequestPending, models.PaymentRequestExpired}).
+		Updates(map[string]any{
+			"status":         models.PaymentRequestPaid,
+			"paid_at":        time.Now(),
+			"transaction_id": transaction.TransactionID,
+		})
+	if settled.Error != nil {
+		tx.Rollback()
+		return true, fmt.Errorf("failed to update payment request: %w", settled.Error)
+	}
+
+	status := models.TransactionCompleted
+	if settled.RowsAffected == 0 {
+		status = models.TransactionRefundDue
+	}
+
+	result := tx.Model(&models.Transaction{}).
+		Where("id = ? AND status = ?", transaction.ID, models.TransactionPending).
+		Updates(map[string]any{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		tx.Rollback()
+		return true, fmt.Errorf("failed to update transaction: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		// Already settled by the webhook or an earlier confirmation
+		tx.Rollback()
+		return true, nil
+	}
+
+	details := transaction.TransactionDetails
+	if status == models.TransactionCompleted {
+		if err := updateWalletBalance(tx, transaction.UserID, details.ToCurrency, details.ToAmount, "deposit"); err != nil {
+			tx.Rollback()
+			return true, fmt.Errorf("failed to update wallet: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return true, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	publishTransactionStatus(transaction, status)
+
+	if status == models.TransactionRefundDue {
+		log.Printf("Payment request %d was already settled; checkout %s is due a refund", *transaction.PaymentRequestID, reference)
+		return true, nil
+	}
+
+	notifyAndLog(Notice{
+		UserID:   transaction.UserID,
+		Category: models.CategoryPaymentRequests,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.payment_request_paid.title", nil),
+		Message:  i18n.Msg("notification.payment_request_paid.message", i18n.Args{"amount": i18n.Amount(details.ToAmount, details.ToCurrency)}),
+	})
+	return true, nil
+}
+
+func findPaymentRequest(code string) (models.PaymentRequest, error) {
+	var request models.PaymentRequest
+	if err := database.DB.Preload("Requester").Preload("Payer").Where("code = ?", code).First(&request).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return request, i18n.NewError("error.payment_request_not_found", nil)
+		}
+		return request, fmt.Errorf("failed to fetch payment request: %w", err)
+	}
+
+	if request.Status == models.PaymentRequestPending && time.Now().After(request.ExpiresAt) {
+		if err := database.DB.Model(&models.PaymentRequest{}).
+			Where("id = ? AND status = ?", request.ID, models.PaymentRequestPending).
+			Update("status", models.PaymentRequestExpired).Error; err != nil {
+			return request, fmt.Errorf("failed to expire payment request: %w", err)
+		}
+		request.Status = models.PaymentRequestExpired
+	}
+	return request, nil
+}
+
+func ensurePaymentRequestPayable(request models.PaymentRequest) error {
+	if request.Status != models.PaymentRequestPending {
+		return i18n.NewError("error.payment_request_status", i18n.Args{"status": request.Status})
+	}
+	return nil
+}
+
+func toPaymentRequestResponse(request models.PaymentRequest) types.PaymentRequestResponse {
+	response := types.PaymentRequestResponse{
+		Code:      request.Code,
+		Link:      FRONTEND + constants.PaymentRequestLinkPath + request.Code,
+		Requester: toP2PRecipientResponse(request.Requester),
+		Amount:    request.Amount,
+		Currency:  request.Currency,
+		Note:      request.Note,
+		Status:    string(request.Status),
+		ExpiresAt: request.ExpiresAt,
+		PaidAt:    request.PaidAt,
+		CreatedAt: request.CreatedAt,
+	}
+	if request.Payer != nil {
+		payer := toP2PRecipientResponse(*request.Payer)
+		response.Payer = &payer
+	}
+	return response
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 )
 
@@ -103,8 +105,76 @@ func (s *PaystackService) InitializeNewTransaction(trx InitializeNewTransactionP
 	return response, nil
 }
 
+type ResolveAccountNumberResponse struct {
+	Status  bool
+	Message string
+	Data    struct {
+		AccountNumber string `json:"account_number"`
+		AccountName   string `json:"account_name"`
+	}
+}
+
+// ResolveAccountNumber looks up the name on a bank or mobile money account
+func (s *PaystackService) ResolveAccountNumber(accountNumber, bankCode string) (ResolveAccountNumberResponse, error) {
+	query := url.Values{}
+	query.Set("account_number", accountNumber)
+	query.Set("bank_code", bankCode)
+
+	req, err := http.NewRequest("GET", s.config.BaseURL+"/bank/resolve?"+query.Encode(), nil)
+	if err != nil {
+		return ResolveAccountNumberResponse{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.SecretKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return ResolveAccountNumberResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	var response ResolveAccountNumberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return ResolveAccountNumberResponse{}, err
+	}
+	if resp.StatusCode != http.StatusOK || !response.Status {
+		return response, fmt.Errorf("account resolution failed: %s", response.Message)
+	}
+	return response, nil
+}
+
+type VerifyTransactionResponse struct {
+	Status  bool
+	Message string
+	Data    struct {
+		Status    string `json:"status"`
+		Reference string `json:"reference"`
+		Amount    int64  `json:"amount"`
+		Currency  string `json:"currency"`
+	}
+}
+
+// GetTransactionDetails verifies a transaction with Paystack and reports whether it was paid
 func (s *PaystackService) GetTransactionDetails(transactionID string) (bool, error) {
-	// Implementation for fetching transaction details from Paystack
-	// This is a placeholder; actual implementation will depend on the Paystack API
-	return false, nil
+	req, err := http.NewRequest("GET", s.config.BaseURL+"/transaction/verify/"+url.PathEscape(transactionID), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.SecretKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	var response VerifyTransactionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return false, err
+	}
+	if resp.StatusCode != http.StatusOK || !response.Status {
+		return false, fmt.Errorf("transaction verification failed: %s", response.Message)
+	}
+	return response.Data.Status == "success", nil
 }
@@ -0,0 +1,504 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/interfaces"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+// FCMConfig holds the settings for the FCM HTTP v1 API
+type FCMConfig struct {
+	BaseURL        string
+	ProjectID      string
+	ServiceAccount *FCMServiceAccount
+	Timeout        time.Duration
+}
+
+// FCMServiceAccount is the part of a Google service account key file used to authorise pushes
+type FCMServiceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// fcmScope is the OAuth2 scope that allows sending messages
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// FCMPushSender sends pushes through the FCM HTTP v1 API, authorised with an OAuth2 access
+// token obtained for a service account
+type FCMPushSender struct {
+	config *FCMConfig
+	client *http.Client
+	key    *rsa.PrivateKey
+	now    func() time.Time
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMPushSender creates a new FCM push adapter
+func NewFCMPushSender(config *FCMConfig) (*FCMPushSender, error) {
+	if config.ServiceAccount == nil || config.ServiceAccount.ClientEmail == "" || config.ServiceAccount.PrivateKey == "" {
+		return nil, errors.New("the FCM service account needs a client_email and private_key")
+	}
+	if config.ProjectID == "" {
+		config.ProjectID = config.ServiceAccount.ProjectID
+	}
+	if config.ProjectID == "" {
+		return nil, errors.New("an FCM project ID is required")
+	}
+	if config.ServiceAccount.TokenURI == "" {
+		config.ServiceAccount.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(config.ServiceAccount.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FCM service account private key: %w", err)
+	}
+
+	return &FCMPushSender{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		key:    key,
+		now:    time.Now,
+	}, nil
+}
+
+// fcmInvalidTokenErrors are the FCM v1 error codes that mean the token will never work again
+var fcmInvalidTokenErrors = map[string]bool{
+	"UNREGISTERED":       true,
+	"SENDER_ID_MISMATCH": true,
+}
+
+// SendPush posts a message for one device token
+func (f *FCMPushSender) SendPush(token string, message interfaces.PushMessage) error {
+	payload := map[string]any{
+		"message": map[string]any{
+			"token": token,
+			"notification": map[string]string{
+				"title": message.Title,
+				"body":  message.Body,
+			},
+			"data":    message.Data,
+			"android": map[string]any{"priority": "high"},
+			"apns":    map[string]any{"headers": map[string]string{"apns-priority": "10"}},
+		},
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push request: %w", err)
+	}
+
+	accessToken, err := f.token()
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", strings.TrimRight(f.config.BaseURL, "/"), f.config.ProjectID)
+	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return fmt.Errorf("failed to create push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach push service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read push service response: %w", err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var result struct {
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("push service returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	for _, detail := range result.Error.Details {
+		if fcmInvalidTokenErrors[detail.ErrorCode] {
+			return interfaces.ErrInvalidPushToken
+		}
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		// The next send fetches a fresh access token
+		f.mu.Lock()
+		f.accessToken = ""
+		f.mu.Unlock()
+	}
+	return fmt.Errorf("push service returned status %d (%s): %s", resp.StatusCode, result.Error.Status, result.Error.Message)
+}
+
+// token returns a cached access token, exchanging a signed service account assertion for a
+// new one shortly before the current one expires
+func (f *FCMPushSender) token() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	if f.accessToken != "" && now.Before(f.expiresAt.Add(-time.Minute)) {
+		return f.accessToken, nil
+	}
+
+	account := f.config.ServiceAccount
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   account.ClientEmail,
+		"scope": fcmScope,
+		"aud":   account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(f.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign FCM token request: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	resp, err := f.client.PostForm(account.TokenURI, form)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach FCM token endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read FCM token response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("FCM token endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to decode FCM token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", errors.New("FCM token endpoint did not return an access token")
+	}
+
+	f.accessToken = result.AccessToken
+	f.expiresAt = now.Add(time.Duration(result.ExpiresIn) * time.Second)
+	return f.accessToken, nil
+}
+
+// Name identifies the adapter in logs
+func (f *FCMPushSender) Name() string {
+	return "fcm"
+}
+
+// LogPushSender writes pushes to the log instead of sending them, for development
+type LogPushSender struct{}
+
+// SendPush logs the message
+func (LogPushSender) SendPush(token string, message interfaces.PushMessage) error {
+	log.Printf("Push to device %s: %s - %s", maskDeviceToken(token), message.Title, message.Body)
+	return nil
+}
+
+// Name identifies the adapter in logs
+func (LogPushSender) Name() string {
+	return "log"
+}
+
+// NewPushSenderFromEnv picks the push adapter named by PUSH_PROVIDER
+func NewPushSenderFromEnv() (interfaces.PushSender, error) {
+	switch provider := GetEnvOrDefault("PUSH_PROVIDER", "log"); provider {
+	case "log":
+		return LogPushSender{}, nil
+	case "fcm":
+		account, err := loadFCMServiceAccount()
+		if err != nil {
+			return nil, err
+		}
+		return NewFCMPushSender(&FCMConfig{
+			BaseURL:        GetEnvOrDefault("PUSH_BASE_URL", "https://fcm.googleapis.com"),
+			ProjectID:      os.Getenv("PUSH_PROJECT_ID"),
+			ServiceAccount: account,
+			Timeout:        time.Duration(getEnvIntOrDefault("PUSH_TIMEOUT", 10)) * time.Second,
+		})
+	default:
+		return nil, fmt.Errorf("unknown push provider %q", provider)
+	}
+}
+
+// loadFCMServiceAccount reads the service account key from PUSH_CREDENTIALS_JSON, or from the
+// file named by PUSH_CREDENTIALS_FILE
+func loadFCMServiceAccount() (*FCMServiceAccount, error) {
+	data := []byte(os.Getenv("PUSH_CREDENTIALS_JSON"))
+	if len(data) == 0 {
+		path := os.Getenv("PUSH_CREDENTIALS_FILE")
+		if path == "" {
+			return nil, errors.New("PUSH_CREDENTIALS_FILE or PUSH_CREDENTIALS_JSON is required for the fcm push provider")
+		}
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("failed to read FCM credentials: %w", err)
+		}
+	}
+
+	var account FCMServiceAccount
+	if err := json.Unmarshal(data, &account); err != nil {
+		return nil, fmt.Errorf("invalid FCM credentials: %w", err)
+	}
+	return &account, nil
+}
+
+// InitializeGlobalPushSender initializes the global push sender with configuration from environment
+func InitializeGlobalPushSender() error {
+	sender, err := NewPushSenderFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to initialize push sender: %w", err)
+	}
+
+	interfaces.SetGlobalPushSender(sender)
+	return nil
+}
+
+// GetDevices lists the devices registered for push, most recently seen first
+func GetDevices(userID uint) ([]types.DeviceResponse, error) {
+	var devices []models.DeviceToken
+	if err := database.DB.Where("user_id = ?", userID).Order("last_seen_at DESC").Find(&devices).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+
+	response := make([]types.DeviceResponse, 0, len(devices))
+	for _, device := range devices {
+		response = append(response, toDeviceResponse(device))
+	}
+	return response, nil
+}
+
+// RegisterDevice stores a push token for the user. Apps call this on every launch, so an
+// existing token is refreshed rather than duplicated, and taken over if it was registered
+// to someone who previously used the device.
+func RegisterDevice(userID uint, req types.RegisterDeviceRequest) (types.DeviceResponse, error) {
+	if userID == 0 {
+		return types.DeviceResponse{}, ErrUserIDRequired
+	}
+	token := strings.TrimSpace(req.Token)
+	if token == "" {
+		return types.DeviceResponse{}, i18n.NewError("error.device_token_required", nil)
+	}
+
+	var device models.DeviceToken
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("token = ?", token).First(&device).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			device = models.DeviceToken{
+				UserID:     userID,
+				Token:      token,
+				Platform:   models.DevicePlatform(req.Platform),
+				DeviceName: req.DeviceName,
+				LastSeenAt: time.Now(),
+			}
+			if err := tx.Create(&device).Error; err != nil {
+				return fmt.Errorf("failed to register device: %w", err)
+			}
+		case err != nil:
+			return fmt.Errorf("failed to fetch device: %w", err)
+		default:
+			if err := tx.Model(&device).Updates(map[string]any{
+				"user_id":      userID,
+				"platform":     req.Platform,
+				"device_name":  req.DeviceName,
+				"last_seen_at": time.Now(),
+			}).Error; err != nil {
+				return fmt.Errorf("failed to update device: %w", err)
+			}
+		}
+
+		return pruneDevices(tx, userID)
+	})
+	if err != nil {
+		return types.DeviceResponse{}, err
+	}
+
+	if err := database.DB.First(&device, device.ID).Error; err != nil {
+		return types.DeviceResponse{}, fmt.Errorf("failed to fetch device: %w", err)
+	}
+	return toDeviceResponse(device), nil
+}
+
+// UnregisterDevice forgets a push token, typically on logout
+func UnregisterDevice(userID uint, token string) error {
+	result := database.DB.Unscoped().
+		Where("user_id = ? AND token = ?", userID, strings.TrimSpace(token)).
+		Delete(&models.DeviceToken{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to unregister device: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return i18n.NewError("error.device_not_found", nil)
+	}
+	return nil
+}
+
+// HandlePushDeliveryTask sends a notification to each of the user's devices, unless the
+// platform or the user's preferences rule push out for its category. Tokens the provider
+// rejects are removed.
+func HandlePushDeliveryTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.PushJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal push payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.UserID == 0 {
+		return fmt.Errorf("user_id is required: %w", asynq.SkipRetry)
+	}
+
+	// Campaign pushes report their outcome back to the broadcast
+	report := func(failure string) {
+		if payload.CampaignID != nil {
+			recordCampaignDelivery(*payload.CampaignID, payload.UserID, models.ChannelPush, failure)
+		}
+	}
+
+	category := payload.Category
+	if category == "" {
+		category = categoryForType(payload.Type)
+	}
+	if !channelEnabled(payload.UserID, category, models.ChannelPush) {
+		report("push notifications are turned off")
+		return nil
+	}
+
+	var devices []models.DeviceToken
+	if err := database.DB.Where("user_id = ?", payload.UserID).Find(&devices).Error; err != nil {
+		return fmt.Errorf("failed to fetch devices: %w", err)
+	}
+	if len(devices) == 0 {
+		report("no registered devices")
+		return nil
+	}
+
+	pushSender := interfaces.GetGlobalPushSender()
+	if pushSender == nil {
+		return fmt.Errorf("push sender not initialized")
+	}
+
+	message := interfaces.PushMessage{
+		Title: payload.Title,
+		Body:  payload.Message,
+		Data:  map[string]string{"type": string(payload.Type)},
+	}
+
+	var sent, failed int
+	var lastErr error
+	for _, device := range devices {
+		err := pushSender.SendPush(device.Token, message)
+		switch {
+		case err == nil:
+			sent++
+		case errors.Is(err, interfaces.ErrInvalidPushToken):
+			if err := database.DB.Unscoped().Delete(&device).Error; err != nil {
+				log.Printf("Failed to remove invalid device token %d: %v", device.ID, err)
+			} else {
+				log.Printf("Removed invalid device token %d for user %d", device.ID, device.UserID)
+			}
+		default:
+			failed++
+			lastErr = err
+			log.Printf("Failed to push to device %d via %s: %v", device.ID, pushSender.Name(), err)
+		}
+	}
+
+	// Retrying would repeat the push on devices that already got it, so only retry when
+	// nothing went out
+	if sent == 0 && failed > 0 {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			report(lastErr.Error())
+		}
+		return fmt.Errorf("failed to send push: %w", lastErr)
+	}
+	if sent == 0 {
+		report("every device token was invalid")
+		return nil
+	}
+	report("")
+
+	log.Printf("Push sent to %d of %d devices for user %d via %s", sent, len(devices), payload.UserID, pushSender.Name())
+	return nil
+}
+
+// Helper functions
+
+// pruneDevices drops the least recently seen devices beyond the per-user limit
+func pruneDevices(tx *gorm.DB, userID uint) error {
+	var stale []uint
+	if err := tx.Model(&models.DeviceToken{}).
+		Where("user_id = ?", userID).
+		Order("last_seen_at DESC").
+		Offset(constants.PushMaxDevicesPerUser).
+		Pluck("id", &stale).Error; err != nil {
+		return fmt.Errorf("failed to fetch devices: %w", err)
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	if err := tx.Unscoped().Delete(&models.DeviceToken{}, stale).Error; err != nil {
+		return fmt.Errorf("failed to remove old devices: %w", err)
+	}
+	return nil
+}
+
+func maskDeviceToken(token string) string {
+	if len(token) <= 12 {
+		return "****"
+	}
+	return token[:6] + "..." + token[len(token)-6:]
+}
+
+func toDeviceResponse(device models.DeviceToken) types.DeviceResponse {
+	return types.DeviceResponse{
+		ID:         device.ID,
+		Platform:   string(device.Platform),
+		DeviceName: device.DeviceName,
+		LastSeenAt: device.LastSeenAt,
+		CreatedAt:  device.CreatedAt,
+	}
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+// GetRateAlerts returns the user's rate alerts, newest first
+func GetRateAlerts(userID uint) ([]types.RateAlertResponse, error) {
+	var alerts []models.RateAlert
+	if err := database.DB.Where("user_id = ?", userID).Order("created_at DESC").Find(&alerts).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch rate alerts: %w", err)
+	}
+
+	response := make([]types.RateAlertResponse, 0, len(alerts))
+	for _, alert := range alerts {
+		response = append(response, toRateAlertResponse(alert))
+	}
+	return response, nil
+}
+
+// GetRateAlert returns a single rate alert owned by the user
+func GetRateAlert(userID, alertID uint) (types.RateAlertResponse, error) {
+	alert, err := findRateAlert(userID, alertID)
+	if err != nil {
+		return types.RateAlertResponse{}, err
+	}
+	return toRateAlertResponse(alert), nil
+}
+
+// CreateRateAlert watches a currency pair for the user
+func CreateRateAlert(userID uint, req types.CreateRateAlertRequest) (types.RateAlertResponse, error) {
+	if userID == 0 {
+		return types.RateAlertResponse{}, ErrUserIDRequired
+	}
+	if req.FromCurrency == req.ToCurrency {
+		return types.RateAlertResponse{}, ErrSameCurrency
+	}
+	if err := validateCorridor(req.FromCurrency, req.ToCurrency, 0); err != nil {
+		return types.RateAlertResponse{}, err
+	}
+	if req.Threshold <= 0 {
+		return types.RateAlertResponse{}, i18n.NewError("error.threshold_not_positive", nil)
+	}
+
+	var count int64
+	if err := database.DB.Model(&models.RateAlert{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return types.RateAlertResponse{}, fmt.Errorf("failed to count rate alerts: %w", err)
+	}
+	if count >= constants.RateAlertMaxPerUser {
+		return types.RateAlertResponse{}, i18n.NewError("error.rate_alert_limit", i18n.Args{"count": constants.RateAlertMaxPerUser})
+	}
+
+	cooldown := req.CooldownMinutes
+	if cooldown == 0 {
+		cooldown = constants.RateAlertDefaultCooldown
+	}
+
+	alert := models.RateAlert{
+		UserID:          userID,
+		FromCurrency:    req.FromCurrency,
+		ToCurrency:      req.ToCurrency,
+		Direction:       models.RateAlertDirection(req.Direction),
+		Threshold:       req.Threshold,
+		CooldownMinutes: cooldown,
+		IsActive:        true,
+	}
+	if err := database.DB.Create(&alert).Error; err != nil {
+		return types.RateAlertResponse{}, fmt.Errorf("failed to create rate alert: %w", err)
+	}
+	return toRateAlertResponse(alert), nil
+}
+
+// UpdateRateAlert edits the threshold, cooldown or state of a rate alert
+func UpdateRateAlert(userID, alertID uint, req types.UpdateRateAlertRequest) (types.RateAlertResponse, error) {
+	alert, err := findRateAlert(userID, alertID)
+	if err != nil {
+		return types.RateAlertResponse{}, err
+	}
+
+	updates := map[string]any{}
+	if req.Direction != "" {
+		updates["direction"] = req.Direction
+	}
+	if req.Threshold > 0 {
+		updates["threshold"] = req.Threshold
+	}
+	if req.CooldownMinutes > 0 {
+		updates["cooldown_minutes"] = req.CooldownMinutes
+	}
+	if req.IsActive != nil {
+		updates["is_active"] = *req.IsActive
+	}
+	if len(updates) == 0 {
+		return toRateAlertResponse(alert), nil
+	}
+
+	if err := database.DB.Model(&alert).Updates(updates).Error; err != nil {
+		return types.RateAlertResponse{}, fmt.Errorf("failed to update rate alert: %w", err)
+	}
+
+	alert, err = findRateAlert(userID, alertID)
+	if err != nil {
+		return types.RateAlertResponse{}, err
+	}
+	return toRateAlertResponse(alert), nil
+}
+
+// DeleteRateAlert removes a rate alert owned by the user
+func DeleteRateAlert(userID, alertID uint) error {
+	result := database.DB.Where("id = ? AND user_id = ?", alertID, userID).Delete(&models.RateAlert{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete rate alert: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return i18n.NewError("error.rate_alert_not_found", nil)
+	}
+	return nil
+}
+
+// Helper functions
+
+// evaluateRateAlerts fires every active alert for the pair that the new rate crosses and that is
+// outside its cooldown, and returns how many fired
+func evaluateRateAlerts(fromCurrency, toCurrency string, rate float64) (int, error) {
+	var alerts []models.RateAlert
+	if err := database.DB.
+		Where("from_currency = ? AND to_currency = ? AND is_active = ?", fromCurrency, toCurrency, true).
+		Where("(direction = ? AND threshold <= ?) OR (direction = ? AND threshold >= ?)",
+			models.RateAlertAbove, rate, models.RateAlertBelow, rate).
+		Find(&alerts).Error; err != nil {
+		return 0, fmt.Errorf("failed to fetch rate alerts: %w", err)
+	}
+
+	now := time.Now()
+	fired := 0
+	for _, alert := range alerts {
+		quietUntil := now.Add(-time.Duration(alert.CooldownMinutes) * time.Minute)
+		if alert.LastTriggeredAt != nil && alert.LastTriggeredAt.After(quietUntil) {
+			continue
+		}
+
+		// Claim the alert so concurrent rate changes cannot both deliver it
+		claim := database.DB.Model(&models.RateAlert{}).
+			Where("id = ? AND (last_triggered_at IS NULL OR last_triggered_at <= ?)", alert.ID, quietUntil).
+			Updates(map[string]any{
+				"last_triggered_at":   now,
+				"last_triggered_rate": rate,
+				"trigger_count":       gorm.Expr("trigger_count + 1"),
+			})
+		if claim.Error != nil {
+			log.Printf("Failed to update rate alert %d: %v", alert.ID, claim.Error)
+			continue
+		}
+		if claim.RowsAffected == 0 {
+			continue
+		}
+
+		deliverRateAlert(alert, rate)
+		fired++
+	}
+	return fired, nil
+}
+
+// deliverRateAlert sends the alert on every channel the user's preferences allow
+func deliverRateAlert(alert models.RateAlert, rate float64) {
+	pair := fmt.Sprintf("%s/%s", alert.FromCurrency, alert.ToCurrency)
+	direction := i18n.Msg("rate_alert.direction."+string(alert.Direction), nil)
+
+	err := Notify(Notice{
+		UserID:   alert.UserID,
+		Category: models.CategoryRateAlerts,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.rate_alert.title", nil),
+		Message: i18n.Msg("notification.rate_alert.message", i18n.Args{
+			"pair":      pair,
+			"rate":      i18n.Decimal{Value: rate, Places: 4},
+			"direction": direction,
+			"threshold": i18n.Decimal{Value: alert.Threshold, Places: 4},
+		}),
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			locale := client.Locale()
+			return client.EnqueueRateAlertEmail(user.Email, user.FirstName, pair, locale.Render(direction),
+				locale.Number(alert.Threshold, 4), locale.Number(rate, 4))
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to deliver rate alert %d: %v", alert.ID, err)
+	}
+}
+
+func findRateAlert(userID, alertID uint) (models.RateAlert, error) {
+	var alert models.RateAlert
+	if err := database.DB.Where("id = ? AND user_id = ?", alertID, userID).First(&alert).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return alert, i18n.NewError("error.rate_alert_not_found", nil)
+		}
+		return alert, fmt.Errorf("failed to fetch rate alert: %w", err)
+	}
+	return alert, nil
+}
+
+func toRateAlertResponse(alert models.RateAlert) types.RateAlertResponse {
+	return types.RateAlertResponse{
+		ID:                alert.ID,
+		FromCurrency:      alert.FromCurrency,
+		ToCurrency:        alert.ToCurrency,
+		Direction:         string(alert.Direction),
+		Threshold:         alert.Threshold,
+		CooldownMinutes:   alert.CooldownMinutes,
+		IsActive:          alert.IsActive,
+		LastTriggeredAt:   alert.LastTriggeredAt,
+		LastTriggeredRate: alert.LastTriggeredRate,
+		TriggerCount:      alert.TriggerCount,
+		CreatedAt:         alert.CreatedAt,
+	}
+}
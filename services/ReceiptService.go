@@ -0,0 +1,414 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/templates"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/dustin/go-humanize"
+	"gorm.io/gorm"
+)
+
+var receiptPage = template.Must(template.New("receipt_verification").Parse(templates.ReceiptVerificationTemplate()))
+
+var receiptTypeLabels = map[models.TransactionType]string{
+	models.Deposit:    "Deposit",
+	models.Withdrawal: "Withdrawal",
+	models.Conversion: "Currency Conversion",
+	models.Transfer:   "Transfer",
+	models.P2P:        "JeanPay Transfer",
+}
+
+// ErrReceiptNotFound means the transaction does not exist or belongs to another user
+var ErrReceiptNotFound = i18n.NewError("error.transaction_not_found", nil)
+
+var receiptStatusLabels = map[models.TransactionStatus]string{
+	models.TransactionPending:   "Pending",
+	models.TransactionCompleted: "Completed",
+	models.TransactionFailed:    "Failed",
+	models.TransactionRefundDue: "Refund due",
+}
+
+// GetTransactionReceiptPDF renders the receipt of one of the user's transactions. Admins can
+// download the receipt of any transaction.
+func GetTransactionReceiptPDF(transactionID string, userID uint, isAdmin bool) (string, []byte, error) {
+	query := database.DB.Preload("TransactionDetails").Where("transaction_id = ?", transactionID)
+	if !isAdmin {
+		query = query.Where("user_id = ?", userID)
+	}
+	var transaction models.Transaction
+	if err := query.First(&transaction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil, i18n.NewError("error.transaction_not_found", nil)
+		}
+		return "", nil, errors.New("failed to get transaction")
+	}
+
+	receipt, err := BuildTransactionReceipt(transaction)
+	if err != nil {
+		return "", nil, err
+	}
+	return receiptFileName(receipt), renderReceiptPDF(receipt), nil
+}
+
+// GetVerifiedReceipt returns the current receipt of a transaction for a shared link. The
+// status is read at request time, so a link shared while pending shows the final outcome.
+func GetVerifiedReceipt(transactionID string, query types.ReceiptVerifyQuery) (*types.TransactionReceipt, error) {
+	var transaction models.Transaction
+	if err := database.DB.Preload("TransactionDetails").Where("transaction_id = ?", transactionID).First(&transaction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.receipt_not_found", nil)
+		}
+		return nil, fmt.Errorf("failed to fetch transaction: %w", err)
+	}
+	if !libs.VerifyLinkSignature(receiptLinkPayload(transactionID, transaction.ReceiptLinkVersion), query.Signature) {
+		return nil, i18n.NewError("error.receipt_link_invalid", nil)
+	}
+	return BuildTransactionReceipt(transaction)
+}
+
+// RevokeReceiptLinks invalidates every link shared for one of the user's receipts and returns
+// a fresh verification link
+func RevokeReceiptLinks(transactionID string, userID uint) (string, error) {
+	result := database.DB.Model(&models.Transaction{}).
+		Where("transaction_id = ? AND user_id = ?", transactionID, userID).
+		UpdateColumn("receipt_link_version", gorm.Expr("receipt_link_version + 1"))
+	if result.Error != nil {
+		return "", fmt.Errorf("failed to revoke receipt links: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return "", ErrReceiptNotFound
+	}
+
+	var transaction models.Transaction
+	if err := database.DB.Select("transaction_id", "receipt_link_version").
+		Where("transaction_id = ?", transactionID).First(&transaction).Error; err != nil {
+		return "", fmt.Errorf("failed to fetch transaction: %w", err)
+	}
+	return receiptVerifyURL(transaction.TransactionID, transaction.ReceiptLinkVersion), nil
+}
+
+// GetVerifiedReceiptPDF renders the receipt behind a shared link
+func GetVerifiedReceiptPDF(transactionID string, query types.ReceiptVerifyQuery) (string, []byte, error) {
+	receipt, err := GetVerifiedReceipt(transactionID, query)
+	if err != nil {
+		return "", nil, err
+	}
+	return receiptFileName(receipt), renderReceiptPDF(receipt), nil
+}
+
+// BuildTransactionReceipt collects what a receipt shows for a transaction. Conversions and
+// user to user transfers take their amounts and rate from their own records, which hold the
+// fee and the rate actually applied.
+func BuildTransactionReceipt(transaction models.Transaction) (*types.TransactionReceipt, error) {
+	details := transaction.TransactionDetails
+	if details.ID == 0 {
+		err := database.DB.Where("transaction_id = ?", transaction.ID).First(&details).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to fetch transaction details: %w", err)
+		}
+	}
+
+	user := transaction.User
+	if user.ID == 0 {
+		if err := database.DB.First(&user, transaction.UserID).Error; err != nil {
+			return nil, fmt.Errorf("failed to fetch transaction owner: %w", err)
+		}
+	}
+	userName := strings.TrimSpace(user.FirstName + " " + user.LastName)
+
+	receipt := &types.TransactionReceipt{
+		TransactionID: transaction.TransactionID,
+		Reference:     transaction.Reference,
+		Type:          libs.GetStringOrDefault(receiptTypeLabels[transaction.TransactionType], string(transaction.TransactionType)),
+		Status:        libs.GetStringOrDefault(receiptStatusLabels[transaction.Status], string(transaction.Status)),
+		Description:   transaction.Description,
+		SenderName:    userName,
+		FromAmount:    details.FromAmount,
+		FromCurrency:  details.FromCurrency,
+		ToAmount:      details.ToAmount,
+		ToCurrency:    details.ToCurrency,
+		CreatedAt:     transaction.CreatedAt,
+		UpdatedAt:     transaction.UpdatedAt,
+		VerifyURL:     receiptVerifyURL(transaction.TransactionID, transaction.ReceiptLinkVersion),
+		LinkVersion:   transaction.ReceiptLinkVersion,
+	}
+
+	switch transaction.TransactionType {
+	case models.Conversion:
+		var conversion models.Conversions
+		err := database.DB.Where("transaction_id = ?", transaction.TransactionID).First(&conversion).Error
+		if err == nil {
+			receipt.FromAmount, receipt.FromCurrency = conversion.Amount, conversion.FromCurrency
+			receipt.ToAmount, receipt.ToCurrency = conversion.ConvertedAmount, conversion.ToCurrency
+			receipt.Rate = conversion.Rate
+			receipt.Fee, receipt.FeeCurrency = conversion.Fee, conversion.FromCurrency
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to fetch conversion: %w", err)
+		}
+		receipt.RecipientName = userName
+		receipt.RecipientAccount = receiptWalletName(receipt.ToCurrency)
+	case models.Deposit:
+		receipt.RecipientName = userName
+		receipt.RecipientAccount = receiptWalletName(receipt.FromCurrency)
+	case models.P2P:
+		var transfer models.P2PTransfer
+		err := database.DB.Preload("Sender").Preload("Recipient").
+			Where("sender_transaction_id = ? OR recipient_transaction_id = ?", transaction.TransactionID, transaction.TransactionID).
+			First(&transfer).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, i18n.NewError("error.transfer_not_found", nil)
+			}
+			return nil, fmt.Errorf("failed to fetch transfer: %w", err)
+		}
+		receipt.SenderName = strings.TrimSpace(transfer.Sender.FirstName + " " + transfer.Sender.LastName)
+		receipt.RecipientName = strings.TrimSpace(transfer.Recipient.FirstName + " " + transfer.Recipient.LastName)
+		receipt.RecipientAccount = receiptWalletName(transfer.ToCurrency)
+		receipt.FromAmount, receipt.FromCurrency = transfer.FromAmount, transfer.FromCurrency
+		receipt.ToAmount, receipt.ToCurrency = transfer.ToAmount, transfer.ToCurrency
+		receipt.Rate = transfer.Rate
+	default:
+		receipt.RecipientName = details.RecipientName
+		receipt.RecipientAccount = maskAccountNumber(details.AccountNumber)
+		receipt.RecipientBank = details.BankName
+		receipt.RecipientPhone = libs.MaskPhoneNumber(details.PhoneNumber)
+		receipt.RecipientNetwork = details.Network
+	}
+
+	if receipt.ToCurrency == "" {
+		receipt.ToAmount, receipt.ToCurrency = receipt.FromAmount, receipt.FromCurrency
+	}
+	if receipt.Rate == 0 && receipt.FromCurrency != receipt.ToCurrency && receipt.FromAmount > 0 {
+		receipt.Rate = receipt.ToAmount / receipt.FromAmount
+	}
+	return receipt, nil
+}
+
+// RenderReceiptVerificationPage renders the public page for a shared receipt. A nil receipt
+// renders the page shown for links that fail verification.
+func RenderReceiptVerificationPage(receipt *types.TransactionReceipt) ([]byte, error) {
+	data := map[string]any{
+		"Valid":     receipt != nil,
+		"ServerURL": FRONTEND,
+	}
+	if receipt != nil {
+		amount := func(value float64, currency string) string {
+			return fmt.Sprintf("%s %s", formatStatementAmount(value, currency), currency)
+		}
+		data["Reference"] = receipt.Reference
+		data["Type"] = receipt.Type
+		data["Status"] = receipt.Status
+		data["FromAmount"] = amount(receipt.FromAmount, receipt.FromCurrency)
+		if receipt.ToCurrency != receipt.FromCurrency || receipt.ToAmount != receipt.FromAmount {
+			data["ToAmount"] = amount(receipt.ToAmount, receipt.ToCurrency)
+		}
+		if receipt.Rate > 0 && receipt.FromCurrency != receipt.ToCurrency {
+			data["Rate"] = fmt.Sprintf("1 %s = %s %s", receipt.FromCurrency, formatReceiptRate(receipt.Rate), receipt.ToCurrency)
+		}
+		if receipt.Fee > 0 {
+			data["Fee"] = amount(receipt.Fee, receipt.FeeCurrency)
+		}
+		data["SenderName"] = receipt.SenderName
+		data["RecipientName"] = receipt.RecipientName
+		data["RecipientAccount"] = receipt.RecipientAccount
+		data["RecipientBank"] = receipt.RecipientBank
+		data["RecipientPhone"] = receipt.RecipientPhone
+		data["RecipientNetwork"] = receipt.RecipientNetwork
+		data["CreatedAt"] = receipt.CreatedAt.Format("02 Jan 2006 15:04 MST")
+		data["UpdatedAt"] = receipt.UpdatedAt.Format("02 Jan 2006 15:04 MST")
+		data["DownloadURL"] = receiptDownloadURL(receipt.TransactionID, receipt.LinkVersion)
+		switch receipt.Status {
+		case receiptStatusLabels[models.TransactionPending]:
+			data["Notice"] = "This payment has not completed yet. Funds have not been delivered."
+		case receiptStatusLabels[models.TransactionFailed]:
+			data["Notice"] = "This payment did not go through and no funds were delivered."
+		}
+	}
+
+	var page bytes.Buffer
+	if err := receiptPage.Execute(&page, data); err != nil {
+		return nil, fmt.Errorf("failed to render receipt page: %w", err)
+	}
+	return page.Bytes(), nil
+}
+
+// renderReceiptPDF lays the receipt out on a single page. The PDF fonts cannot draw currency
+// symbols, so amounts carry their currency code.
+func renderReceiptPDF(receipt *types.TransactionReceipt) []byte {
+	const (
+		left      = 40.0
+		right     = libs.PDFPageWidth - 40
+		rowHeight = 18.0
+		valueX    = 200.0
+	)
+	amount := func(value float64, currency string) string {
+		return fmt.Sprintf("%s %s", formatStatementAmount(value, currency), currency)
+	}
+
+	doc := libs.NewPDFDocument()
+	doc.AddPage()
+
+	// Header
+	doc.Text(left, 60, 20, true, "JeanPay")
+	doc.TextRight(right, 60, 14, true, "Transaction Receipt")
+	doc.Line(left, 72, right, 72, 1)
+
+	doc.Text(left, 100, 9, false, "Amount")
+	doc.Text(left, 124, 22, true, amount(receipt.FromAmount, receipt.FromCurrency))
+	doc.TextRight(right, 100, 9, false, receipt.Type)
+	doc.TextRight(right, 124, 14, true, receipt.Status)
+	doc.Line(left, 140, right, 140, 0.5)
+
+	y := 140.0
+	section := func(title string, rows [][2]string) {
+		y += 26
+		doc.Text(left, y, 10, true, title)
+		y += 6
+		doc.Line(left, y, right, y, 0.5)
+		for _, row := range rows {
+			if row[1] == "" {
+				continue
+			}
+			y += rowHeight
+			doc.Text(left, y, 9, false, row[0])
+			doc.Text(valueX, y, 9, true, fitStatementText(doc, row[1], right-valueX, true))
+		}
+	}
+
+	payment := [][2]string{
+		{"Reference", receipt.Reference},
+		{"Transaction ID", receipt.TransactionID},
+		{"Amount sent", amount(receipt.FromAmount, receipt.FromCurrency)},
+	}
+	if receipt.ToCurrency != receipt.FromCurrency || receipt.ToAmount != receipt.FromAmount {
+		payment = append(payment, [2]string{"Amount received", amount(receipt.ToAmount, receipt.ToCurrency)})
+	}
+	if receipt.Rate > 0 && receipt.FromCurrency != receipt.ToCurrency {
+		payment = append(payment, [2]string{"Exchange rate", fmt.Sprintf("1 %s = %s %s", receipt.FromCurrency, formatReceiptRate(receipt.Rate), receipt.ToCurrency)})
+	}
+	if receipt.Fee > 0 {
+		payment = append(payment, [2]string{"Fee", amount(receipt.Fee, receipt.FeeCurrency)})
+	}
+	payment = append(payment, [2]string{"Description", receipt.Description})
+	section("Payment", payment)
+
+	section("Parties", [][2]string{
+		{"Sender", receipt.SenderName},
+		{"Recipient", receipt.RecipientName},
+		{"Account", receipt.RecipientAccount},
+		{"Bank", receipt.RecipientBank},
+		{"Phone number", receipt.RecipientPhone},
+		{"Network", receipt.RecipientNetwork},
+	})
+
+	section("Dates", [][2]string{
+		{"Created", receipt.CreatedAt.Format("02 Jan 2006 15:04 MST")},
+		{"Last updated", receipt.UpdatedAt.Format("02 Jan 2006 15:04 MST")},
+		{"Receipt generated", time.Now().Format("02 Jan 2006 15:04 MST")},
+	})
+
+	// Footer with the verification link, split at the query string when it is too wide
+	footer := libs.PDFPageHeight - 60
+	doc.Line(left, footer, right, footer, 0.5)
+	if receipt.VerifyURL == "" {
+		doc.Text(left, footer+14, 7, false, "This receipt reflects the transaction at the time it was generated.")
+		return doc.Bytes()
+	}
+	doc.Text(left, footer+14, 7, false, "Confirm this receipt is genuine and see the current status at:")
+	if doc.TextWidth(receipt.VerifyURL, 7, false) <= right-left {
+		doc.Text(left, footer+24, 7, false, receipt.VerifyURL)
+	} else if base, query, found := strings.Cut(receipt.VerifyURL, "?"); found {
+		doc.Text(left, footer+24, 7, false, base)
+		doc.Text(left, footer+33, 7, false, "?"+query)
+	} else {
+		doc.Text(left, footer+24, 7, false, receipt.VerifyURL)
+	}
+
+	return doc.Bytes()
+}
+
+// transactionReceiptAttachment renders the receipt of a transaction for an email. Failures
+// are logged and the email goes out without it.
+func transactionReceiptAttachment(transactionID string) ([]EmailAttachment, string) {
+	var transaction models.Transaction
+	if err := database.DB.Preload("TransactionDetails").Where("transaction_id = ?", transactionID).First(&transaction).Error; err != nil {
+		log.Printf("Failed to load transaction %s for receipt: %v", transactionID, err)
+		return nil, ""
+	}
+	receipt, err := BuildTransactionReceipt(transaction)
+	if err != nil {
+		log.Printf("Failed to build receipt for transaction %s: %v", transactionID, err)
+		return nil, ""
+	}
+	return []EmailAttachment{{
+		Filename:    receiptFileName(receipt),
+		ContentType: "application/pdf",
+		Data:        renderReceiptPDF(receipt),
+	}}, receipt.VerifyURL
+}
+
+// receiptVerifyURL returns a public link to the verification page of a receipt. It does not
+// expire, since receipts are kept as proof of payment; the owner can revoke it instead.
+func receiptVerifyURL(transactionID string, version int) string {
+	signature := libs.SignLink(receiptLinkPayload(transactionID, version))
+	if signature == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%s%s/%s?signature=%s",
+		SERVER, constants.APIBase, constants.ReceiptsBase, transactionID, signature)
+}
+
+// receiptDownloadURL returns a public link to the PDF of a receipt
+func receiptDownloadURL(transactionID string, version int) string {
+	signature := libs.SignLink(receiptLinkPayload(transactionID, version))
+	if signature == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%s%s/%s/pdf?signature=%s",
+		SERVER, constants.APIBase, constants.ReceiptsBase, transactionID, signature)
+}
+
+// receiptLinkPayload is what a receipt link signs. Links issued before any revocation sign
+// the bare ID, so they keep working until the owner revokes them.
+func receiptLinkPayload(transactionID string, version int) string {
+	if version == 0 {
+		return fmt.Sprintf("receipt:%s", transactionID)
+	}
+	return fmt.Sprintf("receipt:%s:%d", transactionID, version)
+}
+
+func receiptFileName(receipt *types.TransactionReceipt) string {
+	return fmt.Sprintf("jeanpay-receipt-%s.pdf", receipt.Reference)
+}
+
+func receiptWalletName(currency string) string {
+	return fmt.Sprintf("JeanPay %s wallet", currency)
+}
+
+// formatReceiptRate keeps enough decimals for rates well below one, such as NGN to GHS
+func formatReceiptRate(rate float64) string {
+	if rate >= 1 {
+		return humanize.FormatFloat("#,###.####", rate)
+	}
+	return humanize.FormatFloat("#,###.######", rate)
+}
+
+// maskAccountNumber hides all but the last four digits of an account number
+func maskAccountNumber(account string) string {
+	if len(account) <= 4 {
+		return account
+	}
+	return strings.Repeat("*", len(account)-4) + account[len(account)-4:]
+}
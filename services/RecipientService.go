@@ -0,0 +1,286 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+var ErrRecipientExists = i18n.NewError("error.recipient_exists", nil)
+
+// GetSavedRecipients returns the user's saved recipients, most recently used first
+func GetSavedRecipients(userID uint, recipientType string) ([]types.RecipientResponse, error) {
+	query := database.DB.Where("user_id = ?", userID)
+	if recipientType != "" {
+		query = query.Where("recipient_type = ?", recipientType)
+	}
+
+	var recipients []models.SavedRecipient
+	if err := query.Order("last_used_at DESC NULLS LAST, created_at DESC").Find(&recipients).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch recipients: %w", err)
+	}
+
+	response := make([]types.RecipientResponse, 0, len(recipients))
+	for _, recipient := range recipients {
+		response = append(response, toRecipientResponse(recipient))
+	}
+	return response, nil
+}
+
+// GetSavedRecipient returns a single saved recipient owned by the user
+func GetSavedRecipient(userID, recipientID uint) (types.RecipientResponse, error) {
+	recipient, err := findSavedRecipient(userID, recipientID)
+	if err != nil {
+		return types.RecipientResponse{}, err
+	}
+	return toRecipientResponse(recipient), nil
+}
+
+// CreateSavedRecipient saves a new bank or MoMo recipient for the user
+func CreateSavedRecipient(userID uint, req types.CreateRecipientRequest) (types.RecipientResponse, error) {
+	recipient := models.SavedRecipient{
+		UserID:            userID,
+		RecipientType:     models.RecipientType(req.Type),
+		Nickname:          strings.TrimSpace(req.Nickname),
+		RecipientName:     strings.TrimSpace(req.RecipientName),
+		RecipientAccount:  strings.TrimSpace(req.AccountNumber),
+		RecipientBank:     strings.TrimSpace(req.BankName),
+		RecipientBankCode: strings.TrimSpace(req.BankCode),
+		RecipientPhone:    strings.TrimSpace(req.PhoneNumber),
+		RecipientNetwork:  strings.TrimSpace(req.Network),
+		Currency:          strings.ToUpper(strings.TrimSpace(req.Currency)),
+	}
+	if err := validateSavedRecipient(&recipient); err != nil {
+		return types.RecipientResponse{}, err
+	}
+
+	exists, err := savedRecipientExists(recipient, 0)
+	if err != nil {
+		return types.RecipientResponse{}, err
+	}
+	if exists {
+		return types.RecipientResponse{}, ErrRecipientExists
+	}
+
+	if err := database.DB.Create(&recipient).Error; err != nil {
+		return types.RecipientResponse{}, fmt.Errorf("failed to save recipient: %w", err)
+	}
+	return toRecipientResponse(recipient), nil
+}
+
+// UpdateSavedRecipient edits a saved recipient, keeping its type
+func UpdateSavedRecipient(userID, recipientID uint, req types.UpdateRecipientRequest) (types.RecipientResponse, error) {
+	recipient, err := findSavedRecipient(userID, recipientID)
+	if err != nil {
+		return types.RecipientResponse{}, err
+	}
+
+	if req.RecipientName != "" {
+		recipient.RecipientName = strings.TrimSpace(req.RecipientName)
+	}
+	if req.Nickname != "" {
+		recipient.Nickname = strings.TrimSpace(req.Nickname)
+	}
+	if req.AccountNumber != "" {
+		recipient.RecipientAccount = strings.TrimSpace(req.AccountNumber)
+	}
+	if req.BankName != "" {
+		recipient.RecipientBank = strings.TrimSpace(req.BankName)
+	}
+	if req.BankCode != "" {
+		recipient.RecipientBankCode = strings.TrimSpace(req.BankCode)
+	}
+	if req.PhoneNumber != "" {
+		recipient.RecipientPhone = strings.TrimSpace(req.PhoneNumber)
+	}
+	if req.Network != "" {
+		recipient.RecipientNetwork = strings.TrimSpace(req.Network)
+	}
+	if req.Currency != "" {
+		recipient.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	}
+	if err := validateSavedRecipient(&recipient); err != nil {
+		return types.RecipientResponse{}, err
+	}
+
+	exists, err := savedRecipientExists(recipient, recipient.ID)
+	if err != nil {
+		return types.RecipientResponse{}, err
+	}
+	if exists {
+		return types.RecipientResponse{}, ErrRecipientExists
+	}
+
+	if err := database.DB.Save(&recipient).Error; err != nil {
+		return types.RecipientResponse{}, fmt.Errorf("failed to update recipient: %w", err)
+	}
+	return toRecipientResponse(recipient), nil
+}
+
+// DeleteSavedRecipient removes a saved recipient owned by the user
+func DeleteSavedRecipient(userID, recipientID uint) error {
+	result := database.DB.Where("id = ? AND user_id = ?", recipientID, userID).Delete(&models.SavedRecipient{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete recipient: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return i18n.NewError("error.recipient_not_found", nil)
+	}
+	return nil
+}
+
+// ApplySavedRecipient fills the transfer's destination fields from a saved recipient. The
+// recipient must receive the transfer's currency by the requested method.
+func ApplySavedRecipient(userID uint, transaction *types.NewTransactionRequest) error {
+	recipient, err := findSavedRecipient(userID, transaction.RecipientID)
+	if err != nil {
+		return err
+	}
+	if err := validateSavedRecipient(&recipient); err != nil {
+		return err
+	}
+	if recipient.Currency != "" && !strings.EqualFold(recipient.Currency, transaction.ToCurrency) {
+		return i18n.NewError("error.recipient_currency_mismatch", i18n.Args{"currency": recipient.Currency, "requested": strings.ToUpper(transaction.ToCurrency)})
+	}
+	requested := models.RecipientType(transaction.Method)
+	if (requested == models.RecipientTypeBank || requested == models.RecipientTypeMomo) && requested != recipient.RecipientType {
+		return i18n.NewError("error.recipient_method_mismatch", i18n.Args{"type": recipient.RecipientType, "method": transaction.Method})
+	}
+
+	transaction.Method = string(recipient.RecipientType)
+	transaction.RecipientName = recipient.RecipientName
+	transaction.AccountNumber = recipient.RecipientAccount
+	transaction.BankName = recipient.RecipientBank
+	transaction.BankCode = recipient.RecipientBankCode
+	transaction.PhoneNumber = recipient.RecipientPhone
+	transaction.Network = recipient.RecipientNetwork
+	return nil
+}
+
+// markSavedRecipientUsed moves a recipient up the list once a transfer to it has been created
+func markSavedRecipientUsed(recipientID uint) {
+	if err := database.DB.Model(&models.SavedRecipient{}).Where("id = ?", recipientID).
+		Update("last_used_at", time.Now()).Error; err != nil {
+		log.Printf("Failed to update last use of recipient %d: %v", recipientID, err)
+	}
+}
+
+// SaveRecipientFromTransaction saves a completed transfer's destination when the user has opted in
+func SaveRecipientFromTransaction(userID uint, paymentType models.PaymentType, details models.TransactionDetails) error {
+	var setting models.Setting
+	if err := database.DB.Where("user_id = ?", userID).First(&setting).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch settings: %w", err)
+	}
+	if !setting.SaveRecipient {
+		return nil
+	}
+
+	now := time.Now()
+	recipient := models.SavedRecipient{
+		UserID:            userID,
+		RecipientType:     models.RecipientType(paymentType),
+		RecipientName:     details.RecipientName,
+		RecipientAccount:  details.AccountNumber,
+		RecipientBank:     details.BankName,
+		RecipientBankCode: details.BankCode,
+		RecipientPhone:    details.PhoneNumber,
+		RecipientNetwork:  details.Network,
+		Currency:          details.ToCurrency,
+		LastUsedAt:        &now,
+	}
+	if err := validateSavedRecipient(&recipient); err != nil {
+		return nil
+	}
+
+	exists, err := savedRecipientExists(recipient, 0)
+	if err != nil || exists {
+		return err
+	}
+	if err := database.DB.Create(&recipient).Error; err != nil {
+		return fmt.Errorf("failed to save recipient: %w", err)
+	}
+	return nil
+}
+
+// Helper functions
+
+func findSavedRecipient(userID, recipientID uint) (models.SavedRecipient, error) {
+	var recipient models.SavedRecipient
+	if err := database.DB.Where("id = ? AND user_id = ?", recipientID, userID).First(&recipient).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return recipient, i18n.NewError("error.recipient_not_found", nil)
+		}
+		return recipient, fmt.Errorf("failed to fetch recipient: %w", err)
+	}
+	return recipient, nil
+}
+
+// validateSavedRecipient checks the fields each recipient type needs; a MoMo wallet's account is its phone number
+func validateSavedRecipient(recipient *models.SavedRecipient) error {
+	if recipient.RecipientName == "" {
+		return i18n.NewError("error.recipient_name_required", nil)
+	}
+
+	switch recipient.RecipientType {
+	case models.RecipientTypeBank:
+		if recipient.RecipientAccount == "" || recipient.RecipientBank == "" {
+			return i18n.NewError("error.bank_recipient_details", nil)
+		}
+	case models.RecipientTypeMomo:
+		if recipient.RecipientPhone == "" || recipient.RecipientNetwork == "" {
+			return i18n.NewError("error.momo_recipient_details", nil)
+		}
+		recipient.RecipientAccount = recipient.RecipientPhone
+	default:
+		return i18n.NewError("error.recipient_type_invalid", nil)
+	}
+	return nil
+}
+
+// savedRecipientExists reports whether the user already saved the same destination
+func savedRecipientExists(recipient models.SavedRecipient, excludeID uint) (bool, error) {
+	query := database.DB.Model(&models.SavedRecipient{}).
+		Where("user_id = ? AND recipient_type = ?", recipient.UserID, recipient.RecipientType)
+	if recipient.RecipientType == models.RecipientTypeBank {
+		query = query.Where("recipient_account = ? AND recipient_bank = ?", recipient.RecipientAccount, recipient.RecipientBank)
+	} else {
+		query = query.Where("recipient_phone = ? AND recipient_network = ?", recipient.RecipientPhone, recipient.RecipientNetwork)
+	}
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check recipients: %w", err)
+	}
+	return count > 0, nil
+}
+
+func toRecipientResponse(recipient models.SavedRecipient) types.RecipientResponse {
+	return types.RecipientResponse{
+		ID:            recipient.ID,
+		Type:          string(recipient.RecipientType),
+		RecipientName: recipient.RecipientName,
+		Nickname:      recipient.Nickname,
+		AccountNumber: recipient.RecipientAccount,
+		BankName:      recipient.RecipientBank,
+		BankCode:      recipient.RecipientBankCode,
+		PhoneNumber:   recipient.RecipientPhone,
+		Network:       recipient.RecipientNetwork,
+		Currency:      recipient.Currency,
+		LastUsedAt:    recipient.LastUsedAt,
+		CreatedAt:     recipient.CreatedAt,
+	}
+}
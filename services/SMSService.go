@@ -0,0 +1,361 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/interfaces"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+// SMSGatewayConfig holds the settings for an HTTP SMS gateway
+type SMSGatewayConfig struct {
+	BaseURL  string
+	APIKey   string
+	SenderID string
+	Channel  string
+	Timeout  time.Duration
+}
+
+// HTTPSMSGateway sends messages through a Termii/Hubtel-style JSON API
+type HTTPSMSGateway struct {
+	config *SMSGatewayConfig
+	client *http.Client
+}
+
+// NewHTTPSMSGateway creates a new HTTP SMS gateway adapter
+func NewHTTPSMSGateway(config *SMSGatewayConfig) *HTTPSMSGateway {
+	return &HTTPSMSGateway{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// SendSMS posts a message to the gateway and returns the gateway's message ID
+func (g *HTTPSMSGateway) SendSMS(to, message string) (string, error) {
+	payload := map[string]any{
+		"api_key": g.config.APIKey,
+		"to":      to,
+		"from":    g.config.SenderID,
+		"sms":     message,
+		"type":    "plain",
+		"channel": g.config.Channel,
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SMS request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", strings.TrimRight(g.config.BaseURL, "/")+"/api/sms/send", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create SMS request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach SMS gateway: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read SMS gateway response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("SMS gateway returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		MessageID string `json:"message_id"`
+		Message   string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to decode SMS gateway response: %w", err)
+	}
+	if result.MessageID == "" {
+		return "", fmt.Errorf("SMS gateway did not accept the message: %s", result.Message)
+	}
+
+	return result.MessageID, nil
+}
+
+// Name identifies the adapter on delivery records
+func (g *HTTPSMSGateway) Name() string {
+	return "http"
+}
+
+// LogSMSSender discards messages instead of sending them, for local development. The body is
+// never logged, since it can hold login and verification codes.
+type LogSMSSender struct{}
+
+// SendSMS notes the message in the log and returns a generated message ID
+func (LogSMSSender) SendSMS(to, message string) (string, error) {
+	messageID := "log-" + libs.GenerateUUID()
+	log.Printf("SMS to %s discarded by the log provider (%s)", utils.MaskPhoneNumber(to), messageID)
+	return messageID, nil
+}
+
+// Name identifies the adapter on delivery records
+func (LogSMSSender) Name() string {
+	return "log"
+}
+
+// NewSMSSenderFromEnv picks the SMS adapter named by SMS_PROVIDER. Without one SMS is off and
+// the sender is nil. The log adapter only runs with SMS_LOG_ONLY=true, so a missing gateway is
+// never mistaken for a working one.
+func NewSMSSenderFromEnv() (interfaces.SMSSender, error) {
+	switch provider := os.Getenv("SMS_PROVIDER"); provider {
+	case "":
+		return nil, nil
+	case "log":
+		if !getEnvBoolOrDefault("SMS_LOG_ONLY", false) {
+			return nil, errors.New("the log SMS provider discards messages; set SMS_LOG_ONLY=true to use it in development")
+		}
+		return LogSMSSender{}, nil
+	case "http":
+		config := &SMSGatewayConfig{
+			BaseURL:  os.Getenv("SMS_BASE_URL"),
+			APIKey:   os.Getenv("SMS_API_KEY"),
+			SenderID: GetEnvOrDefault("SMS_SENDER_ID", "JeanPay"),
+			Channel:  GetEnvOrDefault("SMS_CHANNEL", "generic"),
+			Timeout:  time.Duration(getEnvIntOrDefault("SMS_TIMEOUT", 15)) * time.Second,
+		}
+		if config.BaseURL == "" {
+			return nil, errors.New("SMS_BASE_URL is required for the http SMS provider")
+		}
+		if config.APIKey == "" {
+			return nil, errors.New("SMS_API_KEY is required for the http SMS provider")
+		}
+		return NewHTTPSMSGateway(config), nil
+	default:
+		return nil, fmt.Errorf("unknown SMS provider %q", provider)
+	}
+}
+
+// InitializeGlobalSMSSender initializes the global SMS sender with configuration from environment
+func InitializeGlobalSMSSender() error {
+	sender, err := NewSMSSenderFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to initialize SMS sender: %w", err)
+	}
+	if sender == nil {
+		log.Println("SMS_PROVIDER is not set, SMS messages will not be sent")
+		return nil
+	}
+
+	interfaces.SetGlobalSMSSender(sender)
+	return nil
+}
+
+// QueueSMS renders a template from the sms catalog in the recipient's language, records the
+// message and queues it for delivery. Callers go through Notify, which has already checked that
+// SMS is allowed. A non-zero campaignID reports the outcome back to that broadcast.
+func QueueSMS(userID uint, to, templateName string, data i18n.Args, locale i18n.Locale, campaignID uint) error {
+	to = strings.ReplaceAll(strings.ReplaceAll(to, " ", ""), "-", "")
+	if !libs.IsValidPhoneNumber(to) {
+		return i18n.NewError("error.invalid_phone_number", nil)
+	}
+
+	id := "sms." + templateName
+	if !i18n.Exists(id) {
+		return fmt.Errorf("unknown SMS template %q", templateName)
+	}
+	body := locale.T(id, data)
+
+	message := models.SMSMessage{
+		UserID:   userID,
+		To:       to,
+		Template: templateName,
+		Body:     body,
+		Status:   models.SMSQueued,
+	}
+	if campaignID != 0 {
+		message.CampaignID = &campaignID
+	}
+	if err := database.DB.Create(&message).Error; err != nil {
+		return fmt.Errorf("failed to record SMS: %w", err)
+	}
+
+	smsClient := jobs.NewSMSJobClient()
+	defer smsClient.Close()
+
+	if err := smsClient.EnqueueDelivery(message.ID, templateName); err != nil {
+		database.DB.Model(&message).Updates(map[string]any{
+			"status": models.SMSFailed,
+			"error":  err.Error(),
+		})
+		return err
+	}
+	return nil
+}
+
+// otpSMSData fills the OTP template
+func otpSMSData(code string, ttl time.Duration) i18n.Args {
+	return i18n.Args{
+		"code":  code,
+		"count": int(ttl.Minutes()),
+	}
+}
+
+// transactionAlertSMSData fills the transaction alert template
+func transactionAlertSMSData(transaction models.Transaction, status models.TransactionStatus) i18n.Args {
+	return i18n.Args{
+		"type":      i18n.Msg("transaction_type."+string(transaction.TransactionType), nil),
+		"amount":    i18n.Amount(transaction.TransactionDetails.FromAmount, transaction.TransactionDetails.FromCurrency),
+		"status":    i18n.Msg("transaction_status."+string(status), nil),
+		"reference": transaction.Reference,
+	}
+}
+
+// HandleSMSDeliveryTask sends a queued message through the global SMS sender
+func HandleSMSDeliveryTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.SMSJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal SMS payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.MessageID == 0 {
+		return fmt.Errorf("message_id is required: %w", asynq.SkipRetry)
+	}
+
+	var message models.SMSMessage
+	if err := database.DB.First(&message, payload.MessageID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("SMS %d not found: %w", payload.MessageID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch SMS: %w", err)
+	}
+	if message.Status != models.SMSQueued {
+		return nil
+	}
+
+	smsSender := interfaces.GetGlobalSMSSender()
+	if smsSender == nil {
+		database.DB.Model(&message).Updates(map[string]any{
+			"status": models.SMSFailed,
+			"error":  "no SMS provider is configured",
+		})
+		reportCampaignSMS(message, "no SMS provider is configured")
+		return fmt.Errorf("SMS sender not initialized: %w", asynq.SkipRetry)
+	}
+
+	providerMessageID, err := smsSender.SendSMS(message.To, message.Body)
+	if err != nil {
+		updates := map[string]any{
+			"attempts": gorm.Expr("attempts + 1"),
+			"provider": smsSender.Name(),
+			"error":    err.Error(),
+		}
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			updates["status"] = models.SMSFailed
+			reportCampaignSMS(message, err.Error())
+		}
+		database.DB.Model(&message).Updates(updates)
+		return fmt.Errorf("failed to send SMS: %w", err)
+	}
+
+	now := time.Now()
+	if err := database.DB.Model(&message).Updates(map[string]any{
+		"attempts":            gorm.Expr("attempts + 1"),
+		"provider":            smsSender.Name(),
+		"provider_message_id": providerMessageID,
+		"status":              models.SMSSent,
+		"error":               "",
+		"sent_at":             &now,
+	}).Error; err != nil {
+		// The message went out, so retrying would send it twice
+		log.Printf("Failed to record SMS %d as sent: %v", message.ID, err)
+	}
+
+	log.Printf("SMS %d (%s) sent via %s as %s", message.ID, message.Template, smsSender.Name(), providerMessageID)
+	return nil
+}
+
+// SMSDeliveryReport is the status callback a gateway posts for a sent message
+type SMSDeliveryReport struct {
+	MessageID string `json:"message_id"`
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+}
+
+// HandleSMSDeliveryReport applies a gateway delivery report to the matching message
+func HandleSMSDeliveryReport(payload []byte, signature string) error {
+	if !verifySMSSignature(payload, signature) {
+		return errors.New("invalid webhook signature")
+	}
+
+	var report SMSDeliveryReport
+	if err := json.Unmarshal(payload, &report); err != nil {
+		return fmt.Errorf("failed to parse delivery report: %w", err)
+	}
+	if report.MessageID == "" {
+		return errors.New("message_id is required")
+	}
+
+	updates := map[string]any{}
+	failure := ""
+	switch strings.ToLower(report.Status) {
+	case "delivered", "delivrd":
+		updates["status"] = models.SMSDelivered
+		updates["delivered_at"] = time.Now()
+	case "failed", "rejected", "expired", "undelivered", "undeliv":
+		failure = report.Error
+		if failure == "" {
+			failure = "gateway reported " + strings.ToLower(report.Status)
+		}
+		updates["status"] = models.SMSFailed
+		updates["error"] = failure
+	default:
+		// Intermediate states such as "sent" or "accepted" add nothing to what we record
+		return nil
+	}
+
+	// Delivered is final, so a late failure report must not overwrite it
+	result := database.DB.Model(&models.SMSMessage{}).
+		Where("provider_message_id = ? AND status <> ?", report.MessageID, models.SMSDelivered).
+		Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update SMS status: %w", result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		var message models.SMSMessage
+		if err := database.DB.Where("provider_message_id = ?", report.MessageID).First(&message).Error; err == nil {
+			reportCampaignSMS(message, failure)
+		}
+	}
+	return nil
+}
+
+// reportCampaignSMS passes the result of a campaign SMS on to its campaign recipient. An
+// empty failure means the message was delivered.
+func reportCampaignSMS(message models.SMSMessage, failure string) {
+	if message.CampaignID != nil {
+		recordCampaignDelivery(*message.CampaignID, message.UserID, models.ChannelSMS, failure)
+	}
+}
+
+// verifySMSSignature verifies an SMS gateway delivery report signature
+func verifySMSSignature(payload []byte, signature string) bool {
+	return libs.VerifyHMACSHA512(payload, signature, libs.GetEnvOrDefault("SMS_WEBHOOK_SECRET", ""))
+}
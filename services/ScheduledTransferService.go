@@ -0,0 +1,581 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+// CreateScheduledTransfer sets up a repeating wallet transfer to a saved recipient. The user
+// confirms step-up once here; the runs themselves execute unattended.
+func CreateScheduledTransfer(userID uint, req types.CreateScheduledTransferRequest) (*types.ScheduledTransferResponse, error) {
+	if userID == 0 {
+		return nil, ErrUserIDRequired
+	}
+	if req.Amount <= 0 {
+		return nil, ErrAmountNotPositive
+	}
+	if err := validateCorridor(req.FromCurrency, req.ToCurrency, req.Amount); err != nil {
+		return nil, err
+	}
+	if !isValidScheduleFrequency(req.Frequency) {
+		return nil, i18n.NewError("error.invalid_frequency", nil)
+	}
+
+	recipient, err := findSavedRecipient(userID, req.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+	if recipient.Currency != "" && recipient.Currency != req.ToCurrency {
+		return nil, i18n.NewError("error.recipient_currency", i18n.Args{"currency": recipient.Currency})
+	}
+	if req.FromCurrency != req.ToCurrency {
+		if _, err := getCurrentExchangeRate(req.FromCurrency, req.ToCurrency); err != nil {
+			return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+		}
+	}
+
+	now := time.Now()
+	startDate := now
+	if req.StartDate != "" {
+		startDate, err = parseScheduleDate(req.StartDate)
+		if err != nil {
+			return nil, i18n.NewError("error.invalid_start_date", nil)
+		}
+		if startDate.Before(now) {
+			// A bare date for today means "starting today"
+			if startDate.Format("2006-01-02") != now.Format("2006-01-02") {
+				return nil, i18n.NewError("error.start_date_past", nil)
+			}
+			startDate = now
+		}
+	}
+
+	var endDate *time.Time
+	if req.EndDate != "" {
+		end, err := parseScheduleDate(req.EndDate)
+		if err != nil {
+			return nil, i18n.NewError("error.invalid_end_date", nil)
+		}
+		if len(req.EndDate) == len("2006-01-02") {
+			// Include the whole end day
+			end = end.Add(24*time.Hour - time.Second)
+		}
+		if !end.After(startDate) {
+			return nil, i18n.NewError("error.end_date_before_start", nil)
+		}
+		endDate = &end
+	}
+
+	amount := utils.RoundCurrency(req.Amount)
+	if err := VerifyStepUp(userID, req.StepUpCredentials, amount, req.FromCurrency); err != nil {
+		return nil, err
+	}
+
+	schedule := models.ScheduledTransfer{
+		UserID:       userID,
+		RecipientID:  recipient.ID,
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Amount:       amount,
+		Note:         strings.TrimSpace(req.Note),
+		Frequency:    models.ScheduleFrequency(req.Frequency),
+		StartDate:    startDate,
+		EndDate:      endDate,
+		MaxRuns:      req.MaxRuns,
+		NextRunAt:    startDate,
+		Status:       models.ScheduleActive,
+	}
+	if err := database.DB.Create(&schedule).Error; err != nil {
+		return nil, fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+	schedule.Recipient = recipient
+
+	activityClient := jobs.NewActivityJobClient()
+	defer activityClient.Close()
+	activityClient.EnqueueNewActivity(userID, fmt.Sprintf("Scheduled a %s transfer of %s to %s", schedule.Frequency, utils.FormatCurrency(amount, schedule.FromCurrency), recipient.RecipientName))
+
+	response := toScheduledTransferResponse(schedule)
+	return &response, nil
+}
+
+// GetScheduledTransfers lists the user's schedules, optionally filtered by status
+func GetScheduledTransfers(userID uint, query types.ScheduledTransferQuery) (*types.ScheduledTransfersResponse, error) {
+	page, limit := types.ValidatePagination(query.Page, query.Limit)
+
+	db := database.DB.Model(&models.ScheduledTransfer{}).Where("user_id = ?", userID)
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count scheduled transfers: %w", err)
+	}
+
+	var schedules []models.ScheduledTransfer
+	if err := db.Preload("Recipient", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).
+		Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduled transfers: %w", err)
+	}
+
+	response := &types.ScheduledTransfersResponse{
+		Schedules:  make([]types.ScheduledTransferResponse, 0, len(schedules)),
+		Pagination: types.NewPaginationResponse(page, limit, total),
+	}
+	for _, schedule := range schedules {
+		response.Schedules = append(response.Schedules, toScheduledTransferResponse(schedule))
+	}
+	return response, nil
+}
+
+// GetScheduledTransfer returns one of the user's schedules
+func GetScheduledTransfer(userID, scheduleID uint) (*types.ScheduledTransferResponse, error) {
+	schedule, err := findScheduledTransfer(userID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	response := toScheduledTransferResponse(schedule)
+	return &response, nil
+}
+
+// GetScheduledTransferRuns returns the run history of a schedule, newest first
+func GetScheduledTransferRuns(userID, scheduleID uint, page, limit int) (*types.ScheduledTransferRunsResponse, error) {
+	if _, err := findScheduledTransfer(userID, scheduleID); err != nil {
+		return nil, err
+	}
+	page, limit = types.ValidatePagination(page, limit)
+
+	db := database.DB.Model(&models.ScheduledTransferRun{}).Where("scheduled_transfer_id = ?", scheduleID)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count runs: %w", err)
+	}
+
+	var runs []models.ScheduledTransferRun
+	if err := db.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch runs: %w", err)
+	}
+
+	response := &types.ScheduledTransferRunsResponse{
+		Runs:       make([]types.ScheduledTransferRunResponse, 0, len(runs)),
+		Pagination: types.NewPaginationResponse(page, limit, total),
+	}
+	for _, run := range runs {
+		response.Runs = append(response.Runs, types.ScheduledTransferRunResponse{
+			ID:            run.ID,
+			ScheduledFor:  run.ScheduledFor,
+			Status:        string(run.Status),
+			TransactionID: run.TransactionID,
+			FromAmount:    run.FromAmount,
+			ToAmount:      run.ToAmount,
+			Rate:          run.Rate,
+			Code:          run.Code,
+			Reason:        run.Reason,
+			CreatedAt:     run.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+// PauseScheduledTransfer stops an active schedule from running until it is resumed
+func PauseScheduledTransfer(userID, scheduleID uint) (*types.ScheduledTransferResponse, error) {
+	schedule, err := findScheduledTransfer(userID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Status != models.ScheduleActive {
+		return nil, i18n.NewError("error.schedule_pause_inactive", nil)
+	}
+
+	if err := database.DB.Model(&schedule).Update("status", models.SchedulePaused).Error; err != nil {
+		return nil, fmt.Errorf("failed to pause scheduled transfer: %w", err)
+	}
+	schedule.Status = models.SchedulePaused
+
+	response := toScheduledTransferResponse(schedule)
+	return &response, nil
+}
+
+// ResumeScheduledTransfer reactivates a paused schedule. Runs missed while paused are skipped.
+func ResumeScheduledTransfer(userID, scheduleID uint) (*types.ScheduledTransferResponse, error) {
+	schedule, err := findScheduledTransfer(userID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Status != models.SchedulePaused {
+		return nil, i18n.NewError("error.schedule_resume_not_paused", nil)
+	}
+
+	now := time.Now()
+	if schedule.NextRunAt.Before(now) {
+		schedule.Occurrence, schedule.NextRunAt = nextScheduleOccurrence(schedule, now)
+	}
+	if scheduleEnded(schedule) {
+		return nil, i18n.NewError("error.schedule_ended", nil)
+	}
+
+	if err := database.DB.Model(&schedule).Updates(map[string]any{
+		"status":        models.ScheduleActive,
+		"occurrence":    schedule.Occurrence,
+		"next_run_at":   schedule.NextRunAt,
+		"failure_count": 0,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to resume scheduled transfer: %w", err)
+	}
+	schedule.Status = models.ScheduleActive
+	schedule.FailureCount = 0
+
+	response := toScheduledTransferResponse(schedule)
+	return &response, nil
+}
+
+// CancelScheduledTransfer ends a schedule for good; its run history is kept
+func CancelScheduledTransfer(userID, scheduleID uint) (*types.ScheduledTransferResponse, error) {
+	schedule, err := findScheduledTransfer(userID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Status != models.ScheduleActive && schedule.Status != models.SchedulePaused {
+		return nil, i18n.NewError("error.schedule_status", i18n.Args{"status": schedule.Status})
+	}
+
+	if err := database.DB.Model(&schedule).Update("status", models.ScheduleCancelled).Error; err != nil {
+		return nil, fmt.Errorf("failed to cancel scheduled transfer: %w", err)
+	}
+	schedule.Status = models.ScheduleCancelled
+
+	activityClient := jobs.NewActivityJobClient()
+	defer activityClient.Close()
+	activityClient.EnqueueNewActivity(userID, fmt.Sprintf("Cancelled the %s transfer to %s", schedule.Frequency, schedule.Recipient.RecipientName))
+
+	response := toScheduledTransferResponse(schedule)
+	return &response, nil
+}
+
+// Worker functions
+
+// HandleScheduledTransferSweepTask runs periodically. It queues a run for every schedule that is
+// due and warns users whose wallet will not cover a run coming up soon.
+func HandleScheduledTransferSweepTask(ctx context.Context, t *asynq.Task) error {
+	now := time.Now()
+
+	var due []models.ScheduledTransfer
+	if err := database.DB.Where("status = ? AND next_run_at <= ?", models.ScheduleActive, now).
+		Order("next_run_at ASC").Find(&due).Error; err != nil {
+		return fmt.Errorf("failed to fetch due scheduled transfers: %w", err)
+	}
+
+	if len(due) > 0 {
+		jobClient := jobs.NewScheduledTransferJobClient()
+		defer jobClient.Close()
+		for _, schedule := range due {
+			if err := jobClient.EnqueueRun(schedule.ID, schedule.Occurrence); err != nil {
+				log.Printf("Failed to enqueue scheduled transfer %d: %v", schedule.ID, err)
+			}
+		}
+	}
+
+	var upcoming []models.ScheduledTransfer
+	if err := database.DB.Preload("Recipient").
+		Where("status = ? AND next_run_at > ? AND next_run_at <= ?", models.ScheduleActive, now, now.Add(constants.ScheduledTransferBalanceAlertAhead)).
+		Where("balance_alert IS NULL OR balance_alert <> next_run_at").
+		Find(&upcoming).Error; err != nil {
+		return fmt.Errorf("failed to fetch upcoming scheduled transfers: %w", err)
+	}
+
+	for _, schedule := range upcoming {
+		balance, err := getScheduleWalletBalance(schedule.UserID, schedule.FromCurrency)
+		if err != nil || balance >= schedule.Amount {
+			continue
+		}
+
+		notifyScheduledTransfer(schedule.UserID, "notification.scheduled_transfer_top_up", i18n.Args{
+			"frequency": frequencyText(schedule.Frequency),
+			"amount":    i18n.Amount(schedule.Amount, schedule.FromCurrency),
+			"recipient": schedule.Recipient.RecipientName,
+			"runAt":     schedule.NextRunAt,
+			"currency":  schedule.FromCurrency,
+			"balance":   i18n.Amount(balance, schedule.FromCurrency),
+		})
+
+		if err := database.DB.Model(&schedule).Update("balance_alert", schedule.NextRunAt).Error; err != nil {
+			log.Printf("Failed to record balance alert for scheduled transfer %d: %v", schedule.ID, err)
+		}
+	}
+
+	log.Printf("Scheduled transfer sweep: due=%d upcoming=%d", len(due), len(upcoming))
+	return nil
+}
+
+// HandleScheduledTransferRunTask executes one occurrence of a schedule through the regular
+// transaction flow and records the outcome in the schedule's run history
+func HandleScheduledTransferRunTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.ScheduledTransferRunPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal scheduled transfer payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.ScheduleID == 0 {
+		return fmt.Errorf("schedule_id is required: %w", asynq.SkipRetry)
+	}
+
+	var schedule models.ScheduledTransfer
+	if err := database.DB.Preload("Recipient").First(&schedule, payload.ScheduleID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("scheduled transfer %d not found: %w", payload.ScheduleID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch scheduled transfer: %w", err)
+	}
+
+	now := time.Now()
+	scheduledFor := schedule.NextRunAt
+	nextOccurrence, nextRunAt := nextScheduleOccurrence(schedule, now)
+
+	// Claim the occurrence before moving money so a retried or duplicate task cannot run it twice
+	claim := database.DB.Model(&models.ScheduledTransfer{}).
+		Where("id = ? AND status = ? AND occurrence = ?", schedule.ID, models.ScheduleActive, payload.Occurrence).
+		Updates(map[string]any{
+			"occurrence":  nextOccurrence,
+			"next_run_at": nextRunAt,
+			"last_run_at": now,
+		})
+	if claim.Error != nil {
+		return fmt.Errorf("failed to claim scheduled transfer: %w", claim.Error)
+	}
+	if claim.RowsAffected == 0 {
+		log.Printf("Skipping scheduled transfer %d occurrence %d: already handled", schedule.ID, payload.Occurrence)
+		return nil
+	}
+	schedule.Occurrence = nextOccurrence
+	schedule.NextRunAt = nextRunAt
+
+	run := models.ScheduledTransferRun{
+		ScheduledTransferID: schedule.ID,
+		ScheduledFor:        scheduledFor,
+		FromAmount:          schedule.Amount,
+	}
+	executeScheduledTransfer(schedule, &run)
+
+	if err := database.DB.Create(&run).Error; err != nil {
+		log.Printf("Failed to record run for scheduled transfer %d: %v", schedule.ID, err)
+	}
+
+	updates := map[string]any{}
+	if run.Status == models.ScheduleRunSucceeded {
+		schedule.RunCount++
+		schedule.FailureCount = 0
+		updates["run_count"] = schedule.RunCount
+		updates["failure_count"] = 0
+		notifyScheduledTransfer(schedule.UserID, "notification.scheduled_transfer_sent", i18n.Args{
+			"frequency": frequencyText(schedule.Frequency),
+			"amount":    i18n.Amount(run.FromAmount, schedule.FromCurrency),
+			"recipient": schedule.Recipient.RecipientName,
+		})
+	} else {
+		schedule.FailureCount++
+		updates["failure_count"] = schedule.FailureCount
+		notifyScheduledTransfer(schedule.UserID, "notification.scheduled_transfer_failed", i18n.Args{
+			"frequency": frequencyText(schedule.Frequency),
+			"amount":    i18n.Amount(run.FromAmount, schedule.FromCurrency),
+			"recipient": schedule.Recipient.RecipientName,
+			"reason":    run.Reason,
+		})
+	}
+
+	switch {
+	case scheduleEnded(schedule):
+		updates["status"] = models.ScheduleCompleted
+		notifyScheduledTransfer(schedule.UserID, "notification.scheduled_transfer_completed", i18n.Args{
+			"frequency": frequencyText(schedule.Frequency),
+			"recipient": schedule.Recipient.RecipientName,
+			"count":     schedule.RunCount,
+		})
+	case schedule.FailureCount >= constants.ScheduledTransferMaxFailures:
+		updates["status"] = models.SchedulePaused
+		notifyScheduledTransfer(schedule.UserID, "notification.scheduled_transfer_paused", i18n.Args{
+			"frequency": frequencyText(schedule.Frequency),
+			"recipient": schedule.Recipient.RecipientName,
+			"count":     schedule.FailureCount,
+		})
+	}
+
+	if err := database.DB.Model(&schedule).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update scheduled transfer: %w", err)
+	}
+
+	log.Printf("Scheduled transfer run: schedule_id=%d status=%s transaction_id=%s", schedule.ID, run.Status, run.TransactionID)
+	return nil
+}
+
+// Helper functions
+
+// executeScheduledTransfer checks the wallet, prices the transfer and places it, filling in run
+func executeScheduledTransfer(schedule models.ScheduledTransfer, run *models.ScheduledTransferRun) {
+	balance, err := getScheduleWalletBalance(schedule.UserID, schedule.FromCurrency)
+	if err != nil {
+		run.Status, run.Code, run.Reason = models.ScheduleRunFailed, "INTERNAL_SERVER_ERROR", "wallet could not be checked"
+		return
+	}
+	if balance < schedule.Amount {
+		run.Status, run.Code, run.Reason = models.ScheduleRunSkipped, "INSUFFICIENT_FUNDS", "insufficient wallet balance"
+		return
+	}
+
+	rate := 1.0
+	if schedule.FromCurrency != schedule.ToCurrency {
+		rate, err = getCurrentExchangeRate(schedule.FromCurrency, schedule.ToCurrency)
+		if err != nil {
+			run.Status, run.Code, run.Reason = models.ScheduleRunFailed, "RATE_UNAVAILABLE", "exchange rate unavailable"
+			return
+		}
+	}
+	run.Rate = rate
+	run.ToAmount = utils.RoundCurrency(schedule.Amount * rate)
+
+	response, code, err := createTransaction(schedule.UserID, types.NewTransactionRequest{
+		FromCurrency:    schedule.FromCurrency,
+		ToCurrency:      schedule.ToCurrency,
+		FromAmount:      fmt.Sprintf("%.2f", schedule.Amount),
+		ToAmount:        fmt.Sprintf("%.2f", run.ToAmount),
+		ExchangeRate:    rate,
+		MethodOfPayment: string(models.PaymentTypeWallet),
+		RecipientID:     schedule.RecipientID,
+		Note:            schedule.Note,
+	})
+	if err != nil {
+		run.Status, run.Code, run.Reason = models.ScheduleRunFailed, code, err.Error()
+		return
+	}
+
+	run.Status = models.ScheduleRunSucceeded
+	run.TransactionID = response.Transaction.TransactionID
+}
+
+// nextScheduleOccurrence returns the first occurrence of a schedule strictly after the given time
+func nextScheduleOccurrence(schedule models.ScheduledTransfer, after time.Time) (int, time.Time) {
+	occurrence := schedule.Occurrence
+	runAt := scheduleOccurrenceAt(schedule.StartDate, schedule.Frequency, occurrence)
+	for !runAt.After(after) {
+		occurrence++
+		runAt = scheduleOccurrenceAt(schedule.StartDate, schedule.Frequency, occurrence)
+	}
+	return occurrence, runAt
+}
+
+// scheduleOccurrenceAt counts from the start date so monthly runs keep their day of the month,
+// falling back to the last day in shorter months
+func scheduleOccurrenceAt(start time.Time, frequency models.ScheduleFrequency, n int) time.Time {
+	switch frequency {
+	case models.FrequencyDaily:
+		return start.AddDate(0, 0, n)
+	case models.FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case models.FrequencyBiweekly:
+		return start.AddDate(0, 0, 14*n)
+	default:
+		firstOfMonth := time.Date(start.Year(), start.Month()+time.Month(n), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+		lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+		return firstOfMonth.AddDate(0, 0, min(start.Day(), lastDay)-1)
+	}
+}
+
+func scheduleEnded(schedule models.ScheduledTransfer) bool {
+	if schedule.MaxRuns > 0 && schedule.RunCount >= schedule.MaxRuns {
+		return true
+	}
+	return schedule.EndDate != nil && schedule.NextRunAt.After(*schedule.EndDate)
+}
+
+func parseScheduleDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}
+
+func isValidScheduleFrequency(frequency string) bool {
+	switch models.ScheduleFrequency(frequency) {
+	case models.FrequencyDaily, models.FrequencyWeekly, models.FrequencyBiweekly, models.FrequencyMonthly:
+		return true
+	}
+	return false
+}
+
+func getScheduleWalletBalance(userID uint, currency string) (float64, error) {
+	var wallet models.Wallet
+	if err := database.DB.Where("user_id = ? AND currency = ?", userID, currency).First(&wallet).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to fetch wallet: %w", err)
+	}
+	return wallet.Balance, nil
+}
+
+func findScheduledTransfer(userID, scheduleID uint) (models.ScheduledTransfer, error) {
+	var schedule models.ScheduledTransfer
+	if err := database.DB.Preload("Recipient", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("id = ? AND user_id = ?", scheduleID, userID).First(&schedule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return schedule, i18n.NewError("error.scheduled_transfer_not_found", nil)
+		}
+		return schedule, fmt.Errorf("failed to fetch scheduled transfer: %w", err)
+	}
+	return schedule, nil
+}
+
+// notifyScheduledTransfer sends the notice whose title and message live under the catalog key
+func notifyScheduledTransfer(userID uint, key string, args i18n.Args) {
+	notifyAndLog(Notice{
+		UserID:   userID,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg(key+".title", nil),
+		Message:  i18n.Msg(key+".message", args),
+	})
+}
+
+func frequencyText(frequency models.ScheduleFrequency) i18n.Text {
+	return i18n.Msg("frequency."+string(frequency), nil)
+}
+
+func toScheduledTransferResponse(schedule models.ScheduledTransfer) types.ScheduledTransferResponse {
+	response := types.ScheduledTransferResponse{
+		ID:           schedule.ID,
+		Recipient:    toRecipientResponse(schedule.Recipient),
+		FromCurrency: schedule.FromCurrency,
+		ToCurrency:   schedule.ToCurrency,
+		Amount:       schedule.Amount,
+		Note:         schedule.Note,
+		Frequency:    string(schedule.Frequency),
+		StartDate:    schedule.StartDate,
+		EndDate:      schedule.EndDate,
+		MaxRuns:      schedule.MaxRuns,
+		RunCount:     schedule.RunCount,
+		FailureCount: schedule.FailureCount,
+		LastRunAt:    schedule.LastRunAt,
+		Status:       string(schedule.Status),
+		CreatedAt:    schedule.CreatedAt,
+	}
+	if schedule.Status == models.ScheduleActive || schedule.Status == models.SchedulePaused {
+		nextRunAt := schedule.NextRunAt
+		response.NextRunAt = &nextRunAt
+	}
+	return response
+}
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"mime/multipart"
+	"strings"
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
 	"gorm.io/gorm"
@@ -15,8 +17,9 @@ import (
 // UpdateUserSettingsRequest represents user settings update request
 
 type SettingsRequest struct {
-	FeesBreakdown *bool `json:"fees_breakdown,omitempty"`
-	SaveRecipient *bool `json:"save_recipient,omitempty"`
+	FeesBreakdown *bool   `json:"fees_breakdown,omitempty"`
+	SaveRecipient *bool   `json:"save_recipient,omitempty"`
+	Language      *string `json:"language,omitempty"`
 }
 
 type UpdateUserSettingsRequest struct {
@@ -84,7 +87,7 @@ func UpdateUserProfileImage(userId uint, file *multipart.FileHeader) (string, er
 // UpdateUserSettings updates user profile information
 func UpdateUserSettings(userID uint, req UpdateUserSettingsRequest) (any, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Start transaction
@@ -103,6 +106,14 @@ func UpdateUserSettings(userID uint, req UpdateUserSettingsRequest) (any, error)
 	if req.Setting.SaveRecipient != nil {
 		updates["save_recipient"] = req.Setting.SaveRecipient
 	}
+	if req.Setting.Language != nil {
+		language := strings.ToLower(strings.TrimSpace(*req.Setting.Language))
+		if !i18n.Supported(language) {
+			tx.Rollback()
+			return nil, i18n.NewError("error.unsupported_language", i18n.Args{"language": *req.Setting.Language, "languages": strings.Join(i18n.Languages(), ", ")})
+		}
+		updates["language"] = language
+	}
 
 	if err := tx.Model(&models.Setting{}).Where("user_id = ?", userID).Updates(updates).Error; err != nil {
 		tx.Rollback()
@@ -120,7 +131,7 @@ func UpdateUserSettings(userID uint, req UpdateUserSettingsRequest) (any, error)
 // ChangeUserPassword changes user password
 func ChangeUserPassword(userID uint, req ChangePasswordRequest) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if req.CurrentPassword == "" {
@@ -138,7 +149,7 @@ func ChangeUserPassword(userID uint, req ChangePasswordRequest) error {
 	var user models.User
 	if err := database.DB.Where("id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -171,13 +182,13 @@ func ChangeUserPassword(userID uint, req ChangePasswordRequest) error {
 // UpdateUserPreferences updates user preferences
 func UpdateUserPreferences(userID uint32, req UserPreferencesRequest) (*UserPreferencesResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
@@ -199,7 +210,7 @@ func UpdateUserPreferences(userID uint32, req UserPreferencesRequest) (*UserPref
 		response.SMSNotifications = *req.SMSNotifications
 	}
 	if req.Currency != "" {
-		if req.Currency == "NGN" || req.Currency == "GHS" {
+		if isValidCurrency(req.Currency) {
 			response.Currency = req.Currency
 		}
 	}
@@ -213,13 +224,13 @@ func UpdateUserPreferences(userID uint32, req UserPreferencesRequest) (*UserPref
 // GetUserSettings retrieves user settings
 func GetUserSettings(userID uint) (*UserSettingsResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Preload("Setting").Preload("WithdrawMethods").Where("id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
@@ -235,13 +246,13 @@ func GetUserSettings(userID uint) (*UserSettingsResponse, error) {
 // GetUserPreferences retrieves user preferences
 func GetUserPreferences(userID uint32) (*UserPreferencesResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
@@ -259,13 +270,13 @@ func GetUserPreferences(userID uint32) (*UserPreferencesResponse, error) {
 // EnableTwoFactorAuth enables two-factor authentication for user
 func EnableTwoFactorAuth(userID uint) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -291,13 +302,13 @@ func EnableTwoFactorAuth(userID uint) error {
 // DisableTwoFactorAuth disables two-factor authentication for user
 func DisableTwoFactorAuth(userID uint) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -335,6 +346,7 @@ type UpdateProfileRequest struct {
 type UpdateSecuritySettingsRequest struct {
 	TwoFactorEnabled *bool  `json:"twoFactorEnabled"`
 	CurrentPassword  string `json:"currentPassword"`
+	types.StepUpCredentials
 }
 
 // UpdateNotificationSettingsRequest represents notification settings update request
@@ -345,6 +357,8 @@ type UpdateNotificationSettingsRequest struct {
 	TransactionAlerts  *bool `json:"transactionAlerts"`
 	MarketingEmails    *bool `json:"marketingEmails"`
 	SecurityAlerts     *bool `json:"securityAlerts"`
+	// Preferences are explicit category and channel choices, applied after the switches above
+	Preferences []types.NotificationPreferenceUpdate `json:"preferences" binding:"omitempty,dive"`
 }
 
 // EnableTwoFactorRequest represents enable 2FA request
@@ -355,6 +369,7 @@ type EnableTwoFactorRequest struct {
 // DisableTwoFactorRequest represents disable 2FA request
 type DisableTwoFactorRequest struct {
 	Password string `json:"password" validate:"required"`
+	types.StepUpCredentials
 }
 
 // TwoFactorQRResponse represents 2FA QR response
@@ -367,13 +382,13 @@ type TwoFactorQRResponse struct {
 // UpdateUserProfile updates user profile information
 func UpdateUserProfile(userID uint32, req UpdateProfileRequest) (any, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
@@ -437,13 +452,13 @@ func UpdateUserProfile(userID uint32, req UpdateProfileRequest) (any, error) {
 // UpdateSecuritySettings updates security settings
 func UpdateSecuritySettings(userID uint32, req UpdateSecuritySettingsRequest) (map[string]interface{}, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
@@ -458,6 +473,11 @@ func UpdateSecuritySettings(userID uint32, req UpdateSecuritySettingsRequest) (m
 	updates := make(map[string]interface{})
 
 	if req.TwoFactorEnabled != nil {
+		if !*req.TwoFactorEnabled && user.IsTwoFactorEnabled {
+			if err := VerifyStepUp(user.ID, req.StepUpCredentials, 0, ""); err != nil {
+				return nil, err
+			}
+		}
 		updates["is_two_factor_enabled"] = *req.TwoFactorEnabled
 	}
 
@@ -482,44 +502,64 @@ func UpdateSecuritySettings(userID uint32, req UpdateSecuritySettingsRequest) (m
 	return response, nil
 }
 
-// UpdateNotificationSettings updates notification settings
-func UpdateNotificationSettings(userID uint32, req UpdateNotificationSettingsRequest) (map[string]interface{}, error) {
+// GetNotificationSettings returns the user's notification preference matrix
+func GetNotificationSettings(userID uint) ([]types.NotificationCategoryPreferences, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
+	return GetNotificationPreferences(userID)
+}
 
-	// For now, we'll return the requested settings as stored
-	// In a full implementation, you would store these in a notification_settings table
-	response := map[string]interface{}{
-		"emailNotifications": true,
-		"smsNotifications":   false,
-		"pushNotifications":  true,
-		"transactionAlerts":  true,
-		"marketingEmails":    false,
-		"securityAlerts":     true,
+// UpdateNotificationSettings saves the coarse switches on the user's settings, expands the
+// per-topic switches into preference choices, and returns the resulting matrix
+func UpdateNotificationSettings(userID uint, req UpdateNotificationSettingsRequest) ([]types.NotificationCategoryPreferences, error) {
+	if userID == 0 {
+		return nil, ErrUserIDRequired
+	}
+	if req.SecurityAlerts != nil && !*req.SecurityAlerts {
+		return nil, i18n.NewError("error.security_alerts_required", nil)
 	}
 
-	// Update with request values
+	updates := make(map[string]any)
 	if req.EmailNotifications != nil {
-		response["emailNotifications"] = *req.EmailNotifications
-	}
-	if req.SMSNotifications != nil {
-		response["smsNotifications"] = *req.SMSNotifications
+		updates["email_notifications"] = *req.EmailNotifications
 	}
 	if req.PushNotifications != nil {
-		response["pushNotifications"] = *req.PushNotifications
-	}
-	if req.TransactionAlerts != nil {
-		response["transactionAlerts"] = *req.TransactionAlerts
+		updates["push_notifications"] = *req.PushNotifications
 	}
 	if req.MarketingEmails != nil {
-		response["marketingEmails"] = *req.MarketingEmails
+		updates["promotional_notifications"] = *req.MarketingEmails
 	}
-	if req.SecurityAlerts != nil {
-		response["securityAlerts"] = *req.SecurityAlerts
+	if len(updates) > 0 {
+		if err := database.DB.Model(&models.Setting{}).Where("user_id = ?", userID).Updates(updates).Error; err != nil {
+			return nil, fmt.Errorf("failed to update notification settings: %w", err)
+		}
 	}
 
-	return response, nil
+	var choices []types.NotificationPreferenceUpdate
+	if req.SMSNotifications != nil {
+		for _, category := range notificationCategories {
+			if !mandatoryCategories[category] {
+				choices = append(choices, types.NotificationPreferenceUpdate{
+					Category: string(category),
+					Channel:  string(models.ChannelSMS),
+					Enabled:  req.SMSNotifications,
+				})
+			}
+		}
+	}
+	if req.TransactionAlerts != nil {
+		for _, channel := range notificationChannels {
+			choices = append(choices, types.NotificationPreferenceUpdate{
+				Category: string(models.CategoryTransactions),
+				Channel:  string(channel),
+				Enabled:  req.TransactionAlerts,
+			})
+		}
+	}
+	choices = append(choices, req.Preferences...)
+
+	return UpdateNotificationPreferences(userID, choices)
 }
 
 // Platform settings types for admin endpoints
@@ -545,6 +585,8 @@ type PlatformSettingsRequest struct {
 	SendTransactionPending *bool `json:"sendTransactionPending"`
 	SendTransactionRefund  *bool `json:"sendTransactionRefund"`
 	AccountLimitsNotify    *bool `json:"accountLimitsNotification"`
+	// amount above which transfers always need an emailed verification code
+	StepUpThreshold *int64 `json:"stepUpThreshold"`
 }
 
 type PlatformSettingsResponse struct {
@@ -564,11 +606,12 @@ type PlatformSettingsResponse struct {
 	SessionTimeoutMinutes int64  `json:"sessionTimeoutMinutes"`
 	PasswordExpiryDays    int64  `json:"passwordExpiryDays"`
 	// granular notification toggles
-	SendTransactionSuccess bool `json:"sendTransactionSuccess"`
-	SendTransactionDecline bool `json:"sendTransactionDecline"`
-	SendTransactionPending bool `json:"sendTransactionPending"`
-	SendTransactionRefund  bool `json:"sendTransactionRefund"`
-	AccountLimitsNotify    bool `json:"accountLimitsNotification"`
+	SendTransactionSuccess bool  `json:"sendTransactionSuccess"`
+	SendTransactionDecline bool  `json:"sendTransactionDecline"`
+	SendTransactionPending bool  `json:"sendTransactionPending"`
+	SendTransactionRefund  bool  `json:"sendTransactionRefund"`
+	AccountLimitsNotify    bool  `json:"accountLimitsNotification"`
+	StepUpThreshold        int64 `json:"stepUpThreshold"`
 }
 
 // GetPlatformSettings returns platform-wide settings. For now return values from env/defaults.
@@ -599,6 +642,7 @@ func GetPlatformSettings() (*PlatformSettingsResponse, error) {
 				SendTransactionPendingEmail:  true,
 				SendTransactionRefundEmail:   true,
 				AccountLimitsNotification:    true,
+				StepUpAmountThreshold:        100000,
 			}
 			if err := db.Create(&defaultRec).Error; err != nil {
 				return nil, fmt.Errorf("failed to create default platform settings: %w", err)
@@ -630,37 +674,22 @@ func GetPlatformSettings() (*PlatformSettingsResponse, error) {
 		SendTransactionPending: ps.SendTransactionPendingEmail,
 		SendTransactionRefund:  ps.SendTransactionRefundEmail,
 		AccountLimitsNotify:    ps.AccountLimitsNotification,
+		StepUpThreshold:        int64(ps.StepUpAmountThreshold),
 	}
 
 	return resp, nil
 }
 
-// UpdatePlatformSettings updates platform-wide settings. Currently just returns the updated payload.
-func UpdatePlatformSettings(req PlatformSettingsRequest, adminID uint) (*PlatformSettingsResponse, error) {
-	db := database.DB
-
-	tx := db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	var ps models.PlatformSetting
-	if err := tx.Order("id ASC").First(&ps).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// create
-			ps = models.PlatformSetting{}
-			if err := tx.Create(&ps).Error; err != nil {
-				tx.Rollback()
-				return nil, fmt.Errorf("failed to create platform settings: %w", err)
-			}
-		} else {
-			tx.Rollback()
-			return nil, fmt.Errorf("failed to load platform settings: %w", err)
-		}
+// UpdatePlatformSettings updates platform-wide settings, or submits the change for dual approval
+func UpdatePlatformSettings(req PlatformSettingsRequest, actor types.AdminActor) (*PlatformSettingsResponse, error) {
+	if err := requireDualApproval(models.ChangePlatformSettings, "", req, actor); err != nil {
+		return nil, err
 	}
+	return updatePlatformSettings(req, actor)
+}
 
+// buildPlatformSettingsUpdates maps a settings request to the columns it changes
+func buildPlatformSettingsUpdates(req PlatformSettingsRequest) (map[string]any, error) {
 	updates := make(map[string]any)
 	if req.KYCEnforcement != nil {
 		updates["kyc_enforcement"] = *req.KYCEnforcement
@@ -720,7 +749,48 @@ func UpdatePlatformSettings(req PlatformSettingsRequest, adminID uint) (*Platfor
 	if req.DailyUserCap != nil {
 		updates["daily_transaction_limit"] = float64(*req.DailyUserCap)
 	}
+	if req.StepUpThreshold != nil {
+		if *req.StepUpThreshold < 0 {
+			return nil, i18n.NewError("error.step_up_threshold_negative", nil)
+		}
+		updates["step_up_amount_threshold"] = float64(*req.StepUpThreshold)
+	}
+
+	return updates, nil
+}
+
+func updatePlatformSettings(req PlatformSettingsRequest, actor types.AdminActor) (*PlatformSettingsResponse, error) {
+	db := database.DB
+
+	tx := db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var ps models.PlatformSetting
+	if err := tx.Order("id ASC").First(&ps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// create
+			ps = models.PlatformSetting{}
+			if err := tx.Create(&ps).Error; err != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("failed to create platform settings: %w", err)
+			}
+		} else {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to load platform settings: %w", err)
+		}
+	}
 
+	updates, err := buildPlatformSettingsUpdates(req)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	before := ps
 	if len(updates) > 0 {
 		if err := tx.Model(&ps).Updates(updates).Error; err != nil {
 			tx.Rollback()
@@ -728,14 +798,14 @@ func UpdatePlatformSettings(req PlatformSettingsRequest, adminID uint) (*Platfor
 		}
 	}
 
-	// create admin log
-	adminLog := models.AdminLog{
-		AdminID:  uint32(adminID),
-		Action:   "UPDATE_PLATFORM_SETTINGS",
-		Target:   "platform_settings",
-		TargetID: fmt.Sprintf("%d", ps.ID),
-		Details:  fmt.Sprintf("Platform settings updated by admin %d", adminID),
+	var after models.PlatformSetting
+	if err := tx.First(&after, ps.ID).Error; err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to load updated platform settings: %w", err)
 	}
+
+	// create admin log
+	adminLog := actor.NewChangeLog("UPDATE_PLATFORM_SETTINGS", "platform_settings", fmt.Sprintf("%d", ps.ID), fmt.Sprintf("Platform settings updated by admin %d", actor.ID), before, after)
 	if err := tx.Create(&adminLog).Error; err != nil {
 		// don't fail the whole op for logging error, but rollback the transaction
 		tx.Rollback()
@@ -767,6 +837,7 @@ func UpdatePlatformSettings(req PlatformSettingsRequest, adminID uint) (*Platfor
 		EnforceTwoFactor:      ps.EnforceTwoFactor,
 		SessionTimeoutMinutes: int64(ps.SessionTimeoutMinutes),
 		PasswordExpiryDays:    int64(ps.PasswordExpiryDays),
+		StepUpThreshold:       int64(ps.StepUpAmountThreshold),
 	}
 	// add granular flags if present on model
 	// if model has send flags, map them via existing fields
@@ -779,13 +850,13 @@ func UpdatePlatformSettings(req PlatformSettingsRequest, adminID uint) (*Platfor
 // GenerateTwoFactorQR generates QR code for two-factor authentication setup
 func GenerateTwoFactorQR(userID uint32) (*TwoFactorQRResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
@@ -808,7 +879,7 @@ func GenerateTwoFactorQR(userID uint32) (*TwoFactorQRResponse, error) {
 // EnableTwoFactorAuthentication enables 2FA after token verification
 func EnableTwoFactorAuthentication(userID uint, req EnableTwoFactorRequest) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if req.Token == "" {
@@ -818,7 +889,7 @@ func EnableTwoFactorAuthentication(userID uint, req EnableTwoFactorRequest) erro
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -846,7 +917,7 @@ func EnableTwoFactorAuthentication(userID uint, req EnableTwoFactorRequest) erro
 // DisableTwoFactorAuthentication disables 2FA after password verification
 func DisableTwoFactorAuthentication(userID uint, req DisableTwoFactorRequest) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	if req.Password == "" {
@@ -856,7 +927,7 @@ func DisableTwoFactorAuthentication(userID uint, req DisableTwoFactorRequest) er
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -867,7 +938,12 @@ func DisableTwoFactorAuthentication(userID uint, req DisableTwoFactorRequest) er
 
 	// Verify password
 	if err := libs.ComparePassword(user.Password, req.Password); err != nil {
-		return errors.New("password is incorrect")
+		return i18n.NewError("error.password_incorrect", nil)
+	}
+
+	// Turning off 2FA is a sensitive action and needs step-up verification
+	if err := VerifyStepUp(user.ID, req.StepUpCredentials, 0, ""); err != nil {
+		return err
 	}
 
 	// Disable two-factor authentication
@@ -886,13 +962,13 @@ func DisableTwoFactorAuthentication(userID uint, req DisableTwoFactorRequest) er
 // DeactivateAccount deactivates user account
 func DeactivateAccount(userID uint, reason string) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	var user models.User
 	if err := database.DB.Where("user_id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -927,7 +1003,10 @@ func DeactivateAccount(userID uint, reason string) error {
 func UpdateWalletSettings(ID uint, req types.WalletSettingsRequest) error {
 
 	if ID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
+	}
+	if req.Currency != "" && !isValidCurrency(req.Currency) {
+		return invalidCurrencyError("error.invalid_currency")
 	}
 
 	var settings models.Setting
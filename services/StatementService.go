@@ -0,0 +1,685 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/interfaces"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+)
+
+// statementEntry is one line of a statement, in the statement currency. Fee is already
+// included in Debit and is shown for reference.
+type statementEntry struct {
+	Date        time.Time
+	Reference   string
+	Description string
+	Fee         float64
+	Debit       float64
+	Credit      float64
+	Balance     float64
+}
+
+// CreateStatement generates a statement for one of the user's wallets. Short ranges
+// downloaded straight away are rendered during the request; larger ranges and emailed
+// statements are left pending for the statement job.
+func CreateStatement(userID uint, req types.CreateStatementRequest) (*types.StatementResponse, error) {
+	start, err := time.ParseInLocation("2006-01-02", req.StartDate, time.Local)
+	if err != nil {
+		return nil, i18n.NewError("error.start_date_format", nil)
+	}
+	lastDay, err := time.ParseInLocation("2006-01-02", req.EndDate, time.Local)
+	if err != nil {
+		return nil, i18n.NewError("error.end_date_format", nil)
+	}
+	if lastDay.Before(start) {
+		return nil, i18n.NewError("error.end_date_before_start_date", nil)
+	}
+	if start.After(time.Now()) {
+		return nil, i18n.NewError("error.start_date_future", nil)
+	}
+
+	end := lastDay.AddDate(0, 0, 1)
+	days := int(math.Round(end.Sub(start).Hours() / 24))
+	if days > constants.StatementMaxDays {
+		return nil, i18n.NewError("error.statement_range_limit", i18n.Args{"count": constants.StatementMaxDays})
+	}
+
+	var wallet models.Wallet
+	if err := database.DB.Where("user_id = ? AND currency = ?", userID, req.Currency).First(&wallet).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.wallet_missing", i18n.Args{"currency": req.Currency})
+		}
+		return nil, fmt.Errorf("failed to fetch wallet: %w", err)
+	}
+
+	statement := models.Statement{
+		UserID:    userID,
+		Currency:  req.Currency,
+		StartDate: start,
+		EndDate:   end,
+		Format:    models.StatementFormat(libs.GetStringOrDefault(req.Format, string(models.StatementPDF))),
+		Delivery:  models.StatementDelivery(libs.GetStringOrDefault(req.Delivery, string(models.StatementDownload))),
+		Status:    models.StatementPending,
+	}
+	if err := database.DB.Create(&statement).Error; err != nil {
+		return nil, fmt.Errorf("failed to create statement: %w", err)
+	}
+
+	if statement.Delivery == models.StatementDownload && days <= constants.StatementSyncMaxDays {
+		if err := generateStatement(&statement); err != nil {
+			markStatementFailed(&statement, err)
+			return nil, err
+		}
+		response := toStatementResponse(statement)
+		return &response, nil
+	}
+
+	statementClient := jobs.NewStatementJobClient()
+	defer statementClient.Close()
+	if err := statementClient.EnqueueGenerate(statement.ID); err != nil {
+		markStatementFailed(&statement, err)
+		return nil, fmt.Errorf("failed to queue statement: %w", err)
+	}
+
+	response := toStatementResponse(statement)
+	return &response, nil
+}
+
+// GetStatements returns the user's statements, newest first
+func GetStatements(userID uint, query types.StatementQuery) (*types.StatementsResponse, error) {
+	page, limit := types.ValidatePagination(query.Page, query.Limit)
+
+	db := database.DB.Model(&models.Statement{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count statements: %w", err)
+	}
+
+	var statements []models.Statement
+	if err := db.Omit("content").Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).
+		Find(&statements).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch statements: %w", err)
+	}
+
+	response := &types.StatementsResponse{
+		Statements: make([]types.StatementResponse, 0, len(statements)),
+		Pagination: types.NewPaginationResponse(page, limit, total),
+	}
+	for _, statement := range statements {
+		response.Statements = append(response.Statements, toStatementResponse(statement))
+	}
+	return response, nil
+}
+
+// GetStatement returns one of the user's statements with a fresh download link
+func GetStatement(userID, statementID uint) (*types.StatementResponse, error) {
+	var statement models.Statement
+	if err := database.DB.Omit("content").Where("id = ? AND user_id = ?", statementID, userID).
+		First(&statement).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.statement_not_found", nil)
+		}
+		return nil, fmt.Errorf("failed to fetch statement: %w", err)
+	}
+	response := toStatementResponse(statement)
+	return &response, nil
+}
+
+// GetStatementFile returns the rendered statement a signed download link points to
+func GetStatementFile(statementID uint, query types.StatementDownloadQuery) (*models.Statement, error) {
+	if !libs.VerifyLinkSignature(statementLinkPayload(statementID, query.Expires), query.Signature) {
+		return nil, i18n.NewError("error.download_link_invalid", nil)
+	}
+	if time.Now().Unix() > query.Expires {
+		return nil, i18n.NewError("error.download_link_expired", nil)
+	}
+
+	var statement models.Statement
+	if err := database.DB.First(&statement, statementID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.NewError("error.statement_not_found", nil)
+		}
+		return nil, fmt.Errorf("failed to fetch statement: %w", err)
+	}
+	if statement.Status != models.StatementReady || len(statement.Content) == 0 {
+		return nil, i18n.NewError("error.statement_unavailable", nil)
+	}
+	return &statement, nil
+}
+
+// HandleStatementGenerateTask renders a queued statement and delivers it
+func HandleStatementGenerateTask(ctx context.Context, t *asynq.Task) error {
+	var payload jobs.StatementJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal statement payload: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.StatementID == 0 {
+		return fmt.Errorf("statement_id is required: %w", asynq.SkipRetry)
+	}
+
+	var statement models.Statement
+	if err := database.DB.First(&statement, payload.StatementID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("statement %d not found: %w", payload.StatementID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to fetch statement: %w", err)
+	}
+	if statement.Status != models.StatementPending {
+		return nil
+	}
+
+	if err := generateStatement(&statement); err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			markStatementFailed(&statement, err)
+			notifyStatementFailed(statement)
+		}
+		return err
+	}
+
+	deliverStatement(statement)
+	return nil
+}
+
+// HandleStatementPurgeTask deletes statements whose download window has closed
+func HandleStatementPurgeTask(ctx context.Context, t *asynq.Task) error {
+	result := database.DB.Unscoped().Where("expires_at < ?", time.Now()).Delete(&models.Statement{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to purge statements: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("Purged %d expired statements", result.RowsAffected)
+	}
+	return nil
+}
+
+// Helper functions
+
+// generateStatement builds the ledger for a statement, renders it and stores the file
+func generateStatement(statement *models.Statement) error {
+	var user models.User
+	if err := database.DB.First(&user, statement.UserID).Error; err != nil {
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	entries, opening, err := buildStatementLedger(statement.UserID, statement.Currency, statement.StartDate, statement.EndDate)
+	if err != nil {
+		return err
+	}
+	closing := opening
+	if len(entries) > 0 {
+		closing = entries[len(entries)-1].Balance
+	}
+
+	statement.OpeningBalance = opening
+	statement.ClosingBalance = closing
+	statement.EntryCount = len(entries)
+
+	baseName := fmt.Sprintf("jeanpay-statement-%s-%s-%s", strings.ToLower(statement.Currency),
+		statement.StartDate.Format("20060102"), statement.EndDate.AddDate(0, 0, -1).Format("20060102"))
+
+	switch statement.Format {
+	case models.StatementCSV:
+		content, err := renderStatementCSV(statement, entries)
+		if err != nil {
+			return err
+		}
+		statement.Content = content
+		statement.FileName = baseName + ".csv"
+		statement.ContentType = "text/csv; charset=utf-8"
+	default:
+		statement.Content = renderStatementPDF(statement, user, entries)
+		statement.FileName = baseName + ".pdf"
+		statement.ContentType = "application/pdf"
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(constants.StatementRetention)
+	statement.Status = models.StatementReady
+	statement.Error = ""
+	statement.GeneratedAt = &now
+	statement.ExpiresAt = &expiresAt
+
+	if err := database.DB.Save(statement).Error; err != nil {
+		return fmt.Errorf("failed to save statement: %w", err)
+	}
+	return nil
+}
+
+// buildStatementLedger replays the user's completed transactions in one currency. Entries
+// before start make up the opening balance; entries in [start, end) are returned with the
+// running balance after each one.
+func buildStatementLedger(userID uint, currency string, start, end time.Time) ([]statementEntry, float64, error) {
+	var transactions []models.Transaction
+	if err := database.DB.Preload("TransactionDetails").
+		Where("user_id = ? AND status = ? AND created_at < ?", userID, models.TransactionCompleted, end).
+		Order("created_at ASC, id ASC").
+		Find(&transactions).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch transactions: %w", err)
+	}
+
+	// Conversions keep their amounts and fee on the conversion record, and both sides of a
+	// P2P transfer share the same details, so look those up separately
+	var conversionIDs, p2pIDs []string
+	for _, transaction := range transactions {
+		switch transaction.TransactionType {
+		case models.Conversion:
+			conversionIDs = append(conversionIDs, transaction.TransactionID)
+		case models.P2P:
+			p2pIDs = append(p2pIDs, transaction.TransactionID)
+		}
+	}
+
+	conversions := make(map[string]models.Conversions, len(conversionIDs))
+	if len(conversionIDs) > 0 {
+		var records []models.Conversions
+		if err := database.DB.Where("transaction_id IN ?", conversionIDs).Find(&records).Error; err != nil {
+			return nil, 0, fmt.Errorf("failed to fetch conversions: %w", err)
+		}
+		for _, record := range records {
+			conversions[record.TransactionID] = record
+		}
+	}
+
+	sent := make(map[string]bool, len(p2pIDs))
+	if len(p2pIDs) > 0 {
+		var senderIDs []string
+		if err := database.DB.Model(&models.P2PTransfer{}).
+			Where("sender_transaction_id IN ?", p2pIDs).
+			Pluck("sender_transaction_id", &senderIDs).Error; err != nil {
+			return nil, 0, fmt.Errorf("failed to fetch transfers: %w", err)
+		}
+		for _, id := range senderIDs {
+			sent[id] = true
+		}
+	}
+
+	opening := 0.0
+	var entries []statementEntry
+	for _, transaction := range transactions {
+		debit, credit, fee, ok := statementAmounts(transaction, currency, conversions, sent)
+		if !ok {
+			continue
+		}
+		if transaction.CreatedAt.Before(start) {
+			opening += credit - debit
+			continue
+		}
+		entries = append(entries, statementEntry{
+			Date:        transaction.CreatedAt,
+			Reference:   transaction.Reference,
+			Description: libs.GetStringOrDefault(transaction.Description, string(transaction.TransactionType)),
+			Fee:         fee,
+			Debit:       debit,
+			Credit:      credit,
+		})
+	}
+
+	opening = utils.RoundToCurrency(opening, currency)
+	balance := opening
+	for i := range entries {
+		balance = utils.RoundToCurrency(balance+entries[i].Credit-entries[i].Debit, currency)
+		entries[i].Balance = balance
+	}
+	return entries, opening, nil
+}
+
+// statementAmounts returns how a transaction moved the user's wallet in currency. ok is
+// false for transactions that did not touch that wallet.
+func statementAmounts(transaction models.Transaction, currency string, conversions map[string]models.Conversions, sent map[string]bool) (debit, credit, fee float64, ok bool) {
+	details := transaction.TransactionDetails
+	switch transaction.TransactionType {
+	case models.Conversion:
+		conversion, found := conversions[transaction.TransactionID]
+		if !found {
+			return 0, 0, 0, false
+		}
+		if conversion.FromCurrency == currency {
+			return conversion.Amount, 0, conversion.Fee, true
+		}
+		if conversion.ToCurrency == currency {
+			return 0, conversion.ConvertedAmount, 0, true
+		}
+	case models.Deposit:
+		if details.FromCurrency == currency {
+			return 0, details.FromAmount, 0, true
+		}
+	case models.Withdrawal, models.Transfer:
+		if details.FromCurrency == currency {
+			return details.FromAmount, 0, 0, true
+		}
+	case models.P2P:
+		if sent[transaction.TransactionID] {
+			if details.FromCurrency == currency {
+				return details.FromAmount, 0, 0, true
+			}
+		} else if details.ToCurrency == currency {
+			return 0, details.ToAmount, 0, true
+		}
+	}
+	return 0, 0, 0, false
+}
+
+// renderStatementCSV writes the ledger with the opening and closing balances as its first and last rows
+func renderStatementCSV(statement *models.Statement, entries []statementEntry) ([]byte, error) {
+	minorUnits := utils.CurrencyMinorUnits(statement.Currency)
+	amount := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', minorUnits, 64)
+	}
+
+	rows := [][]string{
+		{"Date", "Reference", "Description", "Fee", "Debit", "Credit", "Balance", "Currency"},
+		{statement.StartDate.Format("2006-01-02"), "", "Opening balance", "", "", "", amount(statement.OpeningBalance), statement.Currency},
+	}
+	for _, entry := range entries {
+		rows = append(rows, []string{
+			entry.Date.Format(time.RFC3339),
+			entry.Reference,
+			entry.Description,
+			amount(entry.Fee),
+			amount(entry.Debit),
+			amount(entry.Credit),
+			amount(entry.Balance),
+			statement.Currency,
+		})
+	}
+	rows = append(rows, []string{
+		statement.EndDate.AddDate(0, 0, -1).Format("2006-01-02"), "", "Closing balance", "", "", "", amount(statement.ClosingBalance), statement.Currency,
+	})
+
+	return writeCSV(rows)
+}
+
+// renderStatementPDF lays the statement out as a bank-style A4 document
+func renderStatementPDF(statement *models.Statement, user models.User, entries []statementEntry) []byte {
+	const (
+		left      = 40.0
+		right     = libs.PDFPageWidth - 40
+		rowHeight = 14.0
+		bottom    = libs.PDFPageHeight - 60
+	)
+	amount := func(value float64) string {
+		return formatStatementAmount(value, statement.Currency)
+	}
+	period := fmt.Sprintf("%s - %s", statement.StartDate.Format("02 Jan 2006"), statement.EndDate.AddDate(0, 0, -1).Format("02 Jan 2006"))
+
+	doc := libs.NewPDFDocument()
+	doc.AddPage()
+
+	// Header
+	doc.Text(left, 60, 20, true, "JeanPay")
+	doc.TextRight(right, 60, 14, true, "Account Statement")
+	doc.Line(left, 72, right, 72, 1)
+
+	doc.Text(left, 94, 10, true, strings.TrimSpace(user.FirstName+" "+user.LastName))
+	doc.Text(left, 108, 9, false, user.Email)
+	doc.Text(left, 122, 9, false, fmt.Sprintf("%s wallet", statement.Currency))
+	doc.TextRight(right, 94, 9, false, "Period: "+period)
+	doc.TextRight(right, 108, 9, false, "Generated: "+time.Now().Format("02 Jan 2006 15:04"))
+	doc.TextRight(right, 122, 9, false, fmt.Sprintf("Transactions: %d", len(entries)))
+
+	// Summary
+	totalDebits, totalCredits := 0.0, 0.0
+	for _, entry := range entries {
+		totalDebits += entry.Debit
+		totalCredits += entry.Credit
+	}
+	summary := []struct {
+		label string
+		value float64
+	}{
+		{"Opening balance", statement.OpeningBalance},
+		{"Total credits", totalCredits},
+		{"Total debits", totalDebits},
+		{"Closing balance", statement.ClosingBalance},
+	}
+	doc.Line(left, 140, right, 140, 0.5)
+	columnWidth := (right - left) / float64(len(summary))
+	for i, item := range summary {
+		x := left + float64(i)*columnWidth
+		doc.Text(x, 156, 8, false, item.label)
+		doc.Text(x, 172, 11, true, fmt.Sprintf("%s %s", amount(item.value), statement.Currency))
+	}
+	doc.Line(left, 182, right, 182, 0.5)
+
+	// Ledger
+	columns := []struct {
+		title string
+		x     float64
+		align bool // right aligned
+	}{
+		{"Date", left, false},
+		{"Reference", 95, false},
+		{"Description", 190, false},
+		{"Fee", 360, true},
+		{"Debit", 425, true},
+		{"Credit", 490, true},
+		{"Balance", right, true},
+	}
+	tableHeader := func(y float64) float64 {
+		for _, column := range columns {
+			if column.align {
+				doc.TextRight(column.x, y, 8, true, column.title)
+			} else {
+				doc.Text(column.x, y, 8, true, column.title)
+			}
+		}
+		doc.Line(left, y+5, right, y+5, 0.5)
+		return y + rowHeight + 4
+	}
+	row := func(y float64, bold bool, cells []string) {
+		widths := []float64{52, 92, 110}
+		for i, column := range columns {
+			text := cells[i]
+			if i < len(widths) {
+				text = fitStatementText(doc, text, widths[i], bold)
+			}
+			if column.align {
+				doc.TextRight(column.x, y, 8, bold, text)
+			} else {
+				doc.Text(column.x, y, 8, bold, text)
+			}
+		}
+	}
+
+	y := tableHeader(204)
+	row(y, true, []string{statement.StartDate.Format("02 Jan 2006"), "", "Opening balance", "", "", "", amount(statement.OpeningBalance)})
+	y += rowHeight
+
+	for _, entry := range entries {
+		if y > bottom {
+			doc.AddPage()
+			y = tableHeader(60)
+		}
+		cells := []string{entry.Date.Format("02 Jan 2006"), entry.Reference, entry.Description, "", "", "", amount(entry.Balance)}
+		if entry.Fee > 0 {
+			cells[3] = amount(entry.Fee)
+		}
+		if entry.Debit > 0 {
+			cells[4] = amount(entry.Debit)
+		}
+		if entry.Credit > 0 {
+			cells[5] = amount(entry.Credit)
+		}
+		row(y, false, cells)
+		y += rowHeight
+	}
+
+	if y > bottom {
+		doc.AddPage()
+		y = tableHeader(60)
+	}
+	doc.Line(left, y-9, right, y-9, 0.5)
+	row(y, true, []string{statement.EndDate.AddDate(0, 0, -1).Format("02 Jan 2006"), "", "Closing balance", "", "", "", amount(statement.ClosingBalance)})
+
+	// Footer on every page
+	pages := doc.PageCount()
+	for i := 0; i < pages; i++ {
+		doc.SetPage(i)
+		doc.Line(left, libs.PDFPageHeight-40, right, libs.PDFPageHeight-40, 0.5)
+		doc.Text(left, libs.PDFPageHeight-28, 7, false,
+			fmt.Sprintf("Completed transactions only. Fees are included in debits. Amounts in %s.", statement.Currency))
+		doc.TextRight(right, libs.PDFPageHeight-28, 7, false, fmt.Sprintf("Page %d of %d", i+1, pages))
+	}
+
+	return doc.Bytes()
+}
+
+// fitStatementText shortens text with an ellipsis until it fits a table column
+func fitStatementText(doc *libs.PDFDocument, text string, width float64, bold bool) string {
+	if doc.TextWidth(text, 8, bold) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && doc.TextWidth(string(runes)+"...", 8, bold) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// formatStatementAmount formats an amount with grouping and the currency's minor units
+func formatStatementAmount(amount float64, currency string) string {
+	return humanize.FormatFloat("#,###."+strings.Repeat("#", utils.CurrencyMinorUnits(currency)), amount)
+}
+
+// deliverStatement tells the user a queued statement is ready, and emails it when asked to
+func deliverStatement(statement models.Statement) {
+	var user models.User
+	if err := database.DB.First(&user, statement.UserID).Error; err != nil {
+		log.Printf("Failed to load user for statement %d: %v", statement.ID, err)
+		return
+	}
+
+	period := i18n.Msg("statement.period", i18n.Args{
+		"start": i18n.Day(statement.StartDate),
+		"end":   i18n.Day(statement.EndDate.AddDate(0, 0, -1)),
+	})
+
+	message := i18n.Msg("notification.statement_ready.download", i18n.Args{"currency": statement.Currency, "period": period})
+	if statement.Delivery == models.StatementEmail {
+		message = i18n.Msg("notification.statement_ready.email", i18n.Args{"currency": statement.Currency, "period": period, "email": user.Email})
+	}
+
+	notice := Notice{
+		UserID:   statement.UserID,
+		Category: models.CategoryAccount,
+		Type:     models.StatementType,
+		Title:    i18n.Msg("notification.statement_ready.title", nil),
+		Message:  message,
+	}
+	if statement.Delivery == models.StatementEmail {
+		// The file is attached directly rather than passed through the email queue
+		notice.Email = func(client *jobs.EmailJobClient, user models.User) error {
+			emailService, ok := interfaces.GetGlobalEmailSender().(*EmailService)
+			if !ok {
+				return i18n.NewError("error.email_unavailable", nil)
+			}
+			locale := client.Locale()
+			data := map[string]any{
+				"Locale":         locale.String(),
+				"UserName":       user.FirstName,
+				"Currency":       statement.Currency,
+				"Period":         locale.Render(period),
+				"Format":         strings.ToUpper(string(statement.Format)),
+				"OpeningBalance": locale.Currency(statement.OpeningBalance, statement.Currency),
+				"ClosingBalance": locale.Currency(statement.ClosingBalance, statement.Currency),
+				"EntryCount":     statement.EntryCount,
+				"DownloadURL":    statementDownloadURL(statement),
+				"ExpiresAt":      locale.Date(*statement.ExpiresAt),
+			}
+			attachments := []EmailAttachment{{
+				Filename:    statement.FileName,
+				ContentType: statement.ContentType,
+				Data:        statement.Content,
+			}}
+			return emailService.SendTemplatedEmailWithAttachments([]string{user.Email}, "account_statement", data, attachments)
+		}
+	}
+	if err := Notify(notice); err != nil {
+		log.Printf("Failed to deliver statement %d: %v", statement.ID, err)
+	}
+}
+
+func notifyStatementFailed(statement models.Statement) {
+	notifyAndLog(Notice{
+		UserID:   statement.UserID,
+		Category: models.CategoryAccount,
+		Type:     models.StatementType,
+		Title:    i18n.Msg("notification.statement_failed.title", nil),
+		Message:  i18n.Msg("notification.statement_failed.message", i18n.Args{"currency": statement.Currency}),
+	})
+}
+
+func markStatementFailed(statement *models.Statement, cause error) {
+	statement.Status = models.StatementFailed
+	statement.Error = cause.Error()
+	if err := database.DB.Model(statement).Updates(map[string]any{
+		"status": models.StatementFailed,
+		"error":  cause.Error(),
+	}).Error; err != nil {
+		log.Printf("Failed to mark statement %d as failed: %v", statement.ID, err)
+	}
+}
+
+// statementDownloadURL returns a public link to the statement file, signed until it expires
+func statementDownloadURL(statement models.Statement) string {
+	if statement.Status != models.StatementReady || statement.ExpiresAt == nil {
+		return ""
+	}
+	expires := statement.ExpiresAt.Unix()
+	signature := libs.SignLink(statementLinkPayload(statement.ID, expires))
+	if signature == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%s%s/%d?expires=%d&signature=%s",
+		SERVER, constants.APIBase, constants.StatementFilesBase, statement.ID, expires, signature)
+}
+
+func statementLinkPayload(statementID uint, expires int64) string {
+	return fmt.Sprintf("statement:%d:%d", statementID, expires)
+}
+
+func toStatementResponse(statement models.Statement) types.StatementResponse {
+	response := types.StatementResponse{
+		ID:             statement.ID,
+		Currency:       statement.Currency,
+		StartDate:      statement.StartDate,
+		EndDate:        statement.EndDate.AddDate(0, 0, -1),
+		Format:         string(statement.Format),
+		Delivery:       string(statement.Delivery),
+		Status:         string(statement.Status),
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		EntryCount:     statement.EntryCount,
+		FileName:       statement.FileName,
+		DownloadURL:    statementDownloadURL(statement),
+		GeneratedAt:    statement.GeneratedAt,
+		ExpiresAt:      statement.ExpiresAt,
+		CreatedAt:      statement.CreatedAt,
+	}
+	// The stored error is for operators; users only see that generation failed
+	if statement.Status == models.StatementFailed {
+		response.Error = "statement could not be generated"
+	}
+	return response
+}
@@ -0,0 +1,379 @@
+package services
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/libs"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
+	"gorm.io/gorm"
+)
+
+// Step-up verification errors
+var (
+	ErrStepUpRequired        = i18n.NewError("error.step_up_required", nil)
+	ErrStepUpCodeRequired    = i18n.NewError("error.step_up_code_required", nil)
+	ErrTransactionPinNotSet  = i18n.NewError("error.transaction_pin_not_set", nil)
+	ErrInvalidTransactionPin = i18n.NewError("error.transaction_pin_invalid", nil)
+)
+
+// Purposes for emailed verification codes
+const (
+	codePurposeStepUp   = "step_up"
+	codePurposePinReset = "pin_reset"
+)
+
+// SetTransactionPin sets the transaction PIN for a user who does not have one yet
+func SetTransactionPin(userID uint, req types.SetTransactionPinRequest) error {
+	user, err := findPinUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.TransactionPin != "" {
+		return i18n.NewError("error.transaction_pin_exists", nil)
+	}
+
+	if err := libs.ComparePassword(user.Password, req.Password); err != nil {
+		return i18n.NewError("error.password_incorrect", nil)
+	}
+
+	if err := validateTransactionPin(req.Pin, req.ConfirmPin); err != nil {
+		return err
+	}
+
+	if err := saveTransactionPin(user, req.Pin); err != nil {
+		return err
+	}
+
+	if err := CreateSecurityNotification(user.ID, "Transaction PIN set"); err != nil {
+		fmt.Printf("Failed to create security notification: %v\n", err)
+	}
+	return nil
+}
+
+// ChangeTransactionPin replaces the transaction PIN after checking the current one
+func ChangeTransactionPin(userID uint, req types.ChangeTransactionPinRequest) error {
+	user, err := findPinUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.TransactionPin == "" {
+		return ErrTransactionPinNotSet
+	}
+
+	if stepUpBlocked(user.ID) {
+		return ErrTooManyAttempts
+	}
+
+	if err := libs.ComparePassword(user.TransactionPin, req.CurrentPin); err != nil {
+		recordFailedStepUp(user.ID)
+		return i18n.NewError("error.current_pin_incorrect", nil)
+	}
+
+	if err := validateTransactionPin(req.NewPin, req.ConfirmPin); err != nil {
+		return err
+	}
+
+	if err := saveTransactionPin(user, req.NewPin); err != nil {
+		return err
+	}
+	clearFailedStepUps(user.ID)
+
+	if err := CreateSecurityNotification(user.ID, "Transaction PIN changed"); err != nil {
+		fmt.Printf("Failed to create security notification: %v\n", err)
+	}
+	return nil
+}
+
+// RequestTransactionPinReset emails a one-time code that can be used to reset the PIN
+func RequestTransactionPinReset(userID uint) error {
+	user, err := findPinUser(userID)
+	if err != nil {
+		return err
+	}
+	return sendVerificationCode(user, codePurposePinReset)
+}
+
+// ResetTransactionPin sets a new transaction PIN using an emailed reset code
+func ResetTransactionPin(userID uint, req types.ResetTransactionPinRequest) error {
+	user, err := findPinUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if stepUpBlocked(user.ID) {
+		return ErrTooManyAttempts
+	}
+
+	if err := validateTransactionPin(req.NewPin, req.ConfirmPin); err != nil {
+		return err
+	}
+
+	if !consumeVerificationCode(user.ID, codePurposePinReset, req.Code) {
+		recordFailedStepUp(user.ID)
+		return ErrInvalidCode
+	}
+
+	if err := saveTransactionPin(user, req.NewPin); err != nil {
+		return err
+	}
+	clearFailedStepUps(user.ID)
+
+	if err := CreateSecurityNotification(user.ID, "Transaction PIN reset"); err != nil {
+		fmt.Printf("Failed to create security notification: %v\n", err)
+	}
+	return nil
+}
+
+// RequestStepUpCode emails a short-lived code that authorises a single sensitive action
+func RequestStepUpCode(userID uint) error {
+	user, err := findPinUser(userID)
+	if err != nil {
+		return err
+	}
+	return sendVerificationCode(user, codePurposeStepUp)
+}
+
+// VerifyStepUp authorises a sensitive action. A fresh emailed code is always accepted;
+// the transaction PIN is accepted only while the amount is below the step-up threshold for
+// its currency. Actions without an amount pass 0 and an empty currency.
+func VerifyStepUp(userID uint, credentials types.StepUpCredentials, amount float64, currency string) error {
+	if userID == 0 {
+		return ErrUserIDRequired
+	}
+
+	if stepUpBlocked(userID) {
+		return ErrTooManyAttempts
+	}
+
+	if credentials.OtpCode != "" {
+		if !consumeVerificationCode(userID, codePurposeStepUp, credentials.OtpCode) {
+			recordFailedStepUp(userID)
+			return ErrInvalidTransactionPin
+		}
+		clearFailedStepUps(userID)
+		return nil
+	}
+
+	if amount > 0 && stepUpCodeRequired(amount, currency) {
+		return ErrStepUpCodeRequired
+	}
+
+	if credentials.Pin == "" {
+		return ErrStepUpRequired
+	}
+
+	var user models.User
+	if err := database.DB.Select("id", "transaction_pin").First(&user, userID).Error; err != nil {
+		return ErrUserNotFound
+	}
+
+	if user.TransactionPin == "" {
+		return ErrTransactionPinNotSet
+	}
+
+	if err := libs.ComparePassword(user.TransactionPin, credentials.Pin); err != nil {
+		recordFailedStepUp(userID)
+		return ErrInvalidTransactionPin
+	}
+
+	clearFailedStepUps(userID)
+	return nil
+}
+
+// IsStepUpError reports whether err came from step-up verification
+func IsStepUpError(err error) bool {
+	return errors.Is(err, ErrStepUpRequired) ||
+		errors.Is(err, ErrStepUpCodeRequired) ||
+		errors.Is(err, ErrTransactionPinNotSet) ||
+		errors.Is(err, ErrInvalidTransactionPin) ||
+		errors.Is(err, ErrTooManyAttempts)
+}
+
+// Helper functions
+
+func findPinUser(userID uint) (*models.User, error) {
+	if userID == 0 {
+		return nil, ErrUserIDRequired
+	}
+
+	var user models.User
+	if err := database.DB.First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	return &user, nil
+}
+
+func validateTransactionPin(pin, confirmPin string) error {
+	if len(pin) < constants.TransactionPinMinLength || len(pin) > constants.TransactionPinMaxLength {
+		return i18n.NewError("error.transaction_pin_length", i18n.Args{"min": constants.TransactionPinMinLength, "max": constants.TransactionPinMaxLength})
+	}
+	for _, r := range pin {
+		if !unicode.IsDigit(r) {
+			return i18n.NewError("error.transaction_pin_digits", nil)
+		}
+	}
+	if pin != confirmPin {
+		return i18n.NewError("error.transaction_pin_mismatch", nil)
+	}
+	return nil
+}
+
+func saveTransactionPin(user *models.User, pin string) error {
+	hashedPin, err := libs.HashPassword(pin)
+	if err != nil {
+		return fmt.Errorf("failed to hash transaction PIN: %w", err)
+	}
+
+	now := time.Now()
+	updates := map[string]any{
+		"transaction_pin": hashedPin,
+		"pin_updated_at":  &now,
+	}
+	if err := database.DB.Model(user).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to save transaction PIN: %w", err)
+	}
+	return nil
+}
+
+// stepUpCodeRequired reports whether amount, in currency, is large enough to need a fresh
+// emailed code. A currency's own threshold wins; otherwise the platform threshold, which is
+// set in the platform's default currency, is converted at the active rate. When the threshold
+// cannot be worked out the code is required rather than letting a PIN through.
+func stepUpCodeRequired(amount float64, currency string) bool {
+	currency = strings.ToUpper(currency)
+
+	var registered models.Currency
+	err := database.DB.Select("code", "step_up_threshold").Where("code = ?", currency).First(&registered).Error
+	if err == nil && registered.StepUpThreshold > 0 {
+		return amount >= registered.StepUpThreshold
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("Failed to load step-up threshold for %s: %v", currency, err)
+		return true
+	}
+
+	settings, err := GetPlatformSettings()
+	if err != nil {
+		log.Printf("Failed to load step-up threshold: %v", err)
+		return true
+	}
+	threshold := float64(settings.StepUpThreshold)
+	if threshold <= 0 {
+		return false
+	}
+
+	base := strings.ToUpper(settings.DefaultCurrency)
+	if currency == "" || base == "" || currency == base {
+		return amount >= threshold
+	}
+
+	rate, err := getCurrentExchangeRate(base, currency)
+	if err != nil || rate <= 0 {
+		log.Printf("No %s-%s rate to convert the step-up threshold, requiring a code: %v", base, currency, err)
+		return true
+	}
+	return amount >= threshold*rate
+}
+
+func verificationCodeKey(purpose string, userID uint) string {
+	return fmt.Sprintf("%s:%d", purpose, userID)
+}
+
+func stepUpAttemptKey(userID uint) string {
+	return fmt.Sprintf("step_up_attempts:%d", userID)
+}
+
+// sendVerificationCode sends a new code through the existing two-factor email flow, and
+// by SMS where the platform allows it
+func sendVerificationCode(user *models.User, purpose string) error {
+	code := libs.GenerateOTP(6)
+
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	key := verificationCodeKey(purpose, user.ID)
+	if err := utils.SetRedisKey(redisClient, key, libs.SHA256(key+code), constants.StepUpCodeTTL); err != nil {
+		return i18n.NewError("error.verification_code_create_failed", nil)
+	}
+
+	err := Notify(Notice{
+		UserID:   user.ID,
+		Category: models.CategorySecurity,
+		Email: func(client *jobs.EmailJobClient, user models.User) error {
+			return client.EnqueueTwoFactorEmail(user.Email, user.FirstName, code)
+		},
+		SMSTemplate: constants.SMSTemplateOTP,
+		SMSData:     otpSMSData(code, constants.StepUpCodeTTL),
+	})
+	if err != nil {
+		return i18n.NewError("error.verification_code_send_failed", nil)
+	}
+	return nil
+}
+
+// consumeVerificationCode checks a code and deletes it so it cannot be replayed
+func consumeVerificationCode(userID uint, purpose, code string) bool {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	key := verificationCodeKey(purpose, userID)
+	stored, err := utils.GetRedisValue(redisClient, key)
+	if err != nil || stored == "" {
+		return false
+	}
+
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(libs.SHA256(key+code))) != 1 {
+		return false
+	}
+
+	utils.DeleteRedisKey(redisClient, key)
+	return true
+}
+
+func stepUpBlocked(userID uint) bool {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	value, err := utils.GetRedisValue(redisClient, stepUpAttemptKey(userID))
+	if err != nil {
+		return false
+	}
+	attempts, err := libs.ConvertStringToInt(value)
+	return err == nil && attempts >= constants.StepUpMaxAttempts
+}
+
+func recordFailedStepUp(userID uint) {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	attempts := incrementAttempts(redisClient, stepUpAttemptKey(userID), constants.StepUpAttemptWindow)
+	if attempts == constants.StepUpMaxAttempts {
+		if err := CreateSecurityNotification(userID, "Transaction PIN blocked after repeated incorrect attempts"); err != nil {
+			fmt.Printf("Failed to create security notification: %v\n", err)
+		}
+	}
+}
+
+func clearFailedStepUps(userID uint) {
+	redisClient := utils.NewRedisClient()
+	defer redisClient.Close()
+
+	utils.DeleteRedisKey(redisClient, stepUpAttemptKey(userID))
+}
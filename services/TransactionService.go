@@ -4,11 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
-	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
 	"github.com/Veedsify/JeanPayGoBackend/utils"
@@ -19,19 +20,53 @@ func CreateTransaction(userId uint, transaction types.NewTransactionRequest) (ty
 	if userId == 0 {
 		return types.CreateNewTransactionResponse{}, "", errors.New("user is not valid")
 	}
+	stepUpAmount, _ := utils.ConvertStringToFloat(transaction.FromAmount)
+	if err := VerifyStepUp(userId, transaction.StepUpCredentials, stepUpAmount, transaction.FromCurrency); err != nil {
+		if errors.Is(err, ErrInvalidTransactionPin) {
+			return types.CreateNewTransactionResponse{}, "INVALID_PIN", err
+		}
+		if errors.Is(err, ErrTooManyAttempts) {
+			return types.CreateNewTransactionResponse{}, "RATE_LIMIT", err
+		}
+		return types.CreateNewTransactionResponse{}, "STEP_UP_REQUIRED", err
+	}
+	return createTransaction(userId, transaction)
+}
+
+// createTransaction places a transfer the user has already authorised. Scheduled transfers
+// call it directly because the user confirmed step-up when the schedule was created.
+func createTransaction(userId uint, transaction types.NewTransactionRequest) (types.CreateNewTransactionResponse, string, error) {
 	user, err := GetUserById(userId)
 	balances, err2 := GetWalletBalance(userId)
 	if err != nil {
-		return types.CreateNewTransactionResponse{}, "", errors.New("user not found")
+		return types.CreateNewTransactionResponse{}, "", ErrUserNotFound
 	}
 	if err2 != nil {
 		return types.CreateNewTransactionResponse{}, "", errors.New("wallet balance not found")
 	}
+	if transaction.RecipientID != 0 {
+		if err := ApplySavedRecipient(userId, &transaction); err != nil {
+			return types.CreateNewTransactionResponse{}, "INVALID_RECIPIENT", err
+		}
+	}
+
+	response, code, err := placeTransfer(user, balances, transaction)
+	if err == nil && transaction.RecipientID != 0 {
+		markSavedRecipientUsed(transaction.RecipientID)
+	}
+	return response, code, err
+}
+
+// placeTransfer creates the transfer once the destination is known
+func placeTransfer(user *libs.UserInfo, balances []types.WalletBalance, transaction types.NewTransactionRequest) (types.CreateNewTransactionResponse, string, error) {
+	if err := validateCorridor(transaction.FromCurrency, transaction.ToCurrency, 0); err != nil {
+		return types.CreateNewTransactionResponse{}, "INVALID_CURRENCY", err
+	}
 	TransactionIdx, err := libs.SecureRandomNumber(16)
 	if err != nil {
 		return types.CreateNewTransactionResponse{}, "INTERNAL_SERVER_ERROR", errors.New("failed to generate transaction index")
 	}
-	var transactionDir = utils.GetConvertdirection(transaction.FromCurrency)
+	var transactionDir = models.ConversionDirection(transaction.FromCurrency, transaction.ToCurrency)
 
 	switch transaction.MethodOfPayment {
 	case "wallet":
@@ -55,7 +90,7 @@ func CreateTransaction(userId uint, transaction types.NewTransactionRequest) (ty
 						Status:          models.TransactionFailed,
 						TransactionType: models.Transfer,
 						Code:            code,
-						Description:     fmt.Sprintf("Transfer %s %s to %s", transaction.ToCurrency, transaction.ToAmount, transaction.RecipientName),
+						Description:     withTransferNote(fmt.Sprintf("Transfer %s %s to %s", transaction.ToCurrency, transaction.ToAmount, transaction.RecipientName), transaction.Note),
 						Reference:       libs.GenerateUniqueID(),
 						Direction:       transactionDir,
 						TransactionDetails: models.TransactionDetails{
@@ -66,6 +101,7 @@ func CreateTransaction(userId uint, transaction types.NewTransactionRequest) (ty
 							RecipientName:   transaction.RecipientName,
 							AccountNumber:   transaction.AccountNumber,
 							BankName:        transaction.BankName,
+							BankCode:        transaction.BankCode,
 							PhoneNumber:     transaction.PhoneNumber,
 							Network:         transaction.Network,
 							MethodOfPayment: transaction.MethodOfPayment,
@@ -86,7 +122,7 @@ func CreateTransaction(userId uint, transaction types.NewTransactionRequest) (ty
 			PaymentType:     models.PaymentType(transaction.Method),
 			Status:          models.TransactionPending,
 			TransactionType: models.Transfer,
-			Description:     fmt.Sprintf("Transfer %s %s to %s", transaction.FromCurrency, transaction.FromAmount, transaction.RecipientName),
+			Description:     withTransferNote(fmt.Sprintf("Transfer %s %s to %s", transaction.FromCurrency, transaction.FromAmount, transaction.RecipientName), transaction.Note),
 			Reference:       libs.GenerateUniqueID(),
 			Direction:       transactionDir,
 			TransactionDetails: models.TransactionDetails{
@@ -97,6 +133,7 @@ func CreateTransaction(userId uint, transaction types.NewTransactionRequest) (ty
 				RecipientName:   transaction.RecipientName,
 				AccountNumber:   transaction.AccountNumber,
 				BankName:        transaction.BankName,
+				BankCode:        transaction.BankCode,
 				PhoneNumber:     transaction.PhoneNumber,
 				Network:         transaction.Network,
 				MethodOfPayment: transaction.MethodOfPayment,
@@ -150,7 +187,7 @@ func HandleDirectTransaction(transaction types.NewTransactionRequest, transactio
 		return types.CreateNewTransactionResponse{}, "INVALID_AMOUNTS", errors.New("invalid amount")
 	}
 
-	var transactionDir = utils.GetConvertdirection(transaction.FromCurrency)
+	var transactionDir = models.ConversionDirection(transaction.FromCurrency, transaction.ToCurrency)
 
 	// Create a pending transaction for direct payment
 	pendingTransaction := models.Transaction{
@@ -161,7 +198,7 @@ func HandleDirectTransaction(transaction types.NewTransactionRequest, transactio
 		TransactionType: models.Transfer,
 		Reference:       libs.GenerateUniqueID(),
 		Direction:       transactionDir,
-		Description:     fmt.Sprintf("Transfer %s %s to %s", transaction.ToCurrency, transaction.ToAmount, transaction.RecipientName),
+		Description:     withTransferNote(fmt.Sprintf("Transfer %s %s to %s", transaction.ToCurrency, transaction.ToAmount, transaction.RecipientName), transaction.Note),
 		TransactionDetails: models.TransactionDetails{
 			ToCurrency:      transaction.ToCurrency,
 			FromCurrency:    transaction.FromCurrency,
@@ -170,6 +207,7 @@ func HandleDirectTransaction(transaction types.NewTransactionRequest, transactio
 			RecipientName:   transaction.RecipientName,
 			AccountNumber:   transaction.AccountNumber,
 			BankName:        transaction.BankName,
+			BankCode:        transaction.BankCode,
 			PhoneNumber:     transaction.PhoneNumber,
 			Network:         transaction.Network,
 			MethodOfPayment: transaction.MethodOfPayment,
@@ -180,17 +218,16 @@ func HandleDirectTransaction(transaction types.NewTransactionRequest, transactio
 		return types.CreateNewTransactionResponse{}, "INTERNAL_SERVER_ERROR", errors.New("failed to create transaction")
 	}
 
-	title := "Transaction Successful"
-	message := fmt.Sprintf("Your transfer of %s to %s was successful.", utils.FormatCurrency(fromAmount, transaction.FromCurrency), transaction.RecipientName)
-	notificationClient := jobs.NewNotificationJobClient()
-	defer notificationClient.Close()
-
-	notificationClient.EnqueueCreateNotification(
-		userId,
-		models.NotificationType("transfer"),
-		title,
-		message,
-	)
+	notifyAndLog(Notice{
+		UserID:   userId,
+		Category: models.CategoryTransactions,
+		Type:     models.TransferType,
+		Title:    i18n.Msg("notification.transaction_successful.title", nil),
+		Message: i18n.Msg("notification.transaction_successful.message", i18n.Args{
+			"amount":    i18n.Amount(fromAmount, transaction.FromCurrency),
+			"recipient": transaction.RecipientName,
+		}),
+	})
 
 	return types.CreateNewTransactionResponse{
 		Transaction: types.TransactionResponse{
@@ -326,7 +363,7 @@ func GetAllTransactions(filter types.TransactionFilter, pagination types.Paginat
 // GetUserTransactionHistory retrieves transaction history for a specific user
 func GetUserTransactionHistory(userID uint32, filter types.TransactionFilter, pagination types.PaginationRequest) ([]types.TransactionResponse, *types.PaginationResponse, error) {
 	if userID == 0 {
-		return nil, nil, errors.New("user ID is required")
+		return nil, nil, ErrUserIDRequired
 	}
 	// Build query for user transactions
 	query := database.DB.Model(&models.Transaction{}).Where("user_id = ?", userID)
@@ -407,7 +444,7 @@ func GetTransactionDetails(transactionID string, userID uint32) (*types.Transact
 	}
 	if err := query.First(&transaction).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("transaction not found")
+			return nil, i18n.NewError("error.transaction_not_found", nil)
 		}
 		return nil, fmt.Errorf("failed to find transaction: %w", err)
 	}
@@ -435,20 +472,20 @@ func GetTransactionDetails(transactionID string, userID uint32) (*types.Transact
 }
 
 // UpdateTransactionStatus updates the status of a transaction (Admin only)
-func UpdateTransactionStatus(transactionID string, newStatus string, adminID uint32, reason string) error {
+func UpdateTransactionStatus(transactionID string, newStatus string, actor types.AdminActor, reason string) error {
 	if transactionID == "" {
 		return errors.New("transaction ID is required")
 	}
 	if !isValidTransactionStatus(newStatus) {
 		return errors.New("invalid transaction status")
 	}
-	if adminID == 0 {
+	if actor.ID == 0 {
 		return errors.New("admin ID is required")
 	}
 	var transaction models.Transaction
 	if err := database.DB.Where("transaction_id = ?", transactionID).First(&transaction).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("transaction not found")
+			return i18n.NewError("error.transaction_not_found", nil)
 		}
 		return fmt.Errorf("failed to find transaction: %w", err)
 	}
@@ -463,14 +500,10 @@ func UpdateTransactionStatus(transactionID string, newStatus string, adminID uin
 	if err := database.DB.Model(&transaction).Updates(updates).Error; err != nil {
 		return fmt.Errorf("failed to update transaction: %w", err)
 	}
+	publishTransactionStatus(transaction, models.TransactionStatus(newStatus))
 	// Create admin log entry
-	adminLog := models.AdminLog{
-		AdminID:  adminID,
-		Action:   "update_transaction_status",
-		Target:   "transaction",
-		TargetID: transactionID,
-		Details:  fmt.Sprintf("Status changed to %s. Reason: %s", newStatus, reason),
-	}
+	adminLog := actor.NewLog("update_transaction_status", "transaction", transactionID,
+		fmt.Sprintf("Status changed to %s. Reason: %s", newStatus, reason))
 	if err := database.DB.Create(&adminLog).Error; err != nil {
 		// Log error but don't fail the transaction update
 		fmt.Printf("Failed to create admin log: %v\n", err)
@@ -486,7 +519,7 @@ func GetTransactionByReference(reference string) (*models.Transaction, error) {
 	var transaction models.Transaction
 	if err := database.DB.Where("reference = ?", reference).First(&transaction).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("transaction not found")
+			return nil, i18n.NewError("error.transaction_not_found", nil)
 		}
 		return nil, fmt.Errorf("failed to find transaction: %w", err)
 	}
@@ -510,7 +543,10 @@ func UpdateTransactionStatusByReference(reference string, status string) error {
 		return fmt.Errorf("failed to update transaction: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return errors.New("transaction not found")
+		return i18n.NewError("error.transaction_not_found", nil)
+	}
+	if transaction, err := GetTransactionByReference(reference); err == nil {
+		publishTransactionStatus(*transaction, models.TransactionStatus(status))
 	}
 	return nil
 }
@@ -676,7 +712,7 @@ func GetTransactionDetailsService(transactionID string, userID uint, isAdmin boo
 		err := database.DB.Where("transaction_id = ?", transactionID).First(&transaction).Error
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, errors.New("transaction not found")
+				return nil, i18n.NewError("error.transaction_not_found", nil)
 			}
 			return nil, errors.New("failed to get transaction")
 		}
@@ -685,7 +721,7 @@ func GetTransactionDetailsService(transactionID string, userID uint, isAdmin boo
 		err := database.DB.Preload("TransactionDetails").Where("transaction_id = ? AND user_id = ?", transactionID, userID).First(&transaction).Error
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, errors.New("transaction not found")
+				return nil, i18n.NewError("error.transaction_not_found", nil)
 			}
 			return nil, errors.New("failed to get transaction")
 		}
@@ -698,58 +734,17 @@ func GetTransactionDetailsService(transactionID string, userID uint, isAdmin boo
 	}
 	response := &types.TransactionDetailsResponse{
 		Transaction: transaction,
+		ReceiptURL:  receiptVerifyURL(transaction.TransactionID, transaction.ReceiptLinkVersion),
 	}
 	return response, nil
 }
 
-// UpdateTransactionStatusService updates transaction status
-func UpdateTransactionStatusService(transactionID string, request types.UpdateTransactionStatusRequest, adminID uint32) (*types.TransactionActionResponse, error) {
-	var transaction models.Transaction
-	err := database.DB.Where("transaction_id = ?", transactionID).First(&transaction).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("transaction not found")
-		}
-		return nil, errors.New("failed to get transaction")
-	}
-	// Start transaction
-	tx := database.DB.Begin()
-	// Update transaction status
-	oldStatus := transaction.Status
-	transaction.Status = models.TransactionStatus(request.Status)
-	if request.Reason != "" {
-		transaction.Description += fmt.Sprintf(" | Admin update: %s", request.Reason)
+// withTransferNote appends the sender's note, if any, to a transfer description
+func withTransferNote(description, note string) string {
+	if note = strings.TrimSpace(note); note != "" {
+		return description + ": " + note
 	}
-	if err := tx.Save(&transaction).Error; err != nil {
-		tx.Rollback()
-		return nil, errors.New("failed to update transaction status")
-	}
-	// Log admin action
-	adminLog := models.AdminLog{
-		AdminID:  adminID,
-		Action:   "update_transaction_status",
-		Target:   "transaction",
-		TargetID: transactionID,
-		Details: fmt.Sprint(map[string]interface{}{
-			"transaction_id":  transactionID,
-			"previous_status": string(oldStatus),
-			"new_status":      request.Status,
-			"reason":          request.Reason,
-		}),
-	}
-	if err := tx.Create(&adminLog).Error; err != nil {
-		tx.Rollback()
-		return nil, errors.New("failed to log admin action")
-	}
-	tx.Commit()
-	response := &types.TransactionActionResponse{
-		TransactionID: transactionID,
-		Status:        request.Status,
-		ActionedAt:    time.Now().Format(time.RFC3339),
-		ActionedBy:    adminID,
-		Reason:        request.Reason,
-	}
-	return response, nil
+	return description
 }
 
 // CreateDepositTransactionService creates a new deposit transaction
@@ -764,13 +759,7 @@ func CreateDepositTransactionService(userID uint, request types.CreateDepositReq
 	// Generate transaction ID and reference
 	transactionID := libs.GenerateUniqueID()
 	reference := fmt.Sprintf("DEP_%s_%d", request.Currency, time.Now().Unix())
-	// Determine direction
-	var direction models.TransactionDirection
-	if request.Currency == "NGN" {
-		direction = models.DepositNGN
-	} else {
-		direction = models.DepositGHS
-	}
+	direction := models.DepositDirection(request.Currency)
 	transaction := models.Transaction{
 		UserID:          userID,
 		TransactionID:   transactionID,
@@ -807,27 +796,17 @@ func CreateWithdrawalTransactionService(userID uint, request types.CreateWithdra
 	}
 	// Check wallet balance
 	var wallet models.Wallet
-	err := database.DB.Where("user_id = ?", userID).First(&wallet).Error
+	err := database.DB.Where("user_id = ? AND currency = ?", userID, request.Currency).First(&wallet).Error
 	if err != nil {
 		return nil, errors.New("wallet not found")
 	}
-	var balance float64
-	if request.Currency == "NGN" {
-		balance = wallet.Balance
-	} else {
-		balance = wallet.Balance
-	}
-	if balance < request.Amount {
-		return nil, errors.New("insufficient balance")
+	if wallet.Balance < request.Amount {
+		return nil, ErrInsufficientBalance
 	}
 	// Start transaction
 	tx := database.DB.Begin()
 	// Deduct from wallet balance
-	if request.Currency == "NGN" {
-		wallet.Balance -= request.Amount
-	} else {
-		wallet.Balance -= request.Amount
-	}
+	wallet.Balance -= request.Amount
 	if err := tx.Save(&wallet).Error; err != nil {
 		tx.Rollback()
 		return nil, errors.New("failed to update wallet balance")
@@ -835,13 +814,7 @@ func CreateWithdrawalTransactionService(userID uint, request types.CreateWithdra
 	// Generate transaction ID and reference
 	transactionID := libs.GenerateUniqueID()
 	reference := fmt.Sprintf("WDR_%s_%d", request.Currency, time.Now().Unix())
-	// Determine direction
-	var direction models.TransactionDirection
-	if request.Currency == "NGN" {
-		direction = models.WithdrawalNGN
-	} else {
-		direction = models.WithdrawalGHS
-	}
+	direction := models.WithdrawalDirection(request.Currency)
 	transaction := models.Transaction{
 		UserID:          userID,
 		TransactionID:   transactionID,
@@ -850,6 +823,13 @@ func CreateWithdrawalTransactionService(userID uint, request types.CreateWithdra
 		Reference:       reference,
 		Direction:       direction,
 		Description:     fmt.Sprintf("Withdrawal to %s. %s", request.BankAccount, request.Description),
+		TransactionDetails: models.TransactionDetails{
+			AccountNumber: request.BankAccount,
+			FromCurrency:  request.Currency,
+			ToCurrency:    request.Currency,
+			FromAmount:    request.Amount,
+			ToAmount:      request.Amount,
+		},
 	}
 	if err := tx.Create(&transaction).Error; err != nil {
 		tx.Rollback()
@@ -917,18 +897,6 @@ func GetTransactionStatsService(userID string) (*types.TransactionStatsResponse,
 	return &stats, nil
 }
 
-// Helper functions
-// isValidCurrency checks if currency is valid
-func isValidCurrency(currency string) bool {
-	validCurrencies := []string{"NGN", "GHS"}
-	for _, c := range validCurrencies {
-		if c == currency {
-			return true
-		}
-	}
-	return false
-}
-
 // FilterTransactions filters transactions based on criteria
 func FilterTransactions(userID uint32, filterReq types.TransactionFilterRequest) (*types.GetTransactionsResponse, error) {
 	query := database.DB.Model(&models.Transaction{}).Where("user_id = ?", userID)
@@ -35,6 +35,7 @@ func FetchUser(UserId uint) (types.UserResponse, error) {
 		IsBlocked:      userData.IsBlocked,
 		IsVerified:     userData.IsVerified,
 		Setting:        userData.Setting,
+		HasPin:         userData.TransactionPin != "",
 	}
 
 	return response, nil
@@ -7,7 +7,7 @@ import (
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
-	"github.com/Veedsify/JeanPayGoBackend/jobs"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
 	"github.com/Veedsify/JeanPayGoBackend/utils"
@@ -15,10 +15,10 @@ import (
 	"gorm.io/gorm"
 )
 
-// GetWalletBalance retrieves the wallet balances for a user (both NGN and GHS)
+// GetWalletBalance retrieves the wallet balances for a user, one per enabled currency
 func GetWalletBalance(userID uint) ([]types.WalletBalance, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Find or create wallets
@@ -51,7 +51,7 @@ func GetWalletBalance(userID uint) ([]types.WalletBalance, error) {
 // TopUpWallet initiates a wallet top-up
 func TopUpWallet(userID uint, req types.TopUpRequest) (*types.TopUpResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Validate request
@@ -94,7 +94,7 @@ func TopUpWallet(userID uint, req types.TopUpRequest) (*types.TopUpResponse, err
 		Status:          models.TransactionPending,
 		TransactionType: models.Deposit,
 		Reference:       reference,
-		Direction:       getDepositDirection(req.Currency),
+		Direction:       models.DepositDirection(req.Currency),
 		Description:     fmt.Sprintf("Wallet top-up of %s %s using %s", formattedCurrency, req.Currency, req.PaymentMethod),
 		TransactionDetails: models.TransactionDetails{
 			FromCurrency: req.Currency,
@@ -113,15 +113,13 @@ func TopUpWallet(userID uint, req types.TopUpRequest) (*types.TopUpResponse, err
 	if err := database.DB.Create(&transaction).Error; err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
-	notificationClient := jobs.NewNotificationJobClient()
-	title := "Wallet Top Up"
-	message := fmt.Sprintf("Your wallet top-up of %s %s is being processed", utils.FormatCurrency(req.Amount, req.Currency), req.Currency)
-	notificationClient.EnqueueCreateNotification(
-		userID,
-		models.NotificationType("topup"),
-		title,
-		message,
-	)
+	notifyAndLog(Notice{
+		UserID:   userID,
+		Category: models.CategoryTransactions,
+		Type:     models.TopUpType,
+		Title:    i18n.Msg("notification.wallet_top_up.title", nil),
+		Message:  i18n.Msg("notification.wallet_top_up.message", i18n.Args{"amount": i18n.Amount(req.Amount, req.Currency)}),
+	})
 
 	return &types.TopUpResponse{
 		TransactionID:    transactionID,
@@ -137,7 +135,7 @@ func TopUpWallet(userID uint, req types.TopUpRequest) (*types.TopUpResponse, err
 // WithdrawFromWallet initiates a wallet withdrawal
 func WithdrawFromWallet(userID uint, req types.WithdrawRequest) (*types.WithdrawResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	// Validate request
@@ -145,6 +143,16 @@ func WithdrawFromWallet(userID uint, req types.WithdrawRequest) (*types.Withdraw
 		return nil, err
 	}
 
+	// Withdrawals need the transaction PIN or a fresh verification code
+	if err := VerifyStepUp(userID, req.StepUpCredentials, req.Amount, req.Currency); err != nil {
+		return nil, err
+	}
+
+	method, err := resolveWithdrawMethod(userID, req.WithdrawMethodID, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get wallets and check balance
 	wallets, err := findOrCreateWallet(userID)
 	if err != nil {
@@ -165,7 +173,7 @@ func WithdrawFromWallet(userID uint, req types.WithdrawRequest) (*types.Withdraw
 	}
 
 	if targetWallet.Balance < req.Amount {
-		return nil, errors.New("insufficient balance")
+		return nil, ErrInsufficientBalance
 	}
 
 	// Start transaction
@@ -194,8 +202,25 @@ func WithdrawFromWallet(userID uint, req types.WithdrawRequest) (*types.Withdraw
 		Status:          "pending",
 		TransactionType: "withdrawal",
 		Reference:       reference,
-		Direction:       getWithdrawalDirection(req.Currency),
-		Description:     fmt.Sprintf("Withdraw %s %.2f via %s", req.Currency, req.Amount, req.WithdrawalMethod),
+		PaymentType:     models.PaymentType(method.Method),
+		Direction:       models.WithdrawalDirection(req.Currency),
+		Description:     fmt.Sprintf("Withdraw %s %.2f via %s", req.Currency, req.Amount, method.Method),
+		TransactionDetails: models.TransactionDetails{
+			RecipientName:   method.AccountName,
+			AccountNumber:   method.AccountNumber,
+			BankName:        method.BankName,
+			BankCode:        method.BankCode,
+			FromCurrency:    req.Currency,
+			ToCurrency:      req.Currency,
+			FromAmount:      req.Amount,
+			ToAmount:        req.Amount,
+			MethodOfPayment: method.Method,
+		},
+	}
+
+	if method.Method == "momo" {
+		transaction.TransactionDetails.PhoneNumber = method.AccountNumber
+		transaction.TransactionDetails.Network = method.BankCode
 	}
 
 	if err := tx.Create(&transaction).Error; err != nil {
@@ -212,24 +237,30 @@ func WithdrawFromWallet(userID uint, req types.WithdrawRequest) (*types.Withdraw
 		TransactionID:    transactionID,
 		Amount:           utils.RoundCurrency(req.Amount),
 		Currency:         req.Currency,
-		WithdrawalMethod: req.WithdrawalMethod,
+		WithdrawalMethod: method.Method,
 		Status:           "pending",
-		AccountDetails:   req.AccountDetails,
-		CreatedAt:        now,
+		AccountDetails: map[string]interface{}{
+			"withdrawMethodId": method.ID,
+			"accountNumber":    method.AccountNumber,
+			"accountName":      method.AccountName,
+			"bankName":         method.BankName,
+			"bankCode":         method.BankCode,
+		},
+		CreatedAt: now,
 	}, nil
 }
 
 // GetWalletHistory retrieves wallet transaction history
 func GetWalletHistory(userID uint32, pagination types.PaginationRequest, txType, status string) ([]types.WalletHistoryItem, *types.PaginationResponse, error) {
 	if userID == 0 {
-		return nil, nil, errors.New("user ID is required")
+		return nil, nil, ErrUserIDRequired
 	}
 
 	// Build query
 	query := database.DB.Model(&models.Transaction{}).Where("user_id = ?", userID)
 
 	if txType != "" && isValidTransactionType(txType) {
-		if txType == "deposit" || txType == "withdrawal" {
+		if txType == "deposit" || txType == "withdrawal" || txType == "p2p" {
 			query = query.Where("transaction_type = ?", txType)
 		}
 	}
@@ -289,7 +320,7 @@ func GetWalletHistory(userID uint32, pagination types.PaginationRequest, txType,
 // UpdateWalletAfterPayment updates wallet balance after successful payment
 func UpdateWalletAfterPayment(userID uint, currency string, amount float64) error {
 	if userID == 0 {
-		return errors.New("user ID is required")
+		return ErrUserIDRequired
 	}
 
 	return updateWalletBalance(database.DB, userID, currency, amount, "deposit")
@@ -297,7 +328,7 @@ func UpdateWalletAfterPayment(userID uint, currency string, amount float64) erro
 
 // Helper functions
 
-// findOrCreateWallet finds existing wallets or creates new ones (NGN and GHS)
+// findOrCreateWallet finds the user's wallets, creating one for every enabled currency they lack
 func findOrCreateWallet(userID uint) ([]models.Wallet, error) {
 	var wallets []models.Wallet
 
@@ -306,56 +337,37 @@ func findOrCreateWallet(userID uint) ([]models.Wallet, error) {
 		return nil, fmt.Errorf("failed to find wallets: %w", err)
 	}
 
-	// Check if we have both NGN and GHS wallets
-	hasNGN := false
-	hasGHS := false
+	held := make(map[string]bool, len(wallets))
 	for _, wallet := range wallets {
-		if wallet.Currency == "NGN" {
-			hasNGN = true
-		}
-		if wallet.Currency == "GHS" {
-			hasGHS = true
-		}
+		held[wallet.Currency] = true
 	}
 
 	// Create missing wallets
-	if !hasNGN {
-		ngnWallet := models.Wallet{
-			UserID:           userID,
-			Currency:         "NGN",
-			Balance:          0.0,
-			WalletID:         libs.GenerateRandomLengthNumbers(12), // NGN wallet ID
-			TotalDeposits:    0.0,
-			TotalWithdrawals: 0.0,
-			TotalConversions: 0.0,
-			IsActive:         true,
+	for _, currency := range enabledCurrencies() {
+		if held[currency] {
+			continue
 		}
-		if err := database.DB.Create(&ngnWallet).Error; err != nil {
-			return nil, fmt.Errorf("failed to create NGN wallet: %w", err)
+		wallet := newWallet(userID, currency)
+		if err := database.DB.Create(&wallet).Error; err != nil {
+			return nil, fmt.Errorf("failed to create %s wallet: %w", currency, err)
 		}
-		wallets = append(wallets, ngnWallet)
-	}
-
-	if !hasGHS {
-		ghsWallet := models.Wallet{
-			UserID:           userID,
-			Currency:         "GHS",
-			Balance:          0.0,
-			WalletID:         libs.GenerateRandomLengthNumbers(10), // GHS wallet ID
-			TotalDeposits:    0.0,
-			TotalWithdrawals: 0.0,
-			TotalConversions: 0.0,
-			IsActive:         true,
-		}
-		if err := database.DB.Create(&ghsWallet).Error; err != nil {
-			return nil, fmt.Errorf("failed to create GHS wallet: %w", err)
-		}
-		wallets = append(wallets, ghsWallet)
+		wallets = append(wallets, wallet)
 	}
 
 	return wallets, nil
 }
 
+// newWallet builds an empty wallet for a currency
+func newWallet(userID uint, currency string) models.Wallet {
+	return models.Wallet{
+		UserID:   userID,
+		Currency: currency,
+		Balance:  0.0,
+		WalletID: libs.GenerateRandomLengthNumbers(12),
+		IsActive: true,
+	}
+}
+
 // updateWalletBalance updates wallet balance atomically for specific currency
 func updateWalletBalance(tx *gorm.DB, userID uint, currency string, amount float64, txType string) error {
 	var wallet models.Wallet
@@ -403,30 +415,6 @@ func getBalanceByCurrency(wallets []models.Wallet, currency string) float64 {
 	return 0
 }
 
-// getDepositDirection gets transaction direction for deposit
-func getDepositDirection(currency string) models.TransactionDirection {
-	switch currency {
-	case "NGN":
-		return "DEPOSIT-NGN"
-	case "GHS":
-		return "DEPOSIT-GHS"
-	default:
-		return ""
-	}
-}
-
-// getWithdrawalDirection gets transaction direction for withdrawal
-func getWithdrawalDirection(currency string) models.TransactionDirection {
-	switch currency {
-	case "NGN":
-		return "WITHDRAWAL-NGN"
-	case "GHS":
-		return "WITHDRAWAL-GHS"
-	default:
-		return ""
-	}
-}
-
 // generateTransactionReference generates a unique transaction reference
 func generateTransactionReference(prefix string) string {
 	return fmt.Sprintf("%s_%d_%s", prefix, time.Now().Unix(), uuid.New().String()[:8])
@@ -435,11 +423,11 @@ func generateTransactionReference(prefix string) string {
 // validateTopUpRequest validates top-up request
 func validateTopUpRequest(req types.TopUpRequest) error {
 	if req.Amount <= 0 {
-		return errors.New("amount must be greater than zero")
+		return ErrAmountNotPositive
 	}
 
-	if req.Currency != "NGN" && req.Currency != "GHS" {
-		return errors.New("invalid currency. Must be NGN or GHS")
+	if !isValidCurrency(req.Currency) {
+		return invalidCurrencyError("error.invalid_currency")
 	}
 
 	if req.PaymentMethod != "bank" && req.PaymentMethod != "momo" {
@@ -452,19 +440,11 @@ func validateTopUpRequest(req types.TopUpRequest) error {
 // validateWithdrawRequest validates withdrawal request
 func validateWithdrawRequest(req types.WithdrawRequest) error {
 	if req.Amount <= 0 {
-		return errors.New("amount must be greater than zero")
-	}
-
-	if req.Currency != "NGN" && req.Currency != "GHS" {
-		return errors.New("invalid currency. Must be NGN or GHS")
-	}
-
-	if req.WithdrawalMethod != "bank" && req.WithdrawalMethod != "momo" {
-		return errors.New("invalid withdrawal method. Must be bank or momo")
+		return ErrAmountNotPositive
 	}
 
-	if len(req.AccountDetails) == 0 {
-		return errors.New("account details are required")
+	if !isValidCurrency(req.Currency) {
+		return invalidCurrencyError("error.invalid_currency")
 	}
 
 	return nil
@@ -472,7 +452,7 @@ func validateWithdrawRequest(req types.WithdrawRequest) error {
 
 // isValidTransactionType checks if transaction type is valid
 func isValidTransactionType(txType string) bool {
-	validTypes := []string{"deposit", "withdrawal", "conversion", "transfer"}
+	validTypes := []string{"deposit", "withdrawal", "conversion", "transfer", "p2p"}
 	for _, t := range validTypes {
 		if t == txType {
 			return true
@@ -495,7 +475,7 @@ func isValidTransactionStatus(status string) bool {
 // GetTopUpDetails retrieves topup transaction details
 func GetTopUpDetails(userID uint, transactionID string) (*types.TopUpResponse, error) {
 	if userID == 0 {
-		return nil, errors.New("user ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	if transactionID == "" {
@@ -1,9 +1,6 @@
 package services
 
 import (
-	"crypto/hmac"
-	"crypto/sha512"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,8 +9,10 @@ import (
 
 	"github.com/Veedsify/JeanPayGoBackend/database"
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
 	"github.com/Veedsify/JeanPayGoBackend/libs"
 	"github.com/Veedsify/JeanPayGoBackend/types"
+	"github.com/Veedsify/JeanPayGoBackend/utils"
 	"gorm.io/gorm"
 )
 
@@ -184,6 +183,14 @@ func handlePaystackChargeSuccess(webhookData *CombinedWebhookData, eventLog *Web
 	reference := webhookData.PaystackWebhookData.Data.Reference
 	amount := float64(webhookData.PaystackWebhookData.Data.Amount) / 100 // Convert kobo to naira
 
+	// Payment request checkouts credit the requester and settle the request
+	if handled, err := completePaymentRequestCheckout(reference); handled || err != nil {
+		if err != nil {
+			return err
+		}
+		return logWebhookEvent(eventLog, "processed_successfully")
+	}
+
 	// Find transaction by reference
 	var transaction models.Transaction
 	err := database.DB.Where("reference = ?", reference).First(&transaction).Error
@@ -226,17 +233,14 @@ func handlePaystackChargeSuccess(webhookData *CombinedWebhookData, eventLog *Web
 		}
 	}
 
-	// Create notification
-	if err := createTransactionNotification(tx, transaction.UserID, "deposit", amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID); err != nil {
-		// Log error but don't fail the transaction
-		fmt.Printf("Failed to create notification: %v\n", err)
-	}
-
 	// Commit transaction
 	if err := tx.Commit().Error; err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	publishTransactionStatus(transaction, models.TransactionCompleted)
+	notifyTransactionProcessed(transaction.UserID, "deposit", amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID)
+
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
 
@@ -263,6 +267,8 @@ func handlePaystackChargeFailed(webhookData *CombinedWebhookData, eventLog *Webh
 		return fmt.Errorf("failed to update transaction: %w", err)
 	}
 
+	publishTransactionStatus(transaction, models.TransactionFailed)
+
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
 
@@ -290,7 +296,9 @@ func handlePaystackTransferSuccess(webhookData *CombinedWebhookData, eventLog *W
 
 	// Create notification
 	amount := float64(webhookData.PaystackWebhookData.Data.Amount) / 100
-	createTransactionNotificationDirect(transaction.UserID, "withdrawal", amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID)
+	notifyTransactionProcessed(transaction.UserID, "withdrawal", amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID)
+
+	publishTransactionStatus(transaction, models.TransactionCompleted)
 
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
@@ -342,6 +350,8 @@ func handlePaystackTransferFailed(webhookData *CombinedWebhookData, eventLog *We
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	publishTransactionStatus(transaction, models.TransactionFailed)
+
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
 
@@ -391,16 +401,14 @@ func handleMomoPaymentSuccess(webhookData *CombinedWebhookData, eventLog *Webhoo
 		}
 	}
 
-	// Create notification
-	if err := createTransactionNotification(tx, transaction.UserID, "deposit", amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID); err != nil {
-		fmt.Printf("Failed to create notification: %v\n", err)
-	}
-
 	// Commit transaction
 	if err := tx.Commit().Error; err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	publishTransactionStatus(transaction, models.TransactionCompleted)
+	notifyTransactionProcessed(transaction.UserID, "deposit", amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID)
+
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
 
@@ -427,6 +435,8 @@ func handleMomoPaymentFailed(webhookData *CombinedWebhookData, eventLog *Webhook
 		return fmt.Errorf("failed to update transaction: %w", err)
 	}
 
+	publishTransactionStatus(transaction, models.TransactionFailed)
+
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
 
@@ -453,7 +463,9 @@ func handleMomoTransferSuccess(webhookData *CombinedWebhookData, eventLog *Webho
 	}
 
 	// Create notification
-	createTransactionNotificationDirect(transaction.UserID, "withdrawal", webhookData.MomoWebhookData.Data.Amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID)
+	notifyTransactionProcessed(transaction.UserID, "withdrawal", webhookData.MomoWebhookData.Data.Amount, transaction.TransactionDetails.FromCurrency, transaction.TransactionID)
+
+	publishTransactionStatus(transaction, models.TransactionCompleted)
 
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
@@ -505,6 +517,8 @@ func handleMomoTransferFailed(webhookData *CombinedWebhookData, eventLog *Webhoo
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	publishTransactionStatus(transaction, models.TransactionFailed)
+
 	return logWebhookEvent(eventLog, "processed_successfully")
 }
 
@@ -512,31 +526,13 @@ func handleMomoTransferFailed(webhookData *CombinedWebhookData, eventLog *Webhoo
 
 // verifyPaystackSignature verifies Paystack webhook signature
 func verifyPaystackSignature(payload []byte, signature string) bool {
-	secret := []byte(libs.GetEnvOrDefault("PAYSTACK_SECRET_KEY", ""))
-	if len(secret) == 0 {
-		return false
-	}
-
-	h := hmac.New(sha512.New, secret)
-	h.Write(payload)
-	expectedSignature := hex.EncodeToString(h.Sum(nil))
-
-	return hmac.Equal([]byte(signature), []byte(expectedSignature))
+	return libs.VerifyHMACSHA512(payload, signature, libs.GetEnvOrDefault("PAYSTACK_SECRET_KEY", ""))
 }
 
 // verifyMomoSignature verifies Mobile Money webhook signature
 func verifyMomoSignature(payload []byte, signature string) bool {
 	// Implement based on your MoMo provider's signature verification
-	secret := []byte(libs.GetEnvOrDefault("MOMO_SECRET_KEY", ""))
-	if len(secret) == 0 {
-		return false
-	}
-
-	h := hmac.New(sha512.New, secret)
-	h.Write(payload)
-	expectedSignature := hex.EncodeToString(h.Sum(nil))
-
-	return hmac.Equal([]byte(signature), []byte(expectedSignature))
+	return libs.VerifyHMACSHA512(payload, signature, libs.GetEnvOrDefault("MOMO_SECRET_KEY", ""))
 }
 
 // logWebhookEvent logs webhook processing events
@@ -556,34 +552,23 @@ func logWebhookEvent(eventLog *WebhookEventLog, result string) error {
 	return nil
 }
 
-// createTransactionNotification creates notification within a transaction
-func createTransactionNotification(tx *gorm.DB, userID uint, txType string, amount float64, currency, transactionID string) error {
-	message := fmt.Sprintf("Your %s of %s %.2f has been processed successfully. Transaction ID: %s",
-		txType, currency, amount, transactionID)
-
-	notification := models.Notification{
-		UserID:  userID,
-		Type:    "transfer",
-		Message: message,
-		Read:    false,
-	}
-
-	return tx.Create(&notification).Error
-}
-
-// createTransactionNotificationDirect creates notification directly
-func createTransactionNotificationDirect(userID uint, txType string, amount float64, currency, transactionID string) error {
-	message := fmt.Sprintf("Your %s of %s %.2f has been processed successfully. Transaction ID: %s",
-		txType, currency, amount, transactionID)
-
-	notification := models.Notification{
-		UserID:  userID,
-		Type:    "transfer",
-		Message: message,
-		Read:    false,
-	}
-
-	return database.DB.Create(&notification).Error
+// notifyTransactionProcessed tells the user a deposit or withdrawal has gone through
+func notifyTransactionProcessed(userID uint, txType string, amount float64, currency, transactionID string) {
+	notificationType, key := models.TopUpType, "notification.deposit_successful"
+	if txType == "withdrawal" {
+		notificationType, key = models.WithdrawType, "notification.withdrawal_successful"
+	}
+
+	notifyAndLog(Notice{
+		UserID:   userID,
+		Category: models.CategoryTransactions,
+		Type:     notificationType,
+		Title:    i18n.Msg(key+".title", nil),
+		Message: i18n.Msg(key+".message", i18n.Args{
+			"amount":        i18n.Amount(amount, currency),
+			"transactionId": transactionID,
+		}),
+	})
 }
 
 // GetWebhookEventLogs retrieves webhook event logs for admin
@@ -641,50 +626,20 @@ func GetUserTotalBalance(userID uint) (float64, error) {
 		return 0, fmt.Errorf("failed to find settings: %w", err)
 	}
 
-	// Use DefaultCurrency from settings if present
-	if setting.DefaultCurrency != "" {
-		switch setting.DefaultCurrency {
-		case "NGN":
-			for _, wallet := range user.Wallet {
-				if wallet.Currency == "NGN" {
-					totalBalance = wallet.Balance
-					break
-				}
-			}
-		case "GHS":
-			for _, wallet := range user.Wallet {
-				if wallet.Currency == "GHS" {
-					totalBalance = wallet.Balance
-					break
-				}
-			}
-		default:
-			// If DefaultCurrency is set but not recognized, return 0
-			return 0, fmt.Errorf("unsupported default currency: %s", setting.DefaultCurrency)
-		}
-		return totalBalance, nil
-	}
-
-	// Fallback to user's country if DefaultCurrency not set
-	userCountry := user.Country
-	switch userCountry {
-	case "NG":
-		for _, wallet := range user.Wallet {
-			if wallet.Currency == "NGN" {
-				totalBalance = wallet.Balance
-				break
-			}
-		}
-	case "GH":
-		for _, wallet := range user.Wallet {
-			if wallet.Currency == "GHS" {
-				totalBalance = wallet.Balance
-				break
-			}
+	// Use DefaultCurrency from settings if present, otherwise the currency of the user's country
+	currency := string(setting.DefaultCurrency)
+	if currency == "" {
+		currency = defaultCurrencyForCountry(string(user.Country))
+	}
+	if _, ok := utils.LookupCurrency(currency); !ok {
+		return 0, fmt.Errorf("unsupported default currency: %s", currency)
+	}
+
+	for _, wallet := range user.Wallet {
+		if wallet.Currency == currency {
+			totalBalance = wallet.Balance
+			break
 		}
-	default:
-		// If country not recognized, return 0
-		return 0, fmt.Errorf("unsupported user country: %s", userCountry)
 	}
 
 	return totalBalance, nil
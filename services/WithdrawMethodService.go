@@ -0,0 +1,295 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Veedsify/JeanPayGoBackend/database"
+	"github.com/Veedsify/JeanPayGoBackend/database/models"
+	"github.com/Veedsify/JeanPayGoBackend/i18n"
+	"github.com/Veedsify/JeanPayGoBackend/types"
+	"gorm.io/gorm"
+)
+
+var ErrWithdrawMethodExists = i18n.NewError("error.withdraw_method_exists", nil)
+
+// GetWithdrawMethods returns the user's withdrawal methods, optionally for one currency
+func GetWithdrawMethods(userID uint, currency string) ([]types.WithdrawMethodResponse, error) {
+	query := database.DB.Where("user_id = ?", userID)
+	if currency != "" {
+		query = query.Where("currency = ?", strings.ToUpper(currency))
+	}
+
+	var methods []models.WithdrawMethod
+	if err := query.Order("is_default DESC, created_at DESC").Find(&methods).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch withdrawal methods: %w", err)
+	}
+
+	response := make([]types.WithdrawMethodResponse, 0, len(methods))
+	for _, method := range methods {
+		response = append(response, toWithdrawMethodResponse(method))
+	}
+	return response, nil
+}
+
+// CreateWithdrawMethod verifies the account holder's name and saves a withdrawal method
+func CreateWithdrawMethod(userID uint, req types.CreateWithdrawMethodRequest) (types.WithdrawMethodResponse, error) {
+	if err := VerifyStepUp(userID, req.StepUpCredentials, 0, ""); err != nil {
+		return types.WithdrawMethodResponse{}, err
+	}
+
+	method := models.WithdrawMethod{
+		UserID:        userID,
+		Currency:      req.Currency,
+		Method:        req.Method,
+		AccountNumber: strings.TrimSpace(req.AccountNumber),
+		BankName:      strings.TrimSpace(req.BankName),
+		BankCode:      strings.TrimSpace(req.BankCode),
+	}
+	if err := verifyWithdrawMethod(&method); err != nil {
+		return types.WithdrawMethodResponse{}, err
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existing int64
+	if err := tx.Model(&models.WithdrawMethod{}).
+		Where("user_id = ? AND currency = ?", userID, method.Currency).
+		Count(&existing).Error; err != nil {
+		tx.Rollback()
+		return types.WithdrawMethodResponse{}, fmt.Errorf("failed to check withdrawal methods: %w", err)
+	}
+
+	// The first method saved for a currency becomes its default
+	method.IsDefault = req.IsDefault || existing == 0
+	if method.IsDefault {
+		if err := clearDefaultWithdrawMethod(tx, userID, method.Currency); err != nil {
+			tx.Rollback()
+			return types.WithdrawMethodResponse{}, err
+		}
+	}
+
+	if err := tx.Create(&method).Error; err != nil {
+		tx.Rollback()
+		return types.WithdrawMethodResponse{}, fmt.Errorf("failed to save withdrawal method: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return types.WithdrawMethodResponse{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if err := CreateSecurityNotification(userID, fmt.Sprintf("New %s withdrawal account added", method.Currency)); err != nil {
+		fmt.Printf("Failed to create security notification: %v\n", err)
+	}
+
+	return toWithdrawMethodResponse(method), nil
+}
+
+// UpdateWithdrawMethod changes a method's account details, verifying the name again
+func UpdateWithdrawMethod(userID, methodID uint, req types.UpdateWithdrawMethodRequest) (types.WithdrawMethodResponse, error) {
+	if err := VerifyStepUp(userID, req.StepUpCredentials, 0, ""); err != nil {
+		return types.WithdrawMethodResponse{}, err
+	}
+
+	method, err := findWithdrawMethod(userID, methodID)
+	if err != nil {
+		return types.WithdrawMethodResponse{}, err
+	}
+
+	if req.AccountNumber != "" {
+		method.AccountNumber = strings.TrimSpace(req.AccountNumber)
+	}
+	if req.BankName != "" {
+		method.BankName = strings.TrimSpace(req.BankName)
+	}
+	if req.BankCode != "" {
+		method.BankCode = strings.TrimSpace(req.BankCode)
+	}
+	if err := verifyWithdrawMethod(&method); err != nil {
+		return types.WithdrawMethodResponse{}, err
+	}
+
+	if err := database.DB.Save(&method).Error; err != nil {
+		return types.WithdrawMethodResponse{}, fmt.Errorf("failed to update withdrawal method: %w", err)
+	}
+
+	if err := CreateSecurityNotification(userID, fmt.Sprintf("%s withdrawal account changed", method.Currency)); err != nil {
+		fmt.Printf("Failed to create security notification: %v\n", err)
+	}
+
+	return toWithdrawMethodResponse(method), nil
+}
+
+// SetDefaultWithdrawMethod makes a method the default for its currency
+func SetDefaultWithdrawMethod(userID, methodID uint) (types.WithdrawMethodResponse, error) {
+	method, err := findWithdrawMethod(userID, methodID)
+	if err != nil {
+		return types.WithdrawMethodResponse{}, err
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := clearDefaultWithdrawMethod(tx, userID, method.Currency); err != nil {
+		tx.Rollback()
+		return types.WithdrawMethodResponse{}, err
+	}
+	if err := tx.Model(&method).Update("is_default", true).Error; err != nil {
+		tx.Rollback()
+		return types.WithdrawMethodResponse{}, fmt.Errorf("failed to set default withdrawal method: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return types.WithdrawMethodResponse{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	method.IsDefault = true
+	return toWithdrawMethodResponse(method), nil
+}
+
+// DeleteWithdrawMethod removes a method, promoting the newest remaining one if it was the default
+func DeleteWithdrawMethod(userID, methodID uint) error {
+	method, err := findWithdrawMethod(userID, methodID)
+	if err != nil {
+		return err
+	}
+
+	tx := database.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Delete(&method).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete withdrawal method: %w", err)
+	}
+
+	if method.IsDefault {
+		var next models.WithdrawMethod
+		err := tx.Where("user_id = ? AND currency = ?", userID, method.Currency).Order("created_at DESC").First(&next).Error
+		if err == nil {
+			if err := tx.Model(&next).Update("is_default", true).Error; err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to set default withdrawal method: %w", err)
+			}
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			tx.Rollback()
+			return fmt.Errorf("failed to fetch withdrawal methods: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Helper functions
+
+func findWithdrawMethod(userID, methodID uint) (models.WithdrawMethod, error) {
+	var method models.WithdrawMethod
+	if err := database.DB.Where("id = ? AND user_id = ?", methodID, userID).First(&method).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return method, i18n.NewError("error.withdraw_method_not_found", nil)
+		}
+		return method, fmt.Errorf("failed to fetch withdrawal method: %w", err)
+	}
+	return method, nil
+}
+
+// resolveWithdrawMethod returns the method a withdrawal pays out to, falling back to the currency's default
+func resolveWithdrawMethod(userID, methodID uint, currency string) (models.WithdrawMethod, error) {
+	if methodID != 0 {
+		method, err := findWithdrawMethod(userID, methodID)
+		if err != nil {
+			return method, err
+		}
+		if method.Currency != currency {
+			return method, i18n.NewError("error.withdraw_method_currency", i18n.Args{"currency": method.Currency, "requested": currency})
+		}
+		return method, nil
+	}
+
+	var method models.WithdrawMethod
+	if err := database.DB.Where("user_id = ? AND currency = ? AND is_default = ?", userID, currency, true).First(&method).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return method, i18n.NewError("error.withdraw_method_missing", i18n.Args{"currency": currency})
+		}
+		return method, fmt.Errorf("failed to fetch withdrawal method: %w", err)
+	}
+	return method, nil
+}
+
+// verifyWithdrawMethod checks for duplicates and stores the name returned by name enquiry
+func verifyWithdrawMethod(method *models.WithdrawMethod) error {
+	if method.AccountNumber == "" || method.BankCode == "" {
+		return i18n.NewError("error.account_details_required", nil)
+	}
+	if method.Method == "bank" && method.BankName == "" {
+		return i18n.NewError("error.bank_name_required", nil)
+	}
+	if method.Method == "momo" && method.BankName == "" {
+		method.BankName = method.BankCode
+	}
+
+	query := database.DB.Model(&models.WithdrawMethod{}).
+		Where("user_id = ? AND currency = ? AND method = ? AND account_number = ? AND bank_code = ?",
+			method.UserID, method.Currency, method.Method, method.AccountNumber, method.BankCode)
+	if method.ID != 0 {
+		query = query.Where("id <> ?", method.ID)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check withdrawal methods: %w", err)
+	}
+	if count > 0 {
+		return ErrWithdrawMethodExists
+	}
+
+	accountName, err := ResolveAccountName(method.Method, method.AccountNumber, method.BankCode)
+	if err != nil {
+		return fmt.Errorf("failed to verify account name: %w", err)
+	}
+
+	now := time.Now()
+	method.AccountName = accountName
+	method.VerifiedAt = &now
+	return nil
+}
+
+func clearDefaultWithdrawMethod(tx *gorm.DB, userID uint, currency string) error {
+	if err := tx.Model(&models.WithdrawMethod{}).
+		Where("user_id = ? AND currency = ? AND is_default = ?", userID, currency, true).
+		Update("is_default", false).Error; err != nil {
+		return fmt.Errorf("failed to clear default withdrawal method: %w", err)
+	}
+	return nil
+}
+
+func toWithdrawMethodResponse(method models.WithdrawMethod) types.WithdrawMethodResponse {
+	return types.WithdrawMethodResponse{
+		ID:            method.ID,
+		Currency:      method.Currency,
+		Method:        method.Method,
+		AccountNumber: method.AccountNumber,
+		AccountName:   method.AccountName,
+		BankName:      method.BankName,
+		BankCode:      method.BankCode,
+		IsDefault:     method.IsDefault,
+		VerifiedAt:    method.VerifiedAt,
+		CreatedAt:     method.CreatedAt,
+	}
+}
@@ -0,0 +1,15 @@
+package services
+
+import "github.com/Veedsify/JeanPayGoBackend/i18n"
+
+// Errors shared by several services. Handlers render them in the reader's language with
+// i18n.ErrorMessage.
+var (
+	ErrUserIDRequired      = i18n.NewError("error.user_id_required", nil)
+	ErrUserNotFound        = i18n.NewError("error.user_not_found", nil)
+	ErrAmountNotPositive   = i18n.NewError("error.amount_not_positive", nil)
+	ErrInsufficientBalance = i18n.NewError("error.insufficient_balance", nil)
+	ErrInvalidCode         = i18n.NewError("error.invalid_code", nil)
+	ErrSameCurrency        = i18n.NewError("error.same_currency", nil)
+	ErrRateNotFound        = i18n.NewError("error.rate_not_found", nil)
+)
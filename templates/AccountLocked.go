@@ -0,0 +1,76 @@
+package templates
+
+import "fmt"
+
+func AccountLockedTemplate() string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Account Temporarily Locked</title>
+    <style>%s</style>
+</head>
+<body>
+    <div class="email-wrapper">
+        <div class="header">
+            <div class="logo">
+                <img src="https://res.cloudinary.com/ds2hdlfvc/image/upload/v1755948663/logo_nf44qm.png" alt="JeanPay Logo" />
+            </div>
+            <h1>Account Temporarily Locked</h1>
+            <p>Your account security is our priority</p>
+        </div>
+        <div class="content">
+            <div class="greeting">Hello {{.UserName}},</div>
+            <div class="message">
+                We noticed several unsuccessful attempts to sign in to your JeanPay account. To keep your money safe, sign-in has been temporarily locked.
+            </div>
+            <div class="code-section">
+                <div class="code-label">Sign-in available again in</div>
+                <div class="verification-code">{{.LockDuration}}</div>
+                <div class="message">Request IP address: {{.IPAddress}}</div>
+            </div>
+            <div class="highlight">
+                <p><strong>Security Notice:</strong> If this wasn't you, we recommend resetting your password as soon as the lock expires and enabling two-factor authentication.</p>
+            </div>
+            <div class="divider"></div>
+            <div class="message">
+                <strong>Need Help?</strong> If you believe someone is trying to access your account, please contact our security team immediately.
+            </div>
+        </div>
+        <div class="footer">
+            <div class="footer-logo">JeanPay</div>
+            <div class="footer-text">Protecting your financial security</div>
+            <div class="footer-text">This email was sent to {{.Email}}</div>
+            <div class="footer-links">
+                <a href="{{.ServerURL}}/security" class="footer-link">Security Center</a>
+                <a href="{{.ServerURL}}/support" class="footer-link">Contact Support</a>
+                <a href="{{.ServerURL}}/help" class="footer-link">Help Center</a>
+            </div>
+        </div>
+    </div>
+</body>
+</html>`, BaseCss)
+}
+
+func AccountLockedPlainTextTemplate() string {
+	return `🔒 Your JeanPay Account Has Been Temporarily Locked
+Hello {{.UserName}},
+
+We noticed several unsuccessful attempts to sign in to your JeanPay account. To keep your money safe, sign-in has been temporarily locked.
+
+Sign-in available again in: {{.LockDuration}}
+Request IP address: {{.IPAddress}}
+
+🛡️ Security Notice: If this wasn't you, we recommend resetting your password as soon as the lock expires and enabling two-factor authentication.
+
+Need Help? If you believe someone is trying to access your account, please contact our security team immediately.
+
+Best regards,
+The JeanPay Security Team
+
+---
+This email was sent to {{.Email}}
+Protecting your financial security.`
+}
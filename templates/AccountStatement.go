@@ -0,0 +1,78 @@
+package templates
+
+import "fmt"
+
+func AccountStatementTemplate() string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Account Statement</title>
+    <style>%s</style>
+</head>
+<body>
+    <div class="email-wrapper">
+        <div class="header">
+            <div class="logo">
+                <img src="https://res.cloudinary.com/ds2hdlfvc/image/upload/v1755948663/logo_nf44qm.png" alt="JeanPay Logo" />
+            </div>
+            <h1>Your Statement Is Ready</h1>
+            <p>{{.Currency}} wallet, {{.Period}}</p>
+        </div>
+        <div class="content">
+            <div class="greeting">Hello {{.UserName}},</div>
+            <div class="message">
+                Your {{.Currency}} account statement is attached to this email as a {{.Format}} file.
+            </div>
+            <div class="code-section">
+                <div class="code-label">Closing balance</div>
+                <div class="verification-code">{{.ClosingBalance}}</div>
+                <div class="message">Opening balance {{.OpeningBalance}} &middot; {{.EntryCount}} transactions</div>
+            </div>
+            <div class="highlight">
+                <p>You can also download it until {{.ExpiresAt}} from <a href="{{.DownloadURL}}">this link</a>.</p>
+            </div>
+            <div class="divider"></div>
+            <div class="message">
+                If you did not request this statement, please contact support right away.
+            </div>
+        </div>
+        <div class="footer">
+            <div class="footer-logo">JeanPay</div>
+            <div class="footer-text">Fast, secure cross-border payments</div>
+            <div class="footer-text">This email was sent to {{.Email}}</div>
+            <div class="footer-links">
+                <a href="{{.ServerURL}}/dashboard" class="footer-link">Dashboard</a>
+                <a href="{{.ServerURL}}/support" class="footer-link">Contact Support</a>
+                <a href="{{.ServerURL}}/help" class="footer-link">Help Center</a>
+            </div>
+        </div>
+    </div>
+</body>
+</html>`, BaseCss)
+}
+
+func AccountStatementPlainTextTemplate() string {
+	return `📄 JeanPay Account Statement
+Hello {{.UserName}},
+
+Your {{.Currency}} account statement for {{.Period}} is attached to this email as a {{.Format}} file.
+
+Opening balance: {{.OpeningBalance}}
+Closing balance: {{.ClosingBalance}}
+Transactions: {{.EntryCount}}
+
+You can also download it until {{.ExpiresAt}}:
+{{.DownloadURL}}
+
+If you did not request this statement, please contact support right away.
+
+Best regards,
+The JeanPay Team
+
+---
+This email was sent to {{.Email}}
+Fast, secure cross-border payments.`
+}
@@ -0,0 +1,62 @@
+package templates
+
+import "fmt"
+
+func CampaignTemplate() string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.Title}}</title>
+    <style>%s</style>
+</head>
+<body>
+    <div class="email-wrapper">
+        <div class="header">
+            <div class="logo">
+                <img src="https://res.cloudinary.com/ds2hdlfvc/image/upload/v1755948663/logo_nf44qm.png" alt="JeanPay Logo" />
+            </div>
+            <h1>{{.Title}}</h1>
+        </div>
+        <div class="content">
+            <div class="greeting">Hello {{.UserName}},</div>
+            <div class="message">
+                {{.Message}}
+            </div>
+            <div class="divider"></div>
+            <div class="message">
+                You are receiving this because promotional messages are switched on in your JeanPay notification settings. You can turn them off at any time.
+            </div>
+        </div>
+        <div class="footer">
+            <div class="footer-logo">JeanPay</div>
+            <div class="footer-text">Fast, secure cross-border payments</div>
+            <div class="footer-text">This email was sent to {{.Email}}</div>
+            <div class="footer-links">
+                <a href="{{.ServerURL}}/settings" class="footer-link">Notification Settings</a>
+                <a href="{{.ServerURL}}/support" class="footer-link">Contact Support</a>
+                <a href="{{.ServerURL}}/help" class="footer-link">Help Center</a>
+            </div>
+        </div>
+    </div>
+</body>
+</html>`, BaseCss)
+}
+
+func CampaignPlainTextTemplate() string {
+	return `{{.Title}}
+Hello {{.UserName}},
+
+{{.Message}}
+
+You are receiving this because promotional messages are switched on in your JeanPay notification settings. You can turn them off at any time.
+
+Best regards,
+The JeanPay Team
+
+---
+This email was sent to {{.Email}}
+Fast, secure cross-border payments.`
+}
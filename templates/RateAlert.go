@@ -0,0 +1,76 @@
+package templates
+
+import "fmt"
+
+func RateAlertTemplate() string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Rate Alert</title>
+    <style>%s</style>
+</head>
+<body>
+    <div class="email-wrapper">
+        <div class="header">
+            <div class="logo">
+                <img src="https://res.cloudinary.com/ds2hdlfvc/image/upload/v1755948663/logo_nf44qm.png" alt="JeanPay Logo" />
+            </div>
+            <h1>Rate Alert</h1>
+            <p>{{.Pair}} has moved {{.Direction}} your target</p>
+        </div>
+        <div class="content">
+            <div class="greeting">Hello {{.UserName}},</div>
+            <div class="message">
+                The exchange rate you are watching just crossed the level you set.
+            </div>
+            <div class="code-section">
+                <div class="code-label">Current {{.Pair}} rate</div>
+                <div class="verification-code">{{.Rate}}</div>
+                <div class="message">Your alert: {{.Direction}} {{.Threshold}}</div>
+            </div>
+            <div class="highlight">
+                <p>Rates can change at any time. Open JeanPay to convert at the current rate.</p>
+            </div>
+            <div class="divider"></div>
+            <div class="message">
+                You can change or switch off this alert from the rates page in the app.
+            </div>
+        </div>
+        <div class="footer">
+            <div class="footer-logo">JeanPay</div>
+            <div class="footer-text">Fast, secure cross-border payments</div>
+            <div class="footer-text">This email was sent to {{.Email}}</div>
+            <div class="footer-links">
+                <a href="{{.ServerURL}}/convert" class="footer-link">Convert</a>
+                <a href="{{.ServerURL}}/support" class="footer-link">Contact Support</a>
+                <a href="{{.ServerURL}}/help" class="footer-link">Help Center</a>
+            </div>
+        </div>
+    </div>
+</body>
+</html>`, BaseCss)
+}
+
+func RateAlertPlainTextTemplate() string {
+	return `📈 JeanPay Rate Alert
+Hello {{.UserName}},
+
+The exchange rate you are watching just crossed the level you set.
+
+Current {{.Pair}} rate: {{.Rate}}
+Your alert: {{.Direction}} {{.Threshold}}
+
+Rates can change at any time. Open JeanPay to convert at the current rate.
+
+You can change or switch off this alert from the rates page in the app.
+
+Best regards,
+The JeanPay Team
+
+---
+This email was sent to {{.Email}}
+Fast, secure cross-border payments.`
+}
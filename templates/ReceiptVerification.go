@@ -0,0 +1,91 @@
+package templates
+
+import "fmt"
+
+// ReceiptVerificationTemplate is the public page behind a shared receipt link
+func ReceiptVerificationTemplate() string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <meta name="robots" content="noindex, nofollow">
+    <title>{{if .Valid}}Genuine Receipt{{else}}Receipt Not Verified{{end}} - JeanPay</title>
+    <style>%s</style>
+</head>
+<body>
+    <div class="email-wrapper">
+        <div class="header">
+            <div class="logo">
+                <img src="https://res.cloudinary.com/ds2hdlfvc/image/upload/v1755948663/logo_nf44qm.png" alt="JeanPay Logo" />
+            </div>
+            {{if .Valid}}
+            <h1>✅ Genuine JeanPay Receipt</h1>
+            <p>This receipt was issued by JeanPay and matches our records</p>
+            {{else}}
+            <h1>⚠️ Receipt Not Verified</h1>
+            <p>This link is invalid or the transaction does not exist</p>
+            {{end}}
+        </div>
+        <div class="content">
+            {{if .Valid}}
+            {{if .Notice}}
+            <div class="highlight">
+                <p><strong>{{.Status}}.</strong> {{.Notice}}</p>
+            </div>
+            {{end}}
+            <div class="card">
+                <h3>Payment</h3>
+                <ul>
+                    <li><strong>Reference:</strong> {{.Reference}}</li>
+                    <li><strong>Type:</strong> {{.Type}}</li>
+                    <li><strong>Status:</strong> {{.Status}}</li>
+                    <li><strong>Amount sent:</strong> {{.FromAmount}}</li>
+                    {{if .ToAmount}}<li><strong>Amount received:</strong> {{.ToAmount}}</li>{{end}}
+                    {{if .Rate}}<li><strong>Exchange rate:</strong> {{.Rate}}</li>{{end}}
+                    {{if .Fee}}<li><strong>Fee:</strong> {{.Fee}}</li>{{end}}
+                </ul>
+            </div>
+            <div class="card">
+                <h3>Parties</h3>
+                <ul>
+                    {{if .SenderName}}<li><strong>Sender:</strong> {{.SenderName}}</li>{{end}}
+                    {{if .RecipientName}}<li><strong>Recipient:</strong> {{.RecipientName}}</li>{{end}}
+                    {{if .RecipientAccount}}<li><strong>Account:</strong> {{.RecipientAccount}}</li>{{end}}
+                    {{if .RecipientBank}}<li><strong>Bank:</strong> {{.RecipientBank}}</li>{{end}}
+                    {{if .RecipientPhone}}<li><strong>Phone number:</strong> {{.RecipientPhone}}</li>{{end}}
+                    {{if .RecipientNetwork}}<li><strong>Network:</strong> {{.RecipientNetwork}}</li>{{end}}
+                </ul>
+            </div>
+            <div class="card">
+                <h3>Dates</h3>
+                <ul>
+                    <li><strong>Created:</strong> {{.CreatedAt}}</li>
+                    <li><strong>Last updated:</strong> {{.UpdatedAt}}</li>
+                </ul>
+            </div>
+            <div class="cta-section">
+                <a href="{{.DownloadURL}}" class="cta-button">Download PDF Receipt</a>
+            </div>
+            <div class="message">
+                Compare these details with the receipt you were shown. If anything differs, the receipt has been altered.
+            </div>
+            {{else}}
+            <div class="message">
+                We could not confirm this receipt. Ask the sender to share the verification link from their JeanPay app or receipt email, and do not release goods or funds until it checks out.
+            </div>
+            {{end}}
+        </div>
+        <div class="footer">
+            <div class="footer-logo">JeanPay</div>
+            <div class="footer-text">Secure payments made simple</div>
+            <div class="footer-links">
+                <a href="{{.ServerURL}}/support" class="footer-link">Support</a>
+                <a href="{{.ServerURL}}/help" class="footer-link">Help Center</a>
+            </div>
+        </div>
+    </div>
+</body>
+</html>`, BaseCss)
+}
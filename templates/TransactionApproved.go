@@ -66,6 +66,11 @@ func TransactionApprovedTemplate() string {
                     View Transaction History
                 </a>
             </div>
+            {{if .ReceiptURL}}
+            <div class="message">
+                Your receipt is attached as a PDF. Anyone you share it with can confirm it is genuine at <a href="{{.ReceiptURL}}">this verification page</a>.
+            </div>
+            {{end}}
             <div class="divider"></div>
             <div class="message">
                 {{.SupportMessage}} Our support team is available 24/7 to assist you with any questions.
@@ -116,7 +121,9 @@ Transaction Details:
 {{.SupportMessage}} Our support team is available 24/7 to assist you with any questions.
 
 View your complete transaction history: {{.ServerURL}}/dashboard/transactions
-
+{{if .ReceiptURL}}
+Your receipt is attached as a PDF. Anyone you share it with can confirm it is genuine at: {{.ReceiptURL}}
+{{end}}
 Best regards,
 The JeanPay Team
 
@@ -0,0 +1,50 @@
+package types
+
+import "time"
+
+// FieldChange represents the old and new value of a field in a change request
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// ChangeRequestQuery represents query parameters for listing change requests
+type ChangeRequestQuery struct {
+	Page       int    `form:"page"`
+	Limit      int    `form:"limit"`
+	Status     string `form:"status"`
+	ActionType string `form:"actionType"`
+}
+
+// ReviewChangeRequest represents an approve or reject decision on a change request
+type ReviewChangeRequest struct {
+	Note string `json:"note"`
+}
+
+// UpdateDualApprovalPolicyRequest represents a request to configure a dual approval policy
+type UpdateDualApprovalPolicyRequest struct {
+	Enabled   *bool    `json:"enabled"`
+	Threshold *float64 `json:"threshold"`
+}
+
+// AdminChangeRequestResponse represents a change request awaiting or after review
+type AdminChangeRequestResponse struct {
+	ID             uint                   `json:"id"`
+	ActionType     string                 `json:"actionType"`
+	TargetID       string                 `json:"targetId"`
+	Diff           map[string]FieldChange `json:"diff"`
+	Metric         float64                `json:"metric"`
+	Status         string                 `json:"status"`
+	MakerID        uint                   `json:"makerId"`
+	CheckerID      *uint                  `json:"checkerId"`
+	ReviewNote     string                 `json:"reviewNote"`
+	ReviewedAt     *time.Time             `json:"reviewedAt"`
+	ExecutionError string                 `json:"executionError,omitempty"`
+	CreatedAt      time.Time              `json:"createdAt"`
+}
+
+// AdminChangeRequestsResponse represents a paginated list of change requests
+type AdminChangeRequestsResponse struct {
+	Requests   []AdminChangeRequestResponse `json:"requests"`
+	Pagination PaginationInfo               `json:"pagination"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/Veedsify/JeanPayGoBackend/database/models"
@@ -25,16 +26,114 @@ type AdminLogResponse struct {
 	Details   string    `json:"details"`
 	IPAddress string    `json:"ip_address"`
 	UserAgent string    `json:"user_agent"`
+	RequestID string    `json:"request_id"`
+	Before    string    `json:"before,omitempty"`
+	After     string    `json:"after,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type GetAdminLogsRequest struct {
-	AdminID string `form:"admin_id"`
-	Action  string `form:"action"`
-	Target  string `form:"target"`
-	Page    int    `form:"page"`
-	Limit   int    `form:"limit"`
+	AdminID   string `form:"admin_id"`
+	Action    string `form:"action"`
+	Target    string `form:"target"`
+	TargetID  string `form:"target_id"`
+	RequestID string `form:"request_id"`
+	IPAddress string `form:"ip_address"`
+	From      string `form:"from"` // YYYY-MM-DD
+	To        string `form:"to"`   // YYYY-MM-DD, inclusive
+	Page      int    `form:"page"`
+	Limit     int    `form:"limit"`
+	Format    string `form:"format"` // "csv" to export every matching row
+}
+
+type GetNotificationLogsRequest struct {
+	UserID string `form:"user_id"`
+	Type   string `form:"type"`
+	Read   *bool  `form:"read"`
+	From   string `form:"from"`
+	To     string `form:"to"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+	Format string `form:"format"`
+}
+
+type GetActivityLogsRequest struct {
+	UserID string `form:"user_id"`
+	Search string `form:"search"`
+	From   string `form:"from"`
+	To     string `form:"to"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+	Format string `form:"format"`
+}
+
+type NotificationLogResponse struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"user_id"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Message   string    `json:"message"`
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ActivityLogResponse struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"user_id"`
+	Activity  string    `json:"activity"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GetNotificationLogsResponse struct {
+	Logs       []NotificationLogResponse `json:"logs"`
+	Total      int64                     `json:"total"`
+	Page       int                       `json:"page"`
+	Limit      int                       `json:"limit"`
+	TotalPages int                       `json:"total_pages"`
+}
+
+type GetActivityLogsResponse struct {
+	Logs       []ActivityLogResponse `json:"logs"`
+	Total      int64                 `json:"total"`
+	Page       int                   `json:"page"`
+	Limit      int                   `json:"limit"`
+	TotalPages int                   `json:"total_pages"`
+}
+
+// AdminActor identifies the admin and the request behind an admin action
+type AdminActor struct {
+	ID        uint
+	IPAddress string
+	UserAgent string
+	RequestID string
+}
+
+// NewLog builds an admin log entry attributed to the actor and its request
+func (a AdminActor) NewLog(action, target, targetID, details string) models.AdminLog {
+	return models.AdminLog{
+		AdminID:   uint32(a.ID),
+		Action:    action,
+		Target:    target,
+		TargetID:  targetID,
+		Details:   details,
+		IPAddress: a.IPAddress,
+		UserAgent: a.UserAgent,
+		RequestID: a.RequestID,
+	}
+}
+
+// NewChangeLog builds an admin log entry that also stores JSON snapshots of the target
+// before and after an update
+func (a AdminActor) NewChangeLog(action, target, targetID, details string, before, after any) models.AdminLog {
+	adminLog := a.NewLog(action, target, targetID, details)
+	if data, err := json.Marshal(before); err == nil {
+		adminLog.Before = string(data)
+	}
+	if data, err := json.Marshal(after); err == nil {
+		adminLog.After = string(data)
+	}
+	return adminLog
 }
 
 type GetAdminLogsResponse struct {
@@ -55,6 +154,9 @@ func ToAdminLogResponse(adminLog *models.AdminLog) AdminLogResponse {
 		Details:   adminLog.Details,
 		IPAddress: adminLog.IPAddress,
 		UserAgent: adminLog.UserAgent,
+		RequestID: adminLog.RequestID,
+		Before:    adminLog.Before,
+		After:     adminLog.After,
 		CreatedAt: adminLog.CreatedAt,
 		UpdatedAt: adminLog.UpdatedAt,
 	}
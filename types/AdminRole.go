@@ -0,0 +1,38 @@
+package types
+
+// CreateAdminRoleRequest represents a request to create a custom admin role
+type CreateAdminRoleRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+// UpdateAdminRoleRequest represents a request to update an admin role
+type UpdateAdminRoleRequest struct {
+	DisplayName string    `json:"displayName"`
+	Description string    `json:"description"`
+	Permissions *[]string `json:"permissions"`
+}
+
+// AssignAdminRoleRequest represents a request to assign a role to an admin
+type AssignAdminRoleRequest struct {
+	RoleID uint `json:"roleId" binding:"required"`
+}
+
+// AdminRoleResponse represents an admin role with its permission names
+type AdminRoleResponse struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description"`
+	IsSystem    bool     `json:"isSystem"`
+	Permissions []string `json:"permissions"`
+	AdminCount  int64    `json:"adminCount"`
+}
+
+// AdminPermissionsResponse represents the role and permissions of the signed-in admin
+type AdminPermissionsResponse struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
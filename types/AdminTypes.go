@@ -94,6 +94,7 @@ type PaginationInfo struct {
 // TransactionDetailsResponse represents detailed transaction response
 type TransactionDetailsResponse struct {
 	Transaction models.Transaction `json:"transaction"`
+	ReceiptURL  string             `json:"receipt_url,omitempty"`
 }
 
 // UserQueryParams represents query parameters for users
@@ -205,12 +206,6 @@ type UserTransactionQuery struct {
 	Search      string `form:"search"`
 }
 
-// UpdateTransactionStatusRequest represents request to update transaction status
-type UpdateTransactionStatusRequest struct {
-	Status string `json:"status" binding:"required"`
-	Reason string `json:"reason,omitempty"`
-}
-
 // CreateDepositRequest represents request to create deposit transaction
 type CreateDepositRequest struct {
 	Amount      float64 `json:"amount" binding:"required"`
@@ -227,15 +222,6 @@ type CreateWithdrawalRequest struct {
 	Description string  `json:"description,omitempty"`
 }
 
-// TransactionActionResponse represents response for transaction actions
-type TransactionActionResponse struct {
-	TransactionID string `json:"transaction_id"`
-	Status        string `json:"status"`
-	ActionedAt    string `json:"actioned_at"`
-	ActionedBy    uint32 `json:"actioned_by"`
-	Reason        string `json:"reason,omitempty"`
-}
-
 // AdminTransactionResponse represents paginated admin transactions response
 type AdminTransactionResponse struct {
 	Transactions []TransactionWithUser `json:"transactions"`
@@ -0,0 +1,77 @@
+package types
+
+import "time"
+
+// CampaignSegmentRequest selects a campaign's audience. Leave a field empty to not filter on it.
+type CampaignSegmentRequest struct {
+	Country          string   `json:"country" binding:"omitempty,oneof=nigeria ghana"`
+	MinKycTier       *int     `json:"minKycTier" binding:"omitempty,min=0,max=1"`
+	MaxKycTier       *int     `json:"maxKycTier" binding:"omitempty,min=0,max=1"`
+	WalletCurrency   string   `json:"walletCurrency" binding:"omitempty,currency"`
+	MinBalance       *float64 `json:"minBalance" binding:"omitempty,min=0"`
+	MaxBalance       *float64 `json:"maxBalance" binding:"omitempty,min=0"`
+	ActiveWithinDays int      `json:"activeWithinDays" binding:"min=0"`
+	InactiveForDays  int      `json:"inactiveForDays" binding:"min=0"`
+}
+
+type CreateCampaignRequest struct {
+	Title    string                 `json:"title" binding:"required,max=120"`
+	Message  string                 `json:"message" binding:"required,max=2000"`
+	Channels []string               `json:"channels" binding:"required,min=1,dive,oneof=in_app email sms push"`
+	Segment  CampaignSegmentRequest `json:"segment"`
+}
+
+// UpdateCampaignRequest edits a draft or scheduled campaign; omitted fields are kept
+type UpdateCampaignRequest struct {
+	Title    *string                 `json:"title" binding:"omitempty,max=120"`
+	Message  *string                 `json:"message" binding:"omitempty,max=2000"`
+	Channels []string                `json:"channels" binding:"omitempty,min=1,dive,oneof=in_app email sms push"`
+	Segment  *CampaignSegmentRequest `json:"segment"`
+}
+
+type ScheduleCampaignRequest struct {
+	// SendAt is RFC3339; leave empty to send now
+	SendAt string `json:"sendAt"`
+}
+
+type CampaignQuery struct {
+	Status string `form:"status"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+}
+
+type CampaignAudienceResponse struct {
+	Recipients int64 `json:"recipients"`
+}
+
+// CampaignStats counts recipients by delivery outcome. Read counts in-app notifications
+// the recipients have opened.
+type CampaignStats struct {
+	Recipients int   `json:"recipients"`
+	Pending    int64 `json:"pending"`
+	Queued     int64 `json:"queued"`
+	Delivered  int64 `json:"delivered"`
+	Failed     int64 `json:"failed"`
+	Skipped    int64 `json:"skipped"`
+	Read       int64 `json:"read"`
+}
+
+type CampaignResponse struct {
+	ID          uint                   `json:"id"`
+	Title       string                 `json:"title"`
+	Message     string                 `json:"message"`
+	Channels    []string               `json:"channels"`
+	Segment     CampaignSegmentRequest `json:"segment"`
+	Status      string                 `json:"status"`
+	ScheduledAt *time.Time             `json:"scheduledAt"`
+	StartedAt   *time.Time             `json:"startedAt"`
+	CompletedAt *time.Time             `json:"completedAt"`
+	CreatedBy   uint                   `json:"createdBy"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	Stats       CampaignStats          `json:"stats"`
+}
+
+type CampaignsResponse struct {
+	Campaigns  []CampaignResponse  `json:"campaigns"`
+	Pagination *PaginationResponse `json:"pagination"`
+}
@@ -62,8 +62,8 @@ type ConversionCalculateResponse struct {
 
 // ConversionRequest represents a currency conversion request
 type ConversionRequest struct {
-	FromCurrency string  `json:"fromCurrency" validate:"required,oneof=NGN GHS"`
-	ToCurrency   string  `json:"toCurrency" validate:"required,oneof=NGN GHS"`
+	FromCurrency string  `json:"fromCurrency" validate:"required,currency"`
+	ToCurrency   string  `json:"toCurrency" validate:"required,currency"`
 	Amount       float64 `json:"amount" validate:"required,gt=0"`
 }
 
@@ -0,0 +1,61 @@
+package types
+
+type CurrencyResponse struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Symbol     string `json:"symbol"`
+	MinorUnits int    `json:"minorUnits"`
+	Country    string `json:"country"`
+}
+
+type CorridorResponse struct {
+	FromCurrency string  `json:"fromCurrency"`
+	ToCurrency   string  `json:"toCurrency"`
+	Rate         float64 `json:"rate"`
+	MinAmount    float64 `json:"minAmount"`
+	MaxAmount    float64 `json:"maxAmount"`
+}
+
+// CurrenciesResponse lists what users can hold and which pairs they can move between
+type CurrenciesResponse struct {
+	Currencies []CurrencyResponse `json:"currencies"`
+	Corridors  []CorridorResponse `json:"corridors"`
+}
+
+type CreateCurrencyRequest struct {
+	Code       string `json:"code" binding:"required,len=3,alpha"`
+	Name       string `json:"name" binding:"required"`
+	Symbol     string `json:"symbol" binding:"required"`
+	MinorUnits *int   `json:"minorUnits" binding:"omitempty,min=0,max=4"`
+	Country    string `json:"country"`
+	SortOrder  int    `json:"sortOrder"`
+	IsEnabled  *bool  `json:"isEnabled"`
+	// StepUpThreshold of 0 uses the platform threshold converted into this currency
+	StepUpThreshold float64 `json:"stepUpThreshold" binding:"omitempty,gte=0"`
+}
+
+type UpdateCurrencyRequest struct {
+	Name            string   `json:"name"`
+	Symbol          string   `json:"symbol"`
+	MinorUnits      *int     `json:"minorUnits" binding:"omitempty,min=0,max=4"`
+	Country         *string  `json:"country"`
+	SortOrder       *int     `json:"sortOrder"`
+	IsEnabled       *bool    `json:"isEnabled"`
+	StepUpThreshold *float64 `json:"stepUpThreshold" binding:"omitempty,gte=0"`
+}
+
+type CreateCorridorRequest struct {
+	FromCurrency string  `json:"fromCurrency" binding:"required,len=3"`
+	ToCurrency   string  `json:"toCurrency" binding:"required,len=3"`
+	DefaultRate  float64 `json:"defaultRate" binding:"omitempty,gt=0"`
+	MinAmount    float64 `json:"minAmount" binding:"omitempty,gte=0"`
+	MaxAmount    float64 `json:"maxAmount" binding:"omitempty,gte=0"`
+	IsEnabled    *bool   `json:"isEnabled"`
+}
+
+type UpdateCorridorRequest struct {
+	DefaultRate *float64 `json:"defaultRate" binding:"omitempty,gt=0"`
+	MinAmount   *float64 `json:"minAmount" binding:"omitempty,gte=0"`
+	MaxAmount   *float64 `json:"maxAmount" binding:"omitempty,gte=0"`
+	IsEnabled   *bool    `json:"isEnabled"`
+}
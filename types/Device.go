@@ -0,0 +1,21 @@
+package types
+
+import "time"
+
+type RegisterDeviceRequest struct {
+	Token      string `json:"token" binding:"required,max=512"`
+	Platform   string `json:"platform" binding:"required,oneof=android ios web"`
+	DeviceName string `json:"deviceName" binding:"omitempty,max=100"`
+}
+
+type UnregisterDeviceRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type DeviceResponse struct {
+	ID         uint      `json:"id"`
+	Platform   string    `json:"platform"`
+	DeviceName string    `json:"deviceName"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
@@ -0,0 +1,90 @@
+package types
+
+import "time"
+
+type GetEmailDeliveriesRequest struct {
+	Status            string `form:"status"`
+	Email             string `form:"email"` // matches any recipient containing it
+	Template          string `form:"template"`
+	ProviderMessageID string `form:"provider_message_id"`
+	From              string `form:"from"` // YYYY-MM-DD
+	To                string `form:"to"`   // YYYY-MM-DD, inclusive
+	Page              int    `form:"page"`
+	Limit             int    `form:"limit"`
+}
+
+type GetEmailSuppressionsRequest struct {
+	Email  string `form:"email"`
+	Reason string `form:"reason"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+}
+
+type AddEmailSuppressionRequest struct {
+	Email  string `json:"email" binding:"required,email"`
+	Detail string `json:"detail" binding:"max=255"`
+}
+
+type EmailDeliveryResponse struct {
+	ID                uint       `json:"id"`
+	To                []string   `json:"to"`
+	Template          string     `json:"template"`
+	Locale            string     `json:"locale"`
+	Subject           string     `json:"subject"`
+	Provider          string     `json:"provider"`
+	ProviderMessageID string     `json:"provider_message_id"`
+	Status            string     `json:"status"`
+	Attempts          int        `json:"attempts"`
+	Error             string     `json:"error"`
+	Suppressed        []string   `json:"suppressed"`
+	Attachments       []string   `json:"attachments"`
+	Redacted          bool       `json:"redacted"` // a security email whose content is not kept
+	ResentFromID      *uint      `json:"resent_from_id"`
+	CreatedAt         time.Time  `json:"created_at"`
+	SentAt            *time.Time `json:"sent_at"`
+	DeliveredAt       *time.Time `json:"delivered_at"`
+	BouncedAt         *time.Time `json:"bounced_at"`
+}
+
+// EmailDeliveryDetailResponse adds the content of a delivery and the resends made from it
+type EmailDeliveryDetailResponse struct {
+	EmailDeliveryResponse
+	CC       []string                `json:"cc"`
+	BCC      []string                `json:"bcc"`
+	TextBody string                  `json:"text_body"`
+	HTMLBody string                  `json:"html_body"`
+	Resends  []EmailDeliveryResponse `json:"resends"`
+}
+
+type GetEmailDeliveriesResponse struct {
+	Deliveries []EmailDeliveryResponse `json:"deliveries"`
+	Total      int64                   `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+	TotalPages int                     `json:"total_pages"`
+}
+
+// EmailDeliveryStatsResponse counts deliveries by status
+type EmailDeliveryStatsResponse struct {
+	Total    int64            `json:"total"`
+	ByStatus map[string]int64 `json:"by_status"`
+	LastSent *time.Time       `json:"last_sent"`
+}
+
+type EmailSuppressionResponse struct {
+	ID         uint      `json:"id"`
+	Email      string    `json:"email"`
+	Reason     string    `json:"reason"`
+	Detail     string    `json:"detail"`
+	DeliveryID *uint     `json:"delivery_id"`
+	CreatedBy  uint      `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type GetEmailSuppressionsResponse struct {
+	Suppressions []EmailSuppressionResponse `json:"suppressions"`
+	Total        int64                      `json:"total"`
+	Page         int                        `json:"page"`
+	Limit        int                        `json:"limit"`
+	TotalPages   int                        `json:"total_pages"`
+}
@@ -0,0 +1,86 @@
+package types
+
+import "time"
+
+type EmailTemplateVariableRequest struct {
+	Name        string `json:"name" binding:"required,max=64"`
+	Description string `json:"description" binding:"max=255"`
+	Required    bool   `json:"required"`
+	Sample      string `json:"sample"`
+}
+
+// CreateEmailTemplateVersionRequest saves a new revision of a template. Every variable the
+// content uses must be declared, apart from the ones added to every email. Locale is the
+// language the revision is written in, English when empty.
+type CreateEmailTemplateVersionRequest struct {
+	Locale      string                         `json:"locale" binding:"omitempty,max=10"`
+	Subject     string                         `json:"subject" binding:"required,max=255"`
+	HTMLContent string                         `json:"htmlContent" binding:"required"`
+	TextContent string                         `json:"textContent"`
+	Variables   []EmailTemplateVariableRequest `json:"variables" binding:"dive"`
+	Note        string                         `json:"note" binding:"max=255"`
+	Activate    bool                           `json:"activate"`
+}
+
+// PreviewEmailTemplateRequest renders a template with sample data. Content fields preview an
+// unsaved draft; otherwise Version picks a saved revision, 0 the built-in template, and an
+// empty Version the one currently sent to readers of Locale.
+type PreviewEmailTemplateRequest struct {
+	Locale      string         `json:"locale" binding:"omitempty,max=10"`
+	Version     *int           `json:"version" binding:"omitempty,min=0"`
+	Subject     string         `json:"subject"`
+	HTMLContent string         `json:"htmlContent"`
+	TextContent string         `json:"textContent"`
+	Data        map[string]any `json:"data"`
+}
+
+// RollbackEmailTemplateRequest makes an earlier revision the one sent to readers of Locale; 0
+// restores the built-in template
+type RollbackEmailTemplateRequest struct {
+	Locale  string `json:"locale" binding:"omitempty,max=10"`
+	Version *int   `json:"version" binding:"required,min=0"`
+}
+
+type EmailTemplateVariableResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+	Sample      string `json:"sample"`
+}
+
+type EmailTemplateSummary struct {
+	Name           string         `json:"name"`
+	Subject        string         `json:"subject"`
+	ActiveVersion  int            `json:"activeVersion"`  // 0 while the built-in template is sent
+	ActiveVersions map[string]int `json:"activeVersions"` // by language, for languages with an active revision
+	LatestVersion  int            `json:"latestVersion"`
+	UpdatedAt      *time.Time     `json:"updatedAt"`
+}
+
+type EmailTemplateVersionResponse struct {
+	Version     int                             `json:"version"`
+	Locale      string                          `json:"locale"`
+	Subject     string                          `json:"subject"`
+	HTMLContent string                          `json:"htmlContent"`
+	TextContent string                          `json:"textContent"`
+	Variables   []EmailTemplateVariableResponse `json:"variables"`
+	IsActive    bool                            `json:"isActive"`
+	Note        string                          `json:"note"`
+	CreatedBy   uint                            `json:"createdBy"`
+	CreatedAt   time.Time                       `json:"createdAt"`
+}
+
+type EmailTemplateDetailResponse struct {
+	Name           string                         `json:"name"`
+	ActiveVersion  int                            `json:"activeVersion"`
+	ActiveVersions map[string]int                 `json:"activeVersions"`
+	BuiltIn        EmailTemplateVersionResponse   `json:"builtIn"`
+	Versions       []EmailTemplateVersionResponse `json:"versions"`
+}
+
+type EmailTemplatePreviewResponse struct {
+	Subject          string   `json:"subject"`
+	HTML             string   `json:"html"`
+	Text             string   `json:"text"`
+	MissingVariables []string `json:"missingVariables"`
+}
@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// TransactionStatusEvent is streamed to the owner of a transaction when its status changes
+type TransactionStatusEvent struct {
+	TransactionID   string    `json:"transaction_id"`
+	Reference       string    `json:"reference"`
+	TransactionType string    `json:"transaction_type"`
+	Direction       string    `json:"direction"`
+	Status          string    `json:"status"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
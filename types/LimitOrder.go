@@ -0,0 +1,39 @@
+package types
+
+import "time"
+
+type CreateLimitOrderRequest struct {
+	FromCurrency string  `json:"fromCurrency" binding:"required,currency"`
+	ToCurrency   string  `json:"toCurrency" binding:"required,currency"`
+	Amount       float64 `json:"amount" binding:"required,gt=0"`
+	// TargetRate is the FromCurrency->ToCurrency rate at or above which the order converts
+	TargetRate     float64 `json:"targetRate" binding:"required,gt=0"`
+	ExpiresInHours int     `json:"expiresInHours" binding:"omitempty,min=1,max=720"`
+}
+
+type LimitOrderQuery struct {
+	Status string `form:"status"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+}
+
+type LimitOrderResponse struct {
+	ID              uint       `json:"id"`
+	FromCurrency    string     `json:"fromCurrency"`
+	ToCurrency      string     `json:"toCurrency"`
+	Amount          float64    `json:"amount"`
+	TargetRate      float64    `json:"targetRate"`
+	Status          string     `json:"status"`
+	ExpiresAt       time.Time  `json:"expiresAt"`
+	FilledAt        *time.Time `json:"filledAt"`
+	FilledRate      float64    `json:"filledRate"`
+	ConvertedAmount float64    `json:"convertedAmount"`
+	ConversionID    string     `json:"conversionId"`
+	ClosedAt        *time.Time `json:"closedAt"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type LimitOrdersResponse struct {
+	Orders     []LimitOrderResponse `json:"orders"`
+	Pagination *PaginationResponse  `json:"pagination"`
+}
@@ -0,0 +1,22 @@
+package types
+
+type NotificationPreferenceUpdate struct {
+	Category string `json:"category" binding:"required"`
+	Channel  string `json:"channel" binding:"required,oneof=in_app email sms push"`
+	Enabled  *bool  `json:"enabled" binding:"required"`
+}
+
+type NotificationChannelPreference struct {
+	Channel string `json:"channel"`
+	Enabled bool   `json:"enabled"`
+	// Locked is set for mandatory categories, which cannot be turned off
+	Locked bool `json:"locked"`
+	// Available is false when the platform has switched the channel off for everyone
+	Available bool `json:"available"`
+}
+
+type NotificationCategoryPreferences struct {
+	Category  string                          `json:"category"`
+	Mandatory bool                            `json:"mandatory"`
+	Channels  []NotificationChannelPreference `json:"channels"`
+}
@@ -0,0 +1,35 @@
+package types
+
+import "time"
+
+type P2PTransferRequest struct {
+	// Recipient is the username, email or phone number of the JeanPay user being paid
+	Recipient    string  `json:"recipient" binding:"required"`
+	FromCurrency string  `json:"fromCurrency" binding:"required,currency"`
+	ToCurrency   string  `json:"toCurrency" binding:"omitempty,currency"`
+	Amount       float64 `json:"amount" binding:"required,gt=0"`
+	Note         string  `json:"note" binding:"max=140"`
+	StepUpCredentials
+}
+
+type P2PRecipientResponse struct {
+	UserID         uint   `json:"userId"`
+	Username       string `json:"username"`
+	FirstName      string `json:"firstName"`
+	LastName       string `json:"lastName"`
+	ProfilePicture string `json:"profilePicture"`
+}
+
+type P2PTransferResponse struct {
+	TransferID    string               `json:"transferId"`
+	TransactionID string               `json:"transactionId"`
+	Recipient     P2PRecipientResponse `json:"recipient"`
+	FromCurrency  string               `json:"fromCurrency"`
+	ToCurrency    string               `json:"toCurrency"`
+	FromAmount    float64              `json:"fromAmount"`
+	ToAmount      float64              `json:"toAmount"`
+	Rate          float64              `json:"rate"`
+	Note          string               `json:"note"`
+	Status        string               `json:"status"`
+	CreatedAt     time.Time            `json:"createdAt"`
+}
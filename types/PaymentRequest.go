@@ -0,0 +1,53 @@
+package types
+
+import "time"
+
+type CreatePaymentRequestRequest struct {
+	// Payer is the username, email or phone number of the user asked to pay; leave empty for an open link
+	Payer          string  `json:"payer"`
+	Amount         float64 `json:"amount" binding:"required,gt=0"`
+	Currency       string  `json:"currency" binding:"required,currency"`
+	Note           string  `json:"note" binding:"max=140"`
+	ExpiresInHours int     `json:"expiresInHours" binding:"omitempty,min=1,max=720"`
+}
+
+type PayPaymentRequestRequest struct {
+	// FromCurrency is the wallet to pay from; defaults to the request currency
+	FromCurrency string `json:"fromCurrency" binding:"omitempty,currency"`
+	StepUpCredentials
+}
+
+type PaymentRequestCheckoutRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type PaymentRequestQuery struct {
+	Role   string `form:"role"` // sent or received
+	Status string `form:"status"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+}
+
+type PaymentRequestResponse struct {
+	Code      string                `json:"code"`
+	Link      string                `json:"link"`
+	Requester P2PRecipientResponse  `json:"requester"`
+	Payer     *P2PRecipientResponse `json:"payer,omitempty"`
+	Amount    float64               `json:"amount"`
+	Currency  string                `json:"currency"`
+	Note      string                `json:"note"`
+	Status    string                `json:"status"`
+	ExpiresAt time.Time             `json:"expiresAt"`
+	PaidAt    *time.Time            `json:"paidAt"`
+	CreatedAt time.Time             `json:"createdAt"`
+}
+
+type PaymentRequestsResponse struct {
+	Requests   []PaymentRequestResponse `json:"requests"`
+	Pagination *PaginationResponse      `json:"pagination"`
+}
+
+type PaymentRequestCheckoutResponse struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+	Reference        string `json:"reference"`
+}
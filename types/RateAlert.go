@@ -0,0 +1,33 @@
+package types
+
+import "time"
+
+type CreateRateAlertRequest struct {
+	FromCurrency string  `json:"fromCurrency" binding:"required,currency"`
+	ToCurrency   string  `json:"toCurrency" binding:"required,currency"`
+	Direction    string  `json:"direction" binding:"required,oneof=above below"`
+	Threshold    float64 `json:"threshold" binding:"required,gt=0"`
+	// CooldownMinutes is the quiet period after the alert fires; defaults to an hour
+	CooldownMinutes int `json:"cooldownMinutes" binding:"omitempty,min=5,max=10080"`
+}
+
+type UpdateRateAlertRequest struct {
+	Direction       string  `json:"direction" binding:"omitempty,oneof=above below"`
+	Threshold       float64 `json:"threshold" binding:"omitempty,gt=0"`
+	CooldownMinutes int     `json:"cooldownMinutes" binding:"omitempty,min=5,max=10080"`
+	IsActive        *bool   `json:"isActive"`
+}
+
+type RateAlertResponse struct {
+	ID                uint       `json:"id"`
+	FromCurrency      string     `json:"fromCurrency"`
+	ToCurrency        string     `json:"toCurrency"`
+	Direction         string     `json:"direction"`
+	Threshold         float64    `json:"threshold"`
+	CooldownMinutes   int        `json:"cooldownMinutes"`
+	IsActive          bool       `json:"isActive"`
+	LastTriggeredAt   *time.Time `json:"lastTriggeredAt"`
+	LastTriggeredRate float64    `json:"lastTriggeredRate"`
+	TriggerCount      int        `json:"triggerCount"`
+	CreatedAt         time.Time  `json:"createdAt"`
+}
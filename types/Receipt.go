@@ -0,0 +1,35 @@
+package types
+
+import "time"
+
+// TransactionReceipt is the printable summary of a transaction. Account and phone numbers
+// are masked so the receipt can be shared.
+type TransactionReceipt struct {
+	TransactionID    string    `json:"transaction_id"`
+	Reference        string    `json:"reference"`
+	Type             string    `json:"type"`
+	Status           string    `json:"status"`
+	Description      string    `json:"description"`
+	SenderName       string    `json:"sender_name"`
+	RecipientName    string    `json:"recipient_name"`
+	RecipientAccount string    `json:"recipient_account,omitempty"`
+	RecipientBank    string    `json:"recipient_bank,omitempty"`
+	RecipientPhone   string    `json:"recipient_phone,omitempty"`
+	RecipientNetwork string    `json:"recipient_network,omitempty"`
+	FromAmount       float64   `json:"from_amount"`
+	FromCurrency     string    `json:"from_currency"`
+	ToAmount         float64   `json:"to_amount"`
+	ToCurrency       string    `json:"to_currency"`
+	Rate             float64   `json:"rate"`
+	Fee              float64   `json:"fee"`
+	FeeCurrency      string    `json:"fee_currency"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	VerifyURL        string    `json:"verify_url"`
+	LinkVersion      int       `json:"-"`
+}
+
+// ReceiptVerifyQuery carries the signature of a shared receipt link
+type ReceiptVerifyQuery struct {
+	Signature string `form:"signature" binding:"required"`
+}
@@ -0,0 +1,41 @@
+package types
+
+import "time"
+
+type CreateRecipientRequest struct {
+	Type          string `json:"type" binding:"required,oneof=bank momo"`
+	RecipientName string `json:"recipientName" binding:"required"`
+	Nickname      string `json:"nickname"`
+	AccountNumber string `json:"accountNumber"`
+	BankName      string `json:"bankName"`
+	BankCode      string `json:"bankCode"`
+	PhoneNumber   string `json:"phoneNumber"`
+	Network       string `json:"network"`
+	Currency      string `json:"currency"`
+}
+
+type UpdateRecipientRequest struct {
+	RecipientName string `json:"recipientName"`
+	Nickname      string `json:"nickname"`
+	AccountNumber string `json:"accountNumber"`
+	BankName      string `json:"bankName"`
+	BankCode      string `json:"bankCode"`
+	PhoneNumber   string `json:"phoneNumber"`
+	Network       string `json:"network"`
+	Currency      string `json:"currency"`
+}
+
+type RecipientResponse struct {
+	ID            uint       `json:"id"`
+	Type          string     `json:"type"`
+	RecipientName string     `json:"recipientName"`
+	Nickname      string     `json:"nickname"`
+	AccountNumber string     `json:"accountNumber"`
+	BankName      string     `json:"bankName"`
+	BankCode      string     `json:"bankCode"`
+	PhoneNumber   string     `json:"phoneNumber"`
+	Network       string     `json:"network"`
+	Currency      string     `json:"currency"`
+	LastUsedAt    *time.Time `json:"lastUsedAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
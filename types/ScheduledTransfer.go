@@ -0,0 +1,66 @@
+package types
+
+import "time"
+
+type CreateScheduledTransferRequest struct {
+	RecipientID  uint    `json:"recipientId" binding:"required"`
+	FromCurrency string  `json:"fromCurrency" binding:"required,currency"`
+	ToCurrency   string  `json:"toCurrency" binding:"required,currency"`
+	Amount       float64 `json:"amount" binding:"required,gt=0"`
+	Note         string  `json:"note" binding:"max=140"`
+	Frequency    string  `json:"frequency" binding:"required,oneof=daily weekly biweekly monthly"`
+	// StartDate is the first run, RFC3339 or YYYY-MM-DD; defaults to now
+	StartDate string `json:"startDate"`
+	// Leave both EndDate and MaxRuns empty to repeat until cancelled
+	EndDate string `json:"endDate"`
+	MaxRuns int    `json:"maxRuns" binding:"omitempty,min=1"`
+	StepUpCredentials
+}
+
+type ScheduledTransferQuery struct {
+	Status string `form:"status"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+}
+
+type ScheduledTransferResponse struct {
+	ID           uint              `json:"id"`
+	Recipient    RecipientResponse `json:"recipient"`
+	FromCurrency string            `json:"fromCurrency"`
+	ToCurrency   string            `json:"toCurrency"`
+	Amount       float64           `json:"amount"`
+	Note         string            `json:"note"`
+	Frequency    string            `json:"frequency"`
+	StartDate    time.Time         `json:"startDate"`
+	EndDate      *time.Time        `json:"endDate"`
+	MaxRuns      int               `json:"maxRuns"`
+	RunCount     int               `json:"runCount"`
+	FailureCount int               `json:"failureCount"`
+	NextRunAt    *time.Time        `json:"nextRunAt"`
+	LastRunAt    *time.Time        `json:"lastRunAt"`
+	Status       string            `json:"status"`
+	CreatedAt    time.Time         `json:"createdAt"`
+}
+
+type ScheduledTransfersResponse struct {
+	Schedules  []ScheduledTransferResponse `json:"schedules"`
+	Pagination *PaginationResponse         `json:"pagination"`
+}
+
+type ScheduledTransferRunResponse struct {
+	ID            uint      `json:"id"`
+	ScheduledFor  time.Time `json:"scheduledFor"`
+	Status        string    `json:"status"`
+	TransactionID string    `json:"transactionId"`
+	FromAmount    float64   `json:"fromAmount"`
+	ToAmount      float64   `json:"toAmount"`
+	Rate          float64   `json:"rate"`
+	Code          string    `json:"code"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type ScheduledTransferRunsResponse struct {
+	Runs       []ScheduledTransferRunResponse `json:"runs"`
+	Pagination *PaginationResponse            `json:"pagination"`
+}
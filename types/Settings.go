@@ -4,3 +4,29 @@ type WalletSettingsRequest struct {
 	Username string `json:"username" binding:"required"`
 	Currency string `json:"currency" binding:"required"`
 }
+
+// StepUpCredentials carries the proof required for sensitive actions.
+// Either the transaction PIN or a fresh emailed verification code is accepted,
+// above the platform step-up threshold only the code is accepted.
+type StepUpCredentials struct {
+	Pin     string `json:"pin,omitempty"`
+	OtpCode string `json:"otpCode,omitempty"`
+}
+
+type SetTransactionPinRequest struct {
+	Pin        string `json:"pin" binding:"required"`
+	ConfirmPin string `json:"confirmPin" binding:"required"`
+	Password   string `json:"password" binding:"required"`
+}
+
+type ChangeTransactionPinRequest struct {
+	CurrentPin string `json:"currentPin" binding:"required"`
+	NewPin     string `json:"newPin" binding:"required"`
+	ConfirmPin string `json:"confirmPin" binding:"required"`
+}
+
+type ResetTransactionPinRequest struct {
+	Code       string `json:"code" binding:"required"`
+	NewPin     string `json:"newPin" binding:"required"`
+	ConfirmPin string `json:"confirmPin" binding:"required"`
+}
@@ -0,0 +1,48 @@
+package types
+
+import "time"
+
+type CreateStatementRequest struct {
+	Currency string `json:"currency" binding:"required,currency"`
+	// StartDate and EndDate are YYYY-MM-DD; both days are included
+	StartDate string `json:"startDate" binding:"required"`
+	EndDate   string `json:"endDate" binding:"required"`
+	Format    string `json:"format" binding:"omitempty,oneof=pdf csv"`
+	// Delivery "email" sends the file as an attachment once it is ready; defaults to "download"
+	Delivery string `json:"delivery" binding:"omitempty,oneof=download email"`
+}
+
+type StatementQuery struct {
+	Page  int `form:"page"`
+	Limit int `form:"limit"`
+}
+
+// StatementDownloadQuery carries the signature of a statement download link
+type StatementDownloadQuery struct {
+	Expires   int64  `form:"expires" binding:"required"`
+	Signature string `form:"signature" binding:"required"`
+}
+
+type StatementResponse struct {
+	ID             uint       `json:"id"`
+	Currency       string     `json:"currency"`
+	StartDate      time.Time  `json:"startDate"`
+	EndDate        time.Time  `json:"endDate"`
+	Format         string     `json:"format"`
+	Delivery       string     `json:"delivery"`
+	Status         string     `json:"status"`
+	OpeningBalance float64    `json:"openingBalance"`
+	ClosingBalance float64    `json:"closingBalance"`
+	EntryCount     int        `json:"entryCount"`
+	FileName       string     `json:"fileName"`
+	DownloadURL    string     `json:"downloadUrl,omitempty"` // signed, valid until ExpiresAt
+	Error          string     `json:"error,omitempty"`
+	GeneratedAt    *time.Time `json:"generatedAt"`
+	ExpiresAt      *time.Time `json:"expiresAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+type StatementsResponse struct {
+	Statements []StatementResponse `json:"statements"`
+	Pagination *PaginationResponse `json:"pagination"`
+}
@@ -143,6 +143,11 @@ type NewTransactionRequest struct {
 	TransactionId   string  `json:"transactionId"`
 	MethodOfPayment string  `json:"method_of_payment"`
 	CreatedAt       string  `json:"created_at"`
+	// RecipientID selects a saved recipient instead of the raw account fields above
+	RecipientID uint `json:"recipientId,omitempty"`
+	// Note is added to the transaction description
+	Note string `json:"note,omitempty" binding:"max=140"`
+	StepUpCredentials
 }
 
 // TransactionFilter represents filters for transaction queries
@@ -40,6 +40,7 @@ type UserResponse struct {
 	IsVerified     bool               `json:"is_verified"`
 	Country        models.UserCountry `json:"country"`
 	Setting        models.Setting     `json:"setting"`
+	HasPin         bool               `json:"has_transaction_pin"`
 }
 
 type VerifyUser struct {
@@ -143,7 +143,7 @@ type WalletBalance struct {
 // TopUpRequest represents a wallet top-up request
 type TopUpRequest struct {
 	Amount           float64 `json:"amount" validate:"required,gt=0"`
-	Currency         string  `json:"currency" validate:"required,oneof=NGN GHS"`
+	Currency         string  `json:"currency" validate:"required,currency"`
 	PaymentMethod    string  `json:"paymentMethod" validate:"required,oneof=bank momo"`
 	PaymentReference string  `json:"paymentReference,omitempty"`
 	IsDirectPayment  bool    `json:"isDirectPayment,omitempty"`
@@ -151,10 +151,11 @@ type TopUpRequest struct {
 
 // WithdrawRequest represents a wallet withdrawal request
 type WithdrawRequest struct {
-	Amount           float64                `json:"amount" validate:"required,gt=0"`
-	Currency         string                 `json:"currency" validate:"required,oneof=NGN GHS"`
-	WithdrawalMethod string                 `json:"withdrawalMethod" validate:"required,oneof=bank momo"`
-	AccountDetails   map[string]interface{} `json:"accountDetails" validate:"required"`
+	Amount   float64 `json:"amount" validate:"required,gt=0"`
+	Currency string  `json:"currency" validate:"required,currency"`
+	// WithdrawMethodID selects a saved withdrawal method; the currency's default is used when omitted
+	WithdrawMethodID uint `json:"withdrawMethodId,omitempty"`
+	StepUpCredentials
 }
 
 // TopUpResponse represents the response for a top-up request
@@ -0,0 +1,44 @@
+package types
+
+import "time"
+
+type CreateWithdrawMethodRequest struct {
+	Currency      string `json:"currency" binding:"required,currency"`
+	Method        string `json:"method" binding:"required,oneof=bank momo"`
+	AccountNumber string `json:"accountNumber" binding:"required"`
+	BankName      string `json:"bankName"`
+	BankCode      string `json:"bankCode"` // network for momo
+	IsDefault     bool   `json:"isDefault"`
+	StepUpCredentials
+}
+
+type UpdateWithdrawMethodRequest struct {
+	AccountNumber string `json:"accountNumber"`
+	BankName      string `json:"bankName"`
+	BankCode      string `json:"bankCode"`
+	StepUpCredentials
+}
+
+type ResolveAccountNameRequest struct {
+	Method        string `json:"method" binding:"required,oneof=bank momo"`
+	AccountNumber string `json:"accountNumber" binding:"required"`
+	BankCode      string `json:"bankCode" binding:"required"`
+}
+
+type ResolveAccountNameResponse struct {
+	AccountNumber string `json:"accountNumber"`
+	AccountName   string `json:"accountName"`
+}
+
+type WithdrawMethodResponse struct {
+	ID            uint       `json:"id"`
+	Currency      string     `json:"currency"`
+	Method        string     `json:"method"`
+	AccountNumber string     `json:"accountNumber"`
+	AccountName   string     `json:"accountName"`
+	BankName      string     `json:"bankName"`
+	BankCode      string     `json:"bankCode"`
+	IsDefault     bool       `json:"isDefault"`
+	VerifiedAt    *time.Time `json:"verifiedAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
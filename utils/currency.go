@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"sort"
+	"sync"
+)
+
+// CurrencyInfo is the part of a registry currency that formatting and validation need
+type CurrencyInfo struct {
+	Code       string
+	Symbol     string
+	MinorUnits int
+	SortOrder  int
+	Enabled    bool
+}
+
+var (
+	currencyMu sync.RWMutex
+	// currencies holds the launch currencies until the registry is loaded from the database
+	currencies = map[string]CurrencyInfo{
+		"NGN": {Code: "NGN", Symbol: "₦", MinorUnits: 2, SortOrder: 1, Enabled: true},
+		"GHS": {Code: "GHS", Symbol: "GH₵", MinorUnits: 2, SortOrder: 2, Enabled: true},
+	}
+)
+
+// SetCurrencies replaces the known currencies with a fresh registry snapshot
+func SetCurrencies(list []CurrencyInfo) {
+	registry := make(map[string]CurrencyInfo, len(list))
+	for _, currency := range list {
+		registry[currency.Code] = currency
+	}
+
+	currencyMu.Lock()
+	currencies = registry
+	currencyMu.Unlock()
+}
+
+// LookupCurrency returns a currency from the registry, enabled or not
+func LookupCurrency(code string) (CurrencyInfo, bool) {
+	currencyMu.RLock()
+	defer currencyMu.RUnlock()
+	currency, ok := currencies[code]
+	return currency, ok
+}
+
+// IsEnabledCurrency reports whether a currency exists and is enabled
+func IsEnabledCurrency(code string) bool {
+	currency, ok := LookupCurrency(code)
+	return ok && currency.Enabled
+}
+
+// EnabledCurrencyCodes lists the enabled currency codes in display order
+func EnabledCurrencyCodes() []string {
+	currencyMu.RLock()
+	enabled := make([]CurrencyInfo, 0, len(currencies))
+	for _, currency := range currencies {
+		if currency.Enabled {
+			enabled = append(enabled, currency)
+		}
+	}
+	currencyMu.RUnlock()
+
+	sort.Slice(enabled, func(i, j int) bool {
+		if enabled[i].SortOrder != enabled[j].SortOrder {
+			return enabled[i].SortOrder < enabled[j].SortOrder
+		}
+		return enabled[i].Code < enabled[j].Code
+	})
+
+	codes := make([]string, 0, len(enabled))
+	for _, currency := range enabled {
+		codes = append(codes, currency.Code)
+	}
+	return codes
+}
+
+// CurrencyMinorUnits returns how many decimal places a currency is held to, defaulting to 2
+func CurrencyMinorUnits(code string) int {
+	if currency, ok := LookupCurrency(code); ok {
+		return currency.MinorUnits
+	}
+	return 2
+}
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Veedsify/JeanPayGoBackend/constants"
+	"github.com/go-redis/redis/v8"
+)
+
+// UserEvent is a real-time event for one user. ID is the Redis stream entry ID and doubles
+// as the SSE event ID clients send back in Last-Event-ID.
+type UserEvent struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+var (
+	eventClient     *redis.Client
+	eventClientOnce sync.Once
+)
+
+// getEventClient returns the Redis client shared by publishers and stream subscribers
+func getEventClient() *redis.Client {
+	eventClientOnce.Do(func() {
+		eventClient = NewRedisClient()
+	})
+	return eventClient
+}
+
+func userEventKey(userID uint) string {
+	return fmt.Sprintf("user_events:%d", userID)
+}
+
+// PublishUserEvent appends an event to the user's backlog and fans it out to every API
+// instance holding an open stream for the user
+func PublishUserEvent(userID uint, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	client := getEventClient()
+	ctx := context.Background()
+	key := userEventKey(userID)
+
+	id, err := client.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: constants.EventStreamBacklog,
+		Approx: true,
+		Values: map[string]any{"type": eventType, "data": string(payload)},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("failed to store event: %w", err)
+	}
+	client.Expire(ctx, key, constants.EventStreamRetention)
+
+	message, err := json.Marshal(UserEvent{ID: id, Type: eventType, Data: payload})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	if err := client.Publish(ctx, key, message).Err(); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+// SubscribeUserEvents subscribes to the user's live events. The subscription is confirmed
+// before it is returned, so nothing published afterwards is missed.
+func SubscribeUserEvents(ctx context.Context, userID uint) (*redis.PubSub, error) {
+	pubsub := getEventClient().Subscribe(ctx, userEventKey(userID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+	return pubsub, nil
+}
+
+// UserEventsSince returns the backlogged events published after lastEventID, oldest first
+func UserEventsSince(ctx context.Context, userID uint, lastEventID string) ([]UserEvent, error) {
+	messages, err := getEventClient().XRange(ctx, userEventKey(userID), "("+lastEventID, "+").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event backlog: %w", err)
+	}
+
+	events := make([]UserEvent, 0, len(messages))
+	for _, message := range messages {
+		eventType, _ := message.Values["type"].(string)
+		data, _ := message.Values["data"].(string)
+		events = append(events, UserEvent{ID: message.ID, Type: eventType, Data: json.RawMessage(data)})
+	}
+	return events, nil
+}
+
+// IsValidEventID reports whether id has the <milliseconds>-<sequence> form of a stream ID
+func IsValidEventID(id string) bool {
+	ms, seq, found := strings.Cut(id, "-")
+	if !found {
+		return false
+	}
+	_, msErr := strconv.ParseUint(ms, 10, 64)
+	_, seqErr := strconv.ParseUint(seq, 10, 64)
+	return msErr == nil && seqErr == nil
+}
+
+// EventIDAfter reports whether stream ID a was issued after b
+func EventIDAfter(a, b string) bool {
+	aMs, aSeq, _ := strings.Cut(a, "-")
+	bMs, bSeq, _ := strings.Cut(b, "-")
+	aMsN, _ := strconv.ParseUint(aMs, 10, 64)
+	bMsN, _ := strconv.ParseUint(bMs, 10, 64)
+	if aMsN != bMsN {
+		return aMsN > bMsN
+	}
+	aSeqN, _ := strconv.ParseUint(aSeq, 10, 64)
+	bSeqN, _ := strconv.ParseUint(bSeq, 10, 64)
+	return aSeqN > bSeqN
+}
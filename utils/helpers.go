@@ -13,7 +13,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Veedsify/JeanPayGoBackend/database/models"
 	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
 )
@@ -41,17 +40,6 @@ func GenerateRandomString(length int) string {
 	return string(b)
 }
 
-func GetConvertdirection(currency string) models.TransactionDirection {
-	switch currency {
-	case "NGN":
-		return models.NGNToGHS
-	case "GHS":
-		return models.GHSToNGN
-	default:
-		return ""
-	}
-}
-
 // GenerateRandomNumericString generates a random numeric string of specified length
 func GenerateRandomNumericString(length int) string {
 	const charset = "0123456789"
@@ -88,6 +76,11 @@ func RoundCurrency(amount float64) float64 {
 	return RoundFloat(amount, 2)
 }
 
+// RoundToCurrency rounds an amount to the minor units of the given currency
+func RoundToCurrency(amount float64, currency string) float64 {
+	return RoundFloat(amount, CurrencyMinorUnits(currency))
+}
+
 // CalculatePercentage calculates percentage of a number
 func CalculatePercentage(value, percentage float64) float64 {
 	return RoundCurrency((value * percentage) / 100)
@@ -200,17 +193,13 @@ func MaskPhoneNumber(phone string) string {
 	return phone[:2] + strings.Repeat("*", len(phone)-4) + phone[len(phone)-2:]
 }
 
-// FormatCurrency formats a float64 as currency string
+// FormatCurrency formats a float64 as currency string using the registry symbol
 func FormatCurrency(amount float64, currency string) string {
-	formattedAmount := humanize.CommafWithDigits(amount, 2)
-	switch currency {
-	case "NGN":
-		return fmt.Sprintf("₦ %s", formattedAmount)
-	case "GHS":
-		return fmt.Sprintf("GH₵ %s", formattedAmount)
-	default:
-		return fmt.Sprintf("%s %s", formattedAmount, currency)
+	info, ok := LookupCurrency(currency)
+	if !ok {
+		return fmt.Sprintf("%s %s", humanize.CommafWithDigits(amount, 2), currency)
 	}
+	return fmt.Sprintf("%s %s", info.Symbol, humanize.CommafWithDigits(amount, info.MinorUnits))
 }
 
 // ParseCurrencyAmount extracts numeric amount from currency string
@@ -537,12 +526,30 @@ var commonErrors = []CommonError{
 		description: "No payment was received for this transaction.",
 		action:      "Please check your payment method and try again.",
 	},
+	{
+		code:        "STEP_UP_REQUIRED",
+		title:       "Verification Required",
+		description: "This transaction needs your transaction PIN or a verification code.",
+		action:      "Enter your transaction PIN or the code sent to your email and try again.",
+	},
+	{
+		code:        "INVALID_PIN",
+		title:       "Incorrect Transaction PIN",
+		description: "The transaction PIN or verification code you entered is incorrect.",
+		action:      "Check your PIN or request a new verification code and try again.",
+	},
 	{
 		code:        "TRANSACTION_NOT_FOUND",
 		title:       "Transaction Not Found",
 		description: "The transaction you are looking for does not exist.",
 		action:      "Please check the transaction ID and try again.",
 	},
+	{
+		code:        "INVALID_RECIPIENT",
+		title:       "Recipient Not Found",
+		description: "The saved recipient you selected could not be found.",
+		action:      "Choose another recipient or enter the account details manually.",
+	},
 }
 
 func GetErrorFromCode(code string) string {
@@ -147,8 +147,8 @@ func ValidateCurrency(currency string) error {
 		return fmt.Errorf("currency is required")
 	}
 
-	if !constants.IsValidCurrency(currency) {
-		return fmt.Errorf("invalid currency. Supported currencies: %s", strings.Join(constants.GetSupportedCurrencies(), ", "))
+	if !IsEnabledCurrency(currency) {
+		return fmt.Errorf("invalid currency. Supported currencies: %s", strings.Join(EnabledCurrencyCodes(), ", "))
 	}
 
 	return nil